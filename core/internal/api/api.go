@@ -2,6 +2,8 @@
 package api
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -88,6 +90,9 @@ type clientImpl struct {
 
 	// Headers to pass in every request.
 	extraHeaders map[string]string
+
+	// Whether Send may reuse connections.
+	keepAlive bool
 }
 
 // An HTTP request to the W&B backend.
@@ -111,6 +116,9 @@ type Request struct {
 	// These are sent in addition to any headers set automatically by the
 	// client, such as for auth. The client headers take precedence.
 	Headers map[string]string
+
+	// Context, if set, cancels the request and its retries once done.
+	Context context.Context
 }
 
 func (req *Request) String() string {
@@ -194,6 +202,31 @@ type ClientOptions struct {
 	//
 	// If Proxy is nil or returns a nil *URL, no proxy will be used.
 	Proxy func(*http.Request) (*url.URL, error)
+
+	// How long an idle connection is kept open for reuse.
+	//
+	// Zero means no limit.
+	IdleConnTimeout time.Duration
+
+	// Maximum number of idle connections to keep open per host.
+	//
+	// Zero uses Go's default of 2.
+	MaxIdleConnsPerHost int
+
+	// Whether [Client.Send] may reuse connections between requests.
+	//
+	// By default, Send closes the connection after each request so that
+	// a connection left in a bad state by an error is never reused.
+	KeepAlive bool
+
+	// Whether to use only HTTP/1.1, even if the server supports HTTP/2.
+	DisableHTTP2 bool
+
+	// Transport, if set, is used instead of creating a new transport.
+	//
+	// This lets clients share connections and rate limits; see
+	// [TransportPool]. Proxy settings are then taken from the transport.
+	Transport http.RoundTripper
 }
 
 // Creates a new [Client] for making requests to the [Backend].
@@ -223,9 +256,36 @@ func (backend *Backend) NewClient(opts ClientOptions) Client {
 		)
 	}
 
+	transport := opts.Transport
+	if transport == nil {
+		transport = NewTransport(opts)
+	}
+
+	retryableHTTP.HTTPClient.Transport =
+		NewPeekingTransport(opts.NetworkPeeker, transport)
+
+	return &clientImpl{
+		backend:       backend,
+		retryableHTTP: retryableHTTP,
+		extraHeaders:  opts.ExtraHeaders,
+		keepAlive:     opts.KeepAlive,
+	}
+}
+
+// NewTransport creates a rate-limited transport for backend requests.
+//
+// Only the proxy and connection options are used.
+func NewTransport(opts ClientOptions) http.RoundTripper {
 	// Set the Proxy function on the HTTP client.
 	transport := &http.Transport{
-		Proxy: opts.Proxy,
+		Proxy:               opts.Proxy,
+		IdleConnTimeout:     opts.IdleConnTimeout,
+		MaxIdleConnsPerHost: opts.MaxIdleConnsPerHost,
+	}
+	// A non-nil empty map is the documented way to turn off HTTP/2.
+	if opts.DisableHTTP2 {
+		transport.TLSNextProto = map[string]func(
+			string, *tls.Conn) http.RoundTripper{}
 	}
 	// Set the "Proxy-Authorization" header for the CONNECT requests
 	// to the proxy server if the header is present in the extra headers.
@@ -238,15 +298,5 @@ func (backend *Backend) NewClient(opts ClientOptions) Client {
 		}
 	}
 
-	retryableHTTP.HTTPClient.Transport =
-		NewPeekingTransport(
-			opts.NetworkPeeker,
-			NewRateLimitedTransport(transport),
-		)
-
-	return &clientImpl{
-		backend:       backend,
-		retryableHTTP: retryableHTTP,
-		extraHeaders:  opts.ExtraHeaders,
-	}
+	return NewRateLimitedTransport(transport)
 }
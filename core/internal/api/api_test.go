@@ -3,11 +3,13 @@ package api_test
 import (
 	"bytes"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"slices"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -205,3 +207,36 @@ func TestNewClientWithProxy(t *testing.T) {
 	proxyReqHeader := resp.Request.Header.Get("Proxy-Authorization")
 	assert.Equal(t, "Basic dXNlcjpwYXNz", proxyReqHeader)
 }
+
+func TestNewClient_KeepAlive(t *testing.T) {
+	for _, keepAlive := range []bool{false, true} {
+		var connections atomic.Int32
+		server := httptest.NewUnstartedServer(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte("OK"))
+			}))
+		server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+			if state == http.StateNew {
+				connections.Add(1)
+			}
+		}
+		server.Start()
+		defer server.Close()
+
+		client := newClient(t, server.URL, api.ClientOptions{
+			KeepAlive: keepAlive,
+		})
+		for range 2 {
+			resp, err := client.Send(&api.Request{Method: http.MethodGet, Path: "test"})
+			require.NoError(t, err)
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		if keepAlive {
+			assert.EqualValues(t, 1, connections.Load())
+		} else {
+			assert.EqualValues(t, 2, connections.Load())
+		}
+	}
+}
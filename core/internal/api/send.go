@@ -19,6 +19,10 @@ func (client *clientImpl) Send(req *Request) (*http.Response, error) {
 		return nil, fmt.Errorf("api: failed to create request: %v", err)
 	}
 
+	if req.Context != nil {
+		retryableReq = retryableReq.WithContext(req.Context)
+	}
+
 	for headerKey, headerValue := range req.Headers {
 		retryableReq.Header.Set(headerKey, headerValue)
 	}
@@ -30,7 +34,7 @@ func (client *clientImpl) Send(req *Request) (*http.Response, error) {
 	// will result in not retrying an otherwise retryable request.
 	// This is safe, however leads to a small performance hit and resource waste.
 	// We are being extra cautious here, but this should be revisited.
-	retryableReq.Close = true
+	retryableReq.Close = !client.keepAlive
 
 	return client.sendToWandbBackend(retryableReq)
 }
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"net/http"
+	"sync"
+)
+
+// TransportPool shares HTTP transports between clients.
+//
+// Clients that use the same transport share a connection pool and a
+// rate limit. This matters when one process uploads many runs at once,
+// such as a sweep agent running several workers, since otherwise each
+// run opens its own connections and backs off from server rate limits
+// independently.
+type TransportPool struct {
+	mu         sync.Mutex
+	transports map[string]http.RoundTripper
+}
+
+func NewTransportPool() *TransportPool {
+	return &TransportPool{transports: make(map[string]http.RoundTripper)}
+}
+
+// Get returns the transport for the key, creating it if necessary.
+//
+// The key must identify all options that affect the transport, such as
+// the backend URL and proxy settings. The options are used only when
+// creating a new transport, as in [NewTransport].
+func (p *TransportPool) Get(key string, opts ClientOptions) http.RoundTripper {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	transport, ok := p.transports[key]
+	if !ok {
+		transport = NewTransport(opts)
+		p.transports[key] = transport
+	}
+
+	return transport
+}
@@ -0,0 +1,48 @@
+package api_test
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/api"
+)
+
+type countingTransport struct {
+	count    atomic.Int32
+	delegate http.RoundTripper
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.count.Add(1)
+	return t.delegate.RoundTrip(req)
+}
+
+func TestTransportPool_SharesByKey(t *testing.T) {
+	pool := api.NewTransportPool()
+
+	a := pool.Get("https://api.wandb.ai", api.ClientOptions{})
+	b := pool.Get("https://api.wandb.ai", api.ClientOptions{})
+	c := pool.Get("https://wandb.example.com", api.ClientOptions{})
+
+	assert.Same(t, a, b)
+	assert.NotSame(t, a, c)
+}
+
+func TestNewClient_UsesGivenTransport(t *testing.T) {
+	server := NewRecordingServer()
+	defer server.Close()
+	transport := &countingTransport{delegate: http.DefaultTransport}
+	opts := api.ClientOptions{Transport: transport}
+
+	for range 2 {
+		resp, err := newClient(t, server.URL, opts).
+			Send(&api.Request{Method: http.MethodGet, Path: "/test"})
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	assert.EqualValues(t, 2, transport.count.Load())
+}
@@ -27,7 +27,7 @@ func isBoolMessage(m protoreflect.Message) bool {
 //   - Fields prefixed with an underscore or having an unsupported type are
 //     skipped
 //   - Nested messages are only supported if they consist entirely of bools
-//     or strings
+//     or of strings and integers
 //
 // All keys in the result and its nested maps are strings, and all values are
 // string/int64/float64/slices and maps of those so that the result can be
@@ -68,9 +68,13 @@ func ProtoEncodeToDict(p proto.Message) map[string]any {
 			} else {
 				m2 := make(map[string]any)
 				pm2.Range(func(fd2 protoreflect.FieldDescriptor, v2 protoreflect.Value) bool {
-					// NOTE: only messages of strings are currently used
-					if fd2.Kind() == protoreflect.StringKind {
+					// NOTE: only messages of strings and integers are
+					// currently used
+					switch fd2.Kind() {
+					case protoreflect.StringKind:
 						m2[strconv.Itoa(int(fd2.Number()))] = v2.String()
+					case protoreflect.Int32Kind, protoreflect.Int64Kind:
+						m2[strconv.Itoa(int(fd2.Number()))] = v2.Int()
 					}
 					return true
 				})
@@ -0,0 +1,94 @@
+package filestream
+
+import (
+	"sync"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// defaultBacklogBytes is the default amount of unsent data above which
+// the user is warned.
+const defaultBacklogBytes = 64 << 20 // 64 MB
+
+// backlog tracks how much data is waiting to be sent.
+//
+// If data is logged faster than it can be uploaded, it accumulates in
+// memory. The backlog warns the user when this happens and can block
+// new data until the filestream catches up.
+type backlog struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	// bytes is the approximate size of the unsent data.
+	bytes int
+
+	// threshold is the size above which the backlog is considered too large.
+	threshold int
+
+	// isReleased is true if waiting should never block.
+	isReleased bool
+
+	logger  *observability.CoreLogger
+	printer *observability.Printer
+}
+
+func newBacklog(
+	threshold int,
+	logger *observability.CoreLogger,
+	printer *observability.Printer,
+) *backlog {
+	b := &backlog{threshold: threshold, logger: logger, printer: printer}
+	b.cond = sync.NewCond(&b.mu)
+	return b
+}
+
+// Set updates the size of the unsent data.
+func (b *backlog) Set(bytes int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	wasAbove := b.bytes > b.threshold
+	b.bytes = bytes
+	isAbove := b.bytes > b.threshold
+
+	switch {
+	case isAbove && !wasAbove:
+		b.logger.Warn("filestream: backlog above threshold",
+			"bytes", bytes, "threshold", b.threshold)
+		b.printer.
+			AtMostEvery(time.Minute).
+			Writef(
+				"Data is being logged faster than it can be uploaded"+
+					" (%d MB waiting). Uploads will catch up, but"+
+					" memory usage will grow until they do.",
+				bytes>>20)
+
+	case !isAbove && wasAbove:
+		b.logger.Info("filestream: backlog below threshold",
+			"bytes", bytes, "threshold", b.threshold)
+		b.cond.Broadcast()
+	}
+}
+
+// WaitBelowThreshold blocks while the backlog is above its threshold.
+func (b *backlog) WaitBelowThreshold() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for !b.isReleased && b.bytes > b.threshold {
+		b.cond.Wait()
+	}
+}
+
+// Release unblocks all current and future calls to WaitBelowThreshold.
+//
+// This is used when the filestream stops working or finishes, at which
+// point the backlog will not be reduced.
+func (b *backlog) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.isReleased = true
+	b.cond.Broadcast()
+}
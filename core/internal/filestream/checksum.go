@@ -0,0 +1,113 @@
+package filestream
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"maps"
+	"slices"
+	"strconv"
+)
+
+// maxChecksumAttempts is how many times to send a request whose
+// checksum the server keeps rejecting before giving up on it.
+const maxChecksumAttempts = 3
+
+// withChecksum returns a copy of the request with its Checksum set.
+func (r *FileStreamRequestJSON) withChecksum() *FileStreamRequestJSON {
+	clone := *r
+	clone.Checksum = r.checksum()
+	return &clone
+}
+
+// checksum returns a hash of the request's file names, offsets and lines.
+//
+// The server recomputes it to detect requests that were corrupted or
+// spliced together in transit. Files are hashed in sorted order, each
+// as its name, offset and lines separated by newlines.
+func (r *FileStreamRequestJSON) checksum() string {
+	hash := sha256.New()
+
+	names := make([]string, 0, len(r.Files))
+	for name := range r.Files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		chunk := r.Files[name]
+
+		hash.Write([]byte(name))
+		hash.Write([]byte{'\n'})
+		hash.Write([]byte(strconv.Itoa(chunk.Offset)))
+		hash.Write([]byte{'\n'})
+		for _, line := range chunk.Content {
+			hash.Write([]byte(line))
+			hash.Write([]byte{'\n'})
+		}
+	}
+
+	return "sha256:" + hex.EncodeToString(hash.Sum(nil))
+}
+
+// checksumRejection returns the offsets the server asks us to resend
+// from if it rejected a request's checksum.
+//
+// A rejection looks like
+//
+//	{"checksum_rejected": {"wandb-history.jsonl": 120}}
+//
+// where each number is the line up to which the server has good data.
+// The second return value is false if the request was accepted.
+func checksumRejection(res map[string]any) (map[string]int, bool) {
+	rejected, ok := res["checksum_rejected"].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+
+	offsets := make(map[string]int, len(rejected))
+	for name, value := range rejected {
+		if offset, ok := value.(float64); ok {
+			offsets[name] = int(offset)
+		}
+	}
+
+	return offsets, true
+}
+
+// trimmedTo returns the request without file lines before the given
+// offsets.
+//
+// Files not in the map are unchanged, and files whose lines are all
+// before their offset are removed. The given request is not modified.
+func (r *FileStreamRequestJSON) trimmedTo(offsets map[string]int) *FileStreamRequestJSON {
+	trimmed := r
+
+	for name, offset := range offsets {
+		chunk, ok := r.Files[name]
+		if !ok {
+			continue
+		}
+
+		skip := min(offset-chunk.Offset, len(chunk.Content))
+		if skip <= 0 {
+			continue
+		}
+
+		if trimmed == r {
+			clone := *r
+			clone.Files = maps.Clone(r.Files)
+			trimmed = &clone
+		}
+
+		if skip == len(chunk.Content) {
+			delete(trimmed.Files, name)
+		} else {
+			trimmed.Files[name] = offsetAndContent{
+				Offset:  chunk.Offset + skip,
+				Content: chunk.Content[skip:],
+			}
+		}
+	}
+
+	return trimmed
+}
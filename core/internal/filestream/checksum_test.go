@@ -0,0 +1,97 @@
+package filestream_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/api"
+	. "github.com/wandb/wandb/core/internal/filestream"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"golang.org/x/time/rate"
+)
+
+// rejectingClient rejects the checksum of its first request.
+type rejectingClient struct {
+	recordingClient
+	rejection string
+}
+
+func (c *rejectingClient) Send(req *api.Request) (*http.Response, error) {
+	c.recordingClient.body = c.rejection
+	c.rejection = ""
+	return c.recordingClient.Send(req)
+}
+
+func sentRequests(t *testing.T, client *recordingClient) []FileStreamRequestJSON {
+	t.Helper()
+
+	var requests []FileStreamRequestJSON
+	for _, req := range client.requests {
+		var request FileStreamRequestJSON
+		require.NoError(t, json.Unmarshal(req.Body, &request))
+		requests = append(requests, request)
+	}
+	return requests
+}
+
+func startWithHistory(client api.Client) FileStream {
+	fs := NewFileStream(FileStreamParams{
+		Settings:          settings.From(&service.Settings{}),
+		Logger:            observability.NewNoOpLogger(),
+		Printer:           observability.NewPrinter(),
+		ApiClient:         client,
+		TransmitRateLimit: rate.NewLimiter(rate.Inf, 1),
+	})
+
+	fs.Start("entity", "project", "run", nil)
+	fs.StreamUpdate(&HistoryUpdate{
+		Record: &service.HistoryRecord{
+			Item: []*service.HistoryItem{{Key: "_step", ValueJson: "1"}},
+		},
+	})
+	fs.StreamUpdate(&FilesUploadedUpdate{RelativePath: "file.txt"})
+	fs.FinishWithExit(0)
+
+	return fs
+}
+
+func TestChecksum_SetOnRequests(t *testing.T) {
+	client := &recordingClient{}
+	startWithHistory(client)
+
+	requests := sentRequests(t, client)
+	require.NotEmpty(t, requests)
+	for _, request := range requests {
+		assert.True(t, strings.HasPrefix(request.Checksum, "sha256:"))
+	}
+}
+
+func TestChecksum_ResendsFromOffsetOnRejection(t *testing.T) {
+	client := &rejectingClient{
+		rejection: `{"checksum_rejected": {"wandb-history.jsonl": 1}}`,
+	}
+	startWithHistory(client)
+
+	requests := sentRequests(t, &client.recordingClient)
+	require.GreaterOrEqual(t, len(requests), 2)
+	assert.Contains(t, requests[0].Files, HistoryFileName)
+	assert.NotContains(t, requests[1].Files, HistoryFileName)
+	assert.Equal(t, requests[0].Uploaded, requests[1].Uploaded)
+	assert.NotEqual(t, requests[0].Checksum, requests[1].Checksum)
+}
+
+func TestChecksum_GivesUpAfterRepeatedRejections(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir()) // for the spill queue
+	client := &recordingClient{
+		body: `{"checksum_rejected": {}}`,
+	}
+	fs := startWithHistory(client)
+
+	assert.Positive(t, fs.Metrics().DeferredLines)
+}
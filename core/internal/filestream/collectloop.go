@@ -13,6 +13,30 @@ import (
 type CollectLoop struct {
 	TransmitRateLimit   *rate.Limiter
 	MaxRequestSizeBytes int
+
+	// FlushSizeBytes is the approximate amount of buffered data at which
+	// to send a request without waiting for the rate limit.
+	//
+	// If zero, only a full request of MaxRequestSizeBytes bypasses
+	// the rate limit.
+	FlushSizeBytes int
+
+	// FlushLines is the number of buffered lines at which to send
+	// a request without waiting for the rate limit.
+	//
+	// If zero, there is no limit on the number of lines.
+	FlushLines int
+
+	// MinBatchDelay is the minimum time to collect updates before
+	// sending a request, even if the rate limit allows sending sooner.
+	//
+	// Updates that reach FlushSizeBytes or FlushLines are still sent
+	// immediately.
+	MinBatchDelay time.Duration
+
+	// ReportBufferSize, if set, is called with the approximate size
+	// of the buffered data whenever it changes.
+	ReportBufferSize func(bytes int)
 }
 
 // Start ingests requests and outputs rate-limited, batched requests.
@@ -27,15 +51,18 @@ func (cl CollectLoop) Start(
 
 		for request := range requests {
 			buffer.Merge(request)
+			cl.reportBufferSize(buffer)
 
 			cl.waitForRateLimit(buffer, requests)
 			buffer, isDone = cl.transmit(buffer, requests, transmissions)
+			cl.reportBufferSize(buffer)
 		}
 
 		for !isDone {
-			reader, _ := NewRequestReader(buffer, cl.MaxRequestSizeBytes)
+			reader := cl.newReader(buffer)
 			transmissions <- reader
 			buffer, isDone = reader.Next()
+			cl.reportBufferSize(buffer)
 		}
 
 		close(transmissions)
@@ -54,27 +81,33 @@ func (cl CollectLoop) waitForRateLimit(
 		return
 	}
 
+	delay := cl.MinBatchDelay
 	reservation := cl.TransmitRateLimit.Reserve()
 
 	// If we would be rate-limited forever, just ignore the limit.
-	if !reservation.OK() {
+	if reservation.OK() {
+		delay = max(delay, reservation.Delay())
+	}
+
+	if delay <= 0 {
 		return
 	}
 
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
 	for {
-		timer := time.NewTimer(reservation.Delay())
 		select {
 		case <-timer.C:
 			return
 
 		case request, ok := <-requests:
-			_ = timer.Stop()
-
 			if !ok {
 				return
 			}
 
 			buffer.Merge(request)
+			cl.reportBufferSize(buffer)
 
 			if cl.shouldSendASAP(buffer) {
 				return
@@ -90,7 +123,7 @@ func (cl CollectLoop) transmit(
 	transmissions chan<- *FileStreamRequestReader,
 ) (*FileStreamRequest, bool) {
 	for {
-		reader, _ := NewRequestReader(buffer, cl.MaxRequestSizeBytes)
+		reader := cl.newReader(buffer)
 
 		select {
 		case transmissions <- reader:
@@ -102,10 +135,32 @@ func (cl CollectLoop) transmit(
 			}
 
 			buffer.Merge(request)
+			cl.reportBufferSize(buffer)
 		}
 	}
 }
 
+// newReader returns a reader for the next request to transmit.
+//
+// If the buffer is too large for a single request, its priority data
+// is sent first on its own so that it isn't held up by bulk data.
+func (cl CollectLoop) newReader(buffer *FileStreamRequest) *FileStreamRequestReader {
+	reader, isTruncated := NewRequestReader(buffer, cl.MaxRequestSizeBytes)
+
+	if isTruncated && buffer.hasPriorityData() {
+		return NewPriorityRequestReader(buffer)
+	}
+
+	return reader
+}
+
+// reportBufferSize invokes ReportBufferSize if it is set.
+func (cl CollectLoop) reportBufferSize(buffer *FileStreamRequest) {
+	if cl.ReportBufferSize != nil {
+		cl.ReportBufferSize(buffer.sizeBytes())
+	}
+}
+
 // shouldSendASAP returns a request should be made regardless of rate limits.
 func (cl CollectLoop) shouldSendASAP(request *FileStreamRequest) bool {
 	_, isTruncated := NewRequestReader(request, cl.MaxRequestSizeBytes)
@@ -115,6 +170,13 @@ func (cl CollectLoop) shouldSendASAP(request *FileStreamRequest) bool {
 	case isTruncated:
 		return true
 
+	// Send large batches without waiting so that they don't keep growing
+	// into many back-to-back requests.
+	case cl.FlushSizeBytes > 0 && request.sizeBytes() >= cl.FlushSizeBytes:
+		return true
+	case cl.FlushLines > 0 && request.numLines() >= cl.FlushLines:
+		return true
+
 	// Send the "pre-empting" state immediately.
 	//
 	// This state indicates that the process may be about to yield the
@@ -123,6 +185,10 @@ func (cl CollectLoop) shouldSendASAP(request *FileStreamRequest) bool {
 	case request.Preempting:
 		return true
 
+	// Don't delay the end of the run.
+	case request.Complete:
+		return true
+
 	default:
 		return false
 	}
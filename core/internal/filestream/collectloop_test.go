@@ -49,3 +49,130 @@ func TestCollectLoop_SendsLastRequestImmediately(t *testing.T) {
 		t.Error("timeout after 1 second")
 	}
 }
+
+func TestCollectLoop_FlushThresholdsBypassRateLimit(t *testing.T) {
+	testCases := []struct {
+		name string
+		loop CollectLoop
+	}{
+		{"FlushLines", CollectLoop{
+			MaxRequestSizeBytes: 1000,
+			FlushLines:          2,
+		}},
+		{"FlushSizeBytes", CollectLoop{
+			MaxRequestSizeBytes: 1000,
+			FlushSizeBytes:      6,
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := make(chan *FileStreamRequest)
+			defer close(requests)
+
+			// Use up the only token so that the next one is an hour away.
+			tc.loop.TransmitRateLimit = rate.NewLimiter(rate.Every(time.Hour), 1)
+			tc.loop.TransmitRateLimit.Allow()
+
+			transmissions := tc.loop.Start(requests)
+			requests <- &FileStreamRequest{HistoryLines: []string{"abc"}}
+			requests <- &FileStreamRequest{HistoryLines: []string{"def"}}
+
+			select {
+			case result := <-transmissions:
+				req := result.GetJSON(&FileStreamState{})
+				assert.Equal(t,
+					[]string{"abc", "def"},
+					req.Files[HistoryFileName].Content)
+			case <-time.After(time.Second):
+				t.Error("timeout after 1 second")
+			}
+		})
+	}
+}
+
+func TestCollectLoop_WaitsForMinBatchDelay(t *testing.T) {
+	requests := make(chan *FileStreamRequest)
+	defer close(requests)
+	loop := CollectLoop{
+		TransmitRateLimit:   rate.NewLimiter(rate.Inf, 1),
+		MaxRequestSizeBytes: 1000,
+		MinBatchDelay:       200 * time.Millisecond,
+	}
+
+	transmissions := loop.Start(requests)
+	requests <- &FileStreamRequest{HistoryLines: []string{"abc"}}
+
+	select {
+	case <-transmissions:
+		t.Error("sent before MinBatchDelay")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	requests <- &FileStreamRequest{HistoryLines: []string{"def"}}
+
+	select {
+	case result := <-transmissions:
+		req := result.GetJSON(&FileStreamState{})
+		assert.Equal(t,
+			[]string{"abc", "def"},
+			req.Files[HistoryFileName].Content)
+	case <-time.After(time.Second):
+		t.Error("timeout after 1 second")
+	}
+}
+
+func TestCollectLoop_ReportsBufferSize(t *testing.T) {
+	requests := make(chan *FileStreamRequest)
+	sizes := make(chan int, 10)
+	loop := CollectLoop{
+		TransmitRateLimit:   rate.NewLimiter(rate.Inf, 1),
+		MaxRequestSizeBytes: 1000,
+		ReportBufferSize:    func(bytes int) { sizes <- bytes },
+	}
+
+	transmissions := loop.Start(requests)
+	requests <- &FileStreamRequest{HistoryLines: []string{"abc"}}
+	requests <- &FileStreamRequest{HistoryLines: []string{"def"}}
+	<-transmissions
+	close(requests)
+	for range transmissions {
+	}
+	close(sizes)
+
+	var reported []int
+	for size := range sizes {
+		reported = append(reported, size)
+	}
+	assert.Equal(t, []int{3, 6, 0}, reported)
+}
+
+func TestCollectLoop_SendsPriorityDataBeforeBacklog(t *testing.T) {
+	requests := make(chan *FileStreamRequest)
+	loop := CollectLoop{
+		TransmitRateLimit:   rate.NewLimiter(rate.Inf, 1),
+		MaxRequestSizeBytes: 10,
+	}
+
+	transmissions := loop.Start(requests)
+	requests <- &FileStreamRequest{
+		HistoryLines:  []string{"0123456789", "0123456789"},
+		LatestSummary: "summary",
+		Complete:      true,
+	}
+	close(requests)
+
+	state := &FileStreamState{}
+	var sent []*FileStreamRequestJSON
+	for reader := range transmissions {
+		sent = append(sent, reader.GetJSON(state))
+	}
+
+	assert.Len(t, sent, 3)
+	assert.Equal(t,
+		[]string{"summary"},
+		sent[0].Files[SummaryFileName].Content)
+	assert.NotContains(t, sent[0].Files, HistoryFileName)
+	assert.Equal(t, 2, state.HistoryLineNum)
+	assert.NotNil(t, sent[2].Complete)
+}
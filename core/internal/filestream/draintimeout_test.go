@@ -0,0 +1,80 @@
+package filestream_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/api"
+	. "github.com/wandb/wandb/core/internal/filestream"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// hangingClient never responds until a request is cancelled.
+type hangingClient struct{}
+
+func (hangingClient) Send(req *api.Request) (*http.Response, error) {
+	<-req.Context.Done()
+	return nil, req.Context.Err()
+}
+
+func (hangingClient) Do(*http.Request) (*http.Response, error) {
+	panic("unexpected call to Do")
+}
+
+func finishWithDrainTimeout(t *testing.T, policy string) MetricsSnapshot {
+	t.Helper()
+	t.Setenv("TMPDIR", t.TempDir()) // for the spill queue
+
+	fs := NewFileStream(FileStreamParams{
+		Settings: settings.From(&service.Settings{
+			RunId:                          wrapperspb.String(t.Name()),
+			XFileStreamDrainTimeoutSeconds: wrapperspb.Double(0.05),
+			XFileStreamDrainTimeoutPolicy:  wrapperspb.String(policy),
+		}),
+		Logger:            observability.NewNoOpLogger(),
+		Printer:           observability.NewPrinter(),
+		ApiClient:         hangingClient{},
+		TransmitRateLimit: rate.NewLimiter(rate.Inf, 1),
+	})
+
+	fs.Start("entity", "project", "run", nil)
+	fs.StreamUpdate(&HistoryUpdate{
+		Record: &service.HistoryRecord{
+			Item: []*service.HistoryItem{{Key: "_step", ValueJson: "1"}},
+		},
+	})
+
+	finished := make(chan struct{})
+	go func() {
+		fs.FinishWithExit(0)
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+	case <-time.After(5 * time.Second):
+		t.Fatal("filestream did not finish after the drain timeout")
+	}
+
+	return fs.Metrics()
+}
+
+func TestDrainTimeout_Spill(t *testing.T) {
+	metrics := finishWithDrainTimeout(t, "spill")
+
+	assert.Positive(t, metrics.DeferredLines)
+	assert.Zero(t, metrics.DroppedLines)
+}
+
+func TestDrainTimeout_Drop(t *testing.T) {
+	metrics := finishWithDrainTimeout(t, "drop")
+
+	assert.Positive(t, metrics.DroppedLines)
+	assert.Zero(t, metrics.DeferredLines)
+}
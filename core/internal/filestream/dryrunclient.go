@@ -0,0 +1,105 @@
+package filestream
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/wandb/wandb/core/internal/api"
+)
+
+// DryRunClient is an [api.Client] that writes filestream requests to
+// a file instead of sending them.
+//
+// Each request is appended as a line of JSON, and every request is
+// answered with an empty successful response. This is useful in tests
+// and for debugging what data would be uploaded.
+type DryRunClient struct {
+	mu   sync.Mutex
+	path string
+}
+
+// dryRunRequestJSON is a request as written by a DryRunClient.
+type dryRunRequestJSON struct {
+	Method      string `json:"method"`
+	Path        string `json:"path"`
+	ContentType string `json:"content_type,omitempty"`
+
+	// Body is the request body if it is JSON.
+	Body json.RawMessage `json:"body,omitempty"`
+
+	// BodyBytes is the request body if it is not JSON.
+	BodyBytes []byte `json:"body_bytes,omitempty"`
+}
+
+// NewDryRunClient returns a client that writes requests to the file
+// at the given path, replacing any existing file.
+func NewDryRunClient(path string) (*DryRunClient, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("filestream: failed to create dry run file: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		return nil, fmt.Errorf("filestream: failed to create dry run file: %v", err)
+	}
+
+	return &DryRunClient{path: path}, nil
+}
+
+func (c *DryRunClient) Send(req *api.Request) (*http.Response, error) {
+	record := dryRunRequestJSON{
+		Method:      req.Method,
+		Path:        req.Path,
+		ContentType: req.Headers["Content-Type"],
+	}
+	if json.Valid(req.Body) {
+		record.Body = req.Body
+	} else {
+		record.BodyBytes = req.Body
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("filestream: failed to encode dry run request: %v", err)
+	}
+
+	if err := c.appendLine(line); err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Status:     "200 OK",
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("{}")),
+	}, nil
+}
+
+func (c *DryRunClient) Do(*http.Request) (*http.Response, error) {
+	return nil, errors.New("filestream: dry run client only supports Send")
+}
+
+// appendLine writes a line to the end of the file.
+func (c *DryRunClient) appendLine(line []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	file, err := os.OpenFile(c.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("filestream: failed to open dry run file: %v", err)
+	}
+
+	_, writeErr := file.Write(append(line, '\n'))
+	closeErr := file.Close()
+
+	if err := errors.Join(writeErr, closeErr); err != nil {
+		return fmt.Errorf("filestream: failed to write dry run file: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package filestream_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/api"
+	. "github.com/wandb/wandb/core/internal/filestream"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"golang.org/x/time/rate"
+)
+
+func TestDryRunClient_WritesRequests(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "requests.jsonl")
+	client, err := NewDryRunClient(path)
+	require.NoError(t, err)
+	fs := NewFileStream(FileStreamParams{
+		Settings:          settings.From(&service.Settings{}),
+		Logger:            observability.NewNoOpLogger(),
+		Printer:           observability.NewPrinter(),
+		ApiClient:         client,
+		TransmitRateLimit: rate.NewLimiter(rate.Inf, 1),
+	})
+
+	fs.Start("entity", "project", "run", nil)
+	fs.StreamUpdate(&FilesUploadedUpdate{RelativePath: "file.txt"})
+	fs.FinishWithExit(0)
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var requests []map[string]any
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var request map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &request))
+		requests = append(requests, request)
+	}
+
+	require.NotEmpty(t, requests)
+	last := requests[len(requests)-1]
+	assert.Equal(t, "files/entity/project/run/file_stream", last["path"])
+	assert.Equal(t, true, last["body"].(map[string]any)["complete"])
+}
+
+func TestDryRunClient_RecordsNonJSONBody(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "requests.jsonl")
+	client, err := NewDryRunClient(path)
+	require.NoError(t, err)
+
+	resp, err := client.Send(&api.Request{
+		Method: http.MethodPost,
+		Path:   "path",
+		Body:   []byte{0xff},
+	})
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.JSONEq(t,
+		`{"method": "POST", "path": "path", "body_bytes": "/w=="}`,
+		string(content))
+}
@@ -0,0 +1,86 @@
+package filestream
+
+import (
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+	"golang.org/x/time/rate"
+)
+
+// processFeedback acts on a response from the filestream API.
+//
+// The recognized fields are:
+//
+//   - "limits": server-imposed limits, see [fileStream.applyLimits]
+//   - "stopped": whether the run was stopped, for example from the UI
+//   - "preempting": whether the server expects the run to be preempted
+func (fs *fileStream) processFeedback(res map[string]any) {
+	if limits, ok := res["limits"].(map[string]any); ok {
+		fs.applyLimits(limits)
+	}
+
+	if stopped, _ := res["stopped"].(bool); stopped {
+		if !fs.runShouldStop.Swap(true) {
+			fs.logger.Info("filestream: server indicated run should stop")
+		}
+	}
+
+	if preempting, _ := res["preempting"].(bool); preempting {
+		fs.preemptingOnce.Do(fs.emitPreempting)
+	}
+}
+
+// applyLimits adjusts the filestream to limits requested by the server.
+//
+// The server sets "heartbeat_seconds" to tune liveness detection, for
+// example on local deployments, and "rate_limit_seconds" to space out
+// requests when it is under load.
+func (fs *fileStream) applyLimits(limits map[string]any) {
+	if seconds, ok := positiveSeconds(limits["heartbeat_seconds"]); ok &&
+		!fs.isHeartbeatIntervalFixed {
+		interval := max(seconds, minHeartbeatInterval)
+		fs.heartbeatStopwatch.SetDuration(interval)
+		fs.logger.Debug("filestream: heartbeat interval set by server",
+			"interval", interval)
+	}
+
+	if seconds, ok := positiveSeconds(limits["rate_limit_seconds"]); ok {
+		fs.throttle.SetServerLimit(rate.Every(seconds))
+		fs.logger.Debug("filestream: transmit rate set by server",
+			"interval", seconds)
+	}
+}
+
+// emitPreempting marks the run as preempting on behalf of the server.
+//
+// The record goes through the normal run pipeline so that it is saved
+// to the transaction log and sent back through the filestream. It is
+// added asynchronously because the pipeline may be blocked on the
+// filestream, which may be waiting for feedback to be processed.
+func (fs *fileStream) emitPreempting() {
+	if fs.extraWork == nil {
+		return
+	}
+
+	fs.logger.Info("filestream: server indicated run is preempting")
+	go fs.extraWork.AddRecordOrCancel(
+		fs.extraWork.BeforeEndCtx().Done(),
+		&service.Record{
+			RecordType: &service.Record_Preempting{
+				Preempting: &service.RunPreemptingRecord{},
+			},
+		},
+	)
+}
+
+// positiveSeconds converts a JSON number of seconds to a duration.
+//
+// The second return value is false if the value is not a positive number.
+func positiveSeconds(value any) (time.Duration, bool) {
+	seconds, ok := value.(float64)
+	if !ok || seconds <= 0 {
+		return 0, false
+	}
+
+	return time.Duration(seconds * float64(time.Second)), true
+}
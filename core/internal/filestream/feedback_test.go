@@ -0,0 +1,138 @@
+package filestream_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	. "github.com/wandb/wandb/core/internal/filestream"
+	"github.com/wandb/wandb/core/internal/runworktest"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/internal/waitingtest"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type feedbackTestResult struct {
+	fs        FileStream
+	heartbeat *waitingtest.FakeStopwatch
+	rateLimit *rate.Limiter
+	runWork   *runworktest.FakeRunWork
+}
+
+// runWithResponse runs a filestream against a server that always
+// responds with the given body.
+func runWithResponse(
+	t *testing.T,
+	s *service.Settings,
+	body string,
+) feedbackTestResult {
+	t.Helper()
+
+	result := feedbackTestResult{
+		heartbeat: waitingtest.NewFakeStopwatch(),
+		rateLimit: rate.NewLimiter(rate.Inf, 1),
+		runWork:   runworktest.New(),
+	}
+	result.fs = NewFileStream(FileStreamParams{
+		Settings:           settings.From(s),
+		Logger:             observability.NewNoOpLogger(),
+		Printer:            observability.NewPrinter(),
+		ApiClient:          &recordingClient{body: body},
+		TransmitRateLimit:  result.rateLimit,
+		HeartbeatStopwatch: result.heartbeat,
+		ExtraWork:          result.runWork,
+	})
+
+	result.fs.Start("entity", "project", "run", nil)
+	result.fs.FinishWithExit(0)
+
+	return result
+}
+
+func TestHeartbeat_FromSettings(t *testing.T) {
+	result := runWithResponse(t,
+		&service.Settings{
+			XFileStreamHeartbeatSeconds: wrapperspb.Double(2.5),
+		},
+		`{"limits": {"heartbeat_seconds": 10}}`,
+	)
+
+	// The server cannot override a user-specified interval.
+	assert.Equal(t, 2500*time.Millisecond, result.heartbeat.Duration())
+}
+
+func TestHeartbeat_SetByServer(t *testing.T) {
+	result := runWithResponse(t,
+		&service.Settings{},
+		`{"limits": {"heartbeat_seconds": 10}}`,
+	)
+
+	assert.Equal(t, 10*time.Second, result.heartbeat.Duration())
+}
+
+func TestHeartbeat_ServerIntervalHasMinimum(t *testing.T) {
+	result := runWithResponse(t,
+		&service.Settings{},
+		`{"limits": {"heartbeat_seconds": 0.001}}`,
+	)
+
+	assert.Equal(t, time.Second, result.heartbeat.Duration())
+}
+
+func TestFeedback_RateLimit(t *testing.T) {
+	result := runWithResponse(t,
+		&service.Settings{},
+		`{"limits": {"rate_limit_seconds": 4}}`,
+	)
+
+	assert.Equal(t, rate.Every(4*time.Second), result.rateLimit.Limit())
+}
+
+func TestFeedback_Stopped(t *testing.T) {
+	result := runWithResponse(t, &service.Settings{}, `{"stopped": true}`)
+
+	assert.True(t, result.fs.RunShouldStop())
+}
+
+func TestFeedback_NotStopped(t *testing.T) {
+	result := runWithResponse(t, &service.Settings{}, `{"exitcode": null}`)
+
+	assert.False(t, result.fs.RunShouldStop())
+}
+
+func TestFeedback_PreemptingEmitsRecord(t *testing.T) {
+	result := runWithResponse(t, &service.Settings{}, `{"preempting": true}`)
+
+	assert.Eventually(t,
+		func() bool { return len(result.runWork.AllRecords()) > 0 },
+		time.Second,
+		time.Millisecond,
+	)
+	records := result.runWork.AllRecords()
+	assert.Len(t, records, 1)
+	assert.NotNil(t, records[0].GetPreempting())
+}
+
+func TestFeedback_RateLimitCannotBeFasterThanUserInterval(t *testing.T) {
+	result := runWithResponse(t,
+		&service.Settings{
+			XFileStreamTransmitIntervalSeconds: wrapperspb.Double(8),
+		},
+		`{"limits": {"rate_limit_seconds": 4}}`,
+	)
+
+	assert.Equal(t, rate.Every(8*time.Second), result.rateLimit.Limit())
+}
+
+func TestSetTransmitInterval(t *testing.T) {
+	result := runWithResponse(t, &service.Settings{}, `{}`)
+
+	result.fs.SetTransmitInterval(3 * time.Second)
+	assert.Equal(t, rate.Every(3*time.Second), result.rateLimit.Limit())
+
+	result.fs.SetTransmitInterval(0)
+	assert.Equal(t, rate.Inf, result.rateLimit.Limit())
+}
@@ -2,11 +2,15 @@
 package filestream
 
 import (
+	"context"
 	"fmt"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/wandb/wandb/core/internal/api"
+	"github.com/wandb/wandb/core/internal/runwork"
 	"github.com/wandb/wandb/core/internal/settings"
 	"github.com/wandb/wandb/core/internal/waiting"
 	"github.com/wandb/wandb/core/pkg/observability"
@@ -21,6 +25,9 @@ const (
 	OutputFileName           = "output.log"
 	defaultHeartbeatInterval = 30 * time.Second
 
+	// The shortest heartbeat interval the server can request.
+	minHeartbeatInterval = time.Second
+
 	// Maximum line length for filestream jsonl files, imposed by the back-end.
 	//
 	// See https://github.com/wandb/core/pull/7339 for history.
@@ -62,6 +69,27 @@ type FileStream interface {
 
 	// StreamUpdate uploads information through the filestream API.
 	StreamUpdate(update Update)
+
+	// RunShouldStop reports whether the server indicated that the run
+	// was stopped, for example by a user in the UI.
+	RunShouldStop() bool
+
+	// Metrics returns statistics about the filestream's requests,
+	// for debugging.
+	Metrics() MetricsSnapshot
+
+	// SetTransmitInterval changes the minimum time between requests.
+	//
+	// A zero interval restores the default. The server may still
+	// require a longer interval.
+	SetTransmitInterval(interval time.Duration)
+
+	// AckHistory invokes the callback once all history passed to
+	// StreamUpdate so far is accepted by the server.
+	//
+	// The callback receives false if the filestream finishes or fails
+	// first. It must not block.
+	AckHistory(callback func(accepted bool))
 }
 
 // fileStream is a stream of data to the server
@@ -89,10 +117,60 @@ type fileStream struct {
 	// The rate limit for sending data to the backend.
 	transmitRateLimit *rate.Limiter
 
+	// Adjusts transmitRateLimit based on server backpressure.
+	throttle *Throttle
+
 	// A schedule on which to send heartbeats to the backend
 	// to prove the run is still alive.
 	heartbeatStopwatch waiting.Stopwatch
 
+	// Whether the heartbeat interval is set by the user, in which case
+	// the server cannot change it.
+	isHeartbeatIntervalFixed bool
+
+	// The amount of data waiting to be sent.
+	backlog *backlog
+
+	// Whether history updates block while the backlog is too large.
+	isBacklogBlocking bool
+
+	// Statistics about requests and dropped data, for debugging.
+	metrics *Metrics
+
+	// Callbacks waiting for history to be accepted.
+	historyAcks *historyAcks
+
+	// Maximum time to spend finishing, or 0 if unlimited.
+	drainTimeout time.Duration
+
+	// Whether to discard unsent data after the drain timeout instead
+	// of spilling it to disk.
+	isDropOnDrainTimeout bool
+
+	// A context that is cancelled to abandon all requests when the
+	// drain timeout passes.
+	drainCtx context.Context
+	abandon  context.CancelFunc
+
+	// Used to emit records in response to server feedback, or nil.
+	extraWork runwork.ExtraWork
+
+	// Whether the server indicated that the run should stop.
+	runShouldStop *atomic.Bool
+
+	// Whether we already emitted a preempting record due to server feedback.
+	preemptingOnce *sync.Once
+
+	// The largest request body to send before splitting a request.
+	maxRequestBodyBytes int
+
+	// The encoding to use for request bodies.
+	//
+	// This starts as JSON and switches to protobuf once the server
+	// advertises support for it. It is only accessed by the goroutine
+	// making requests.
+	wireFormat wireFormat
+
 	// A channel that is closed if there is a fatal error.
 	deadChan     chan struct{}
 	deadChanOnce *sync.Once
@@ -105,6 +183,18 @@ type FileStreamParams struct {
 	ApiClient          api.Client
 	TransmitRateLimit  *rate.Limiter
 	HeartbeatStopwatch waiting.Stopwatch
+
+	// ExtraWork, if set, receives records emitted in response to
+	// server feedback, such as preemption notices.
+	ExtraWork runwork.ExtraWork
+
+	// Metrics, if set, is updated by the filestream. It should be
+	// the same object that counts retries in the ApiClient.
+	Metrics *Metrics
+
+	// Throttle, if set, must wrap TransmitRateLimit and observe the
+	// ApiClient's responses via its CheckRetry method.
+	Throttle *Throttle
 }
 
 func NewFileStream(params FileStreamParams) FileStream {
@@ -128,6 +218,40 @@ func NewFileStream(params FileStreamParams) FileStream {
 		transmitRateLimit: params.TransmitRateLimit,
 		deadChanOnce:      &sync.Once{},
 		deadChan:          make(chan struct{}),
+		extraWork:         params.ExtraWork,
+		runShouldStop:     &atomic.Bool{},
+		preemptingOnce:    &sync.Once{},
+		metrics:           params.Metrics,
+		historyAcks:       &historyAcks{},
+		throttle:          params.Throttle,
+	}
+
+	if fs.throttle == nil {
+		fs.throttle = NewThrottle(fs.transmitRateLimit)
+	}
+	if interval := fs.settings.GetFileStreamTransmitInterval(); interval > 0 {
+		fs.SetTransmitInterval(interval)
+	}
+
+	fs.drainCtx, fs.abandon = context.WithCancel(context.Background())
+	fs.drainTimeout = fs.settings.GetFileStreamDrainTimeout()
+	switch policy := fs.settings.GetFileStreamDrainTimeoutPolicy(); policy {
+	case "", "spill":
+	case "drop":
+		fs.isDropOnDrainTimeout = true
+	default:
+		fs.logger.CaptureWarn(
+			"filestream: unknown drain timeout policy, using spill",
+			"policy", policy)
+	}
+
+	fs.maxRequestBodyBytes = int(fs.settings.GetFileStreamMaxRequestBytes())
+	if fs.maxRequestBodyBytes <= 0 {
+		fs.maxRequestBodyBytes = defaultMaxRequestBodyBytes
+	}
+
+	if fs.metrics == nil {
+		fs.metrics = NewMetrics()
 	}
 
 	fs.heartbeatStopwatch = params.HeartbeatStopwatch
@@ -135,6 +259,18 @@ func NewFileStream(params FileStreamParams) FileStream {
 		fs.heartbeatStopwatch = waiting.NewStopwatch(defaultHeartbeatInterval)
 	}
 
+	backlogBytes := int(fs.settings.GetFileStreamBacklogBytes())
+	if backlogBytes <= 0 {
+		backlogBytes = defaultBacklogBytes
+	}
+	fs.backlog = newBacklog(backlogBytes, fs.logger, fs.printer)
+	fs.isBacklogBlocking = fs.settings.IsFileStreamBacklogBlocking()
+
+	if interval := fs.settings.GetFileStreamHeartbeatInterval(); interval > 0 {
+		fs.heartbeatStopwatch.SetDuration(interval)
+		fs.isHeartbeatIntervalFixed = true
+	}
+
 	return fs
 }
 
@@ -153,6 +289,8 @@ func (fs *fileStream) Start(
 		runID,
 	)
 
+	fs.historyAcks.start(offsetMap[HistoryChunk])
+
 	transmitChan := fs.startProcessingUpdates(fs.processChan)
 	feedbackChan := fs.startTransmitting(transmitChan, offsetMap)
 	fs.startProcessingFeedback(feedbackChan, fs.feedbackWait)
@@ -160,6 +298,12 @@ func (fs *fileStream) Start(
 
 func (fs *fileStream) StreamUpdate(update Update) {
 	fs.logger.Debug("filestream: stream update", "update", update)
+
+	// Slow down the run's logging if we can't keep up with it.
+	if _, isHistory := update.(*HistoryUpdate); isHistory && fs.isBacklogBlocking {
+		fs.backlog.WaitBelowThreshold()
+	}
+
 	select {
 	case fs.processChan <- update:
 	case <-fs.deadChan:
@@ -173,9 +317,96 @@ func (fs *fileStream) FinishWithExit(exitCode int32) {
 }
 
 func (fs *fileStream) FinishWithoutExit() {
+	start := time.Now()
+	if fs.drainTimeout > 0 {
+		timer := time.AfterFunc(fs.drainTimeout, fs.abandonDrain)
+		defer timer.Stop()
+	}
+
 	close(fs.processChan)
 	fs.feedbackWait.Wait()
-	fs.logger.Debug("filestream: closed")
+	fs.metrics.recordDrainTime(time.Since(start))
+	fs.abandon()
+
+	fs.historyAcks.finish()
+	fs.logTransmissionSummary()
+}
+
+// abandonDrain stops sending data after the drain timeout passes.
+func (fs *fileStream) abandonDrain() {
+	fs.logger.CaptureWarn(
+		"filestream: drain timeout reached, abandoning remaining requests",
+		"timeout", fs.drainTimeout,
+		"drop", fs.isDropOnDrainTimeout)
+
+	if fs.isDropOnDrainTimeout {
+		fs.printer.Writef(
+			"Timed out after %v uploading run data. Remaining data"+
+				" was not uploaded.",
+			fs.drainTimeout)
+	} else {
+		fs.printer.Writef(
+			"Timed out after %v uploading run data. Remaining data"+
+				" was saved to disk.",
+			fs.drainTimeout)
+	}
+
+	fs.abandon()
+}
+
+// logTransmissionSummary logs what the filestream sent, so that missing
+// data can be diagnosed.
+func (fs *fileStream) logTransmissionSummary() {
+	metrics := fs.metrics.Snapshot()
+
+	args := []any{
+		"requests", metrics.Requests,
+		"failed_requests", metrics.FailedRequests,
+		"retries", metrics.Retries,
+		"bytes_sent", metrics.BytesSent,
+		"dropped_lines", metrics.DroppedLines,
+		"deferred_lines", metrics.DeferredLines,
+		"drain_time", metrics.DrainTime,
+	}
+	names := make([]string, 0, len(metrics.Files))
+	for name := range metrics.Files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		file := metrics.Files[name]
+		args = append(args,
+			name, fmt.Sprintf("%d lines, %d bytes", file.Lines, file.Bytes))
+	}
+
+	fs.logger.Info("filestream: closed, transmission summary", args...)
+}
+
+func (fs *fileStream) RunShouldStop() bool {
+	return fs.runShouldStop.Load()
+}
+
+func (fs *fileStream) Metrics() MetricsSnapshot {
+	return fs.metrics.Snapshot()
+}
+
+func (fs *fileStream) AckHistory(callback func(accepted bool)) {
+	select {
+	case fs.processChan <- &historyAckUpdate{acks: fs.historyAcks, callback: callback}:
+	case <-fs.deadChan:
+		callback(false)
+	}
+}
+
+func (fs *fileStream) SetTransmitInterval(interval time.Duration) {
+	if interval <= 0 {
+		fs.throttle.SetUserLimit(0)
+	} else {
+		fs.throttle.SetUserLimit(rate.Every(interval))
+	}
+
+	fs.logger.Info("filestream: transmit interval changed", "interval", interval)
 }
 
 // logFatalAndStopWorking logs a fatal error and kills the filestream.
@@ -184,9 +415,12 @@ func (fs *fileStream) FinishWithoutExit() {
 // when we can't guarantee correctness, in which case we stop uploading
 // data but continue to save it to disk to avoid data loss.
 func (fs *fileStream) logFatalAndStopWorking(err error) {
-	fs.logger.CaptureFatal(fmt.Errorf("filestream: fatal error: %v", err))
+	fs.logger.CaptureFatal(
+		fmt.Errorf("filestream: fatal error: %v", err),
+		"metrics", fs.metrics.Snapshot())
 	fs.deadChanOnce.Do(func() {
 		close(fs.deadChan)
+		fs.backlog.Release()
 		fs.printer.Write(
 			"Fatal error while uploading data. Some run data will" +
 				" not be synced, but it will still be written to disk. Use" +
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/wandb/wandb/core/internal/api"
 )
@@ -26,6 +29,7 @@ func (fs *fileStream) startProcessingUpdates(
 		for update := range updates {
 			err := update.Apply(UpdateContext{
 				MakeRequest: func(req *FileStreamRequest) {
+					fs.historyAcks.addQueued(len(req.HistoryLines))
 					requests <- req
 				},
 
@@ -33,6 +37,7 @@ func (fs *fileStream) startProcessingUpdates(
 
 				Logger:  fs.logger,
 				Printer: fs.printer,
+				Metrics: fs.metrics,
 			})
 
 			if err != nil {
@@ -42,7 +47,10 @@ func (fs *fileStream) startProcessingUpdates(
 		}
 
 		// Flush input channel if we exited early.
-		for range updates {
+		for update := range updates {
+			if ack, ok := update.(*historyAckUpdate); ok {
+				ack.callback(false)
+			}
 		}
 	}()
 
@@ -69,17 +77,61 @@ func (fs *fileStream) startTransmitting(
 	transmissions := CollectLoop{
 		TransmitRateLimit:   fs.transmitRateLimit,
 		MaxRequestSizeBytes: int(maxRequestSizeBytes),
+		FlushSizeBytes:      int(fs.settings.GetFileStreamFlushBytes()),
+		FlushLines:          int(fs.settings.GetFileStreamFlushLines()),
+		MinBatchDelay:       fs.settings.GetFileStreamMinBatchDelay(),
+		ReportBufferSize:    fs.backlog.Set,
 	}.Start(requests)
 
+	spill := NewSpillQueue(fs.spillPath())
+
+	var checkpoint *OffsetCheckpoint
+	if syncFile := fs.settings.GetSyncFile(); syncFile != "" {
+		checkpoint = NewOffsetCheckpoint(
+			filepath.Join(filepath.Dir(syncFile), OffsetsFileName),
+			fs.path,
+		)
+		if err := checkpoint.Load(); err != nil {
+			fs.logger.CaptureError(err)
+		}
+	}
+
 	feedback := TransmitLoop{
 		HeartbeatStopwatch:     fs.heartbeatStopwatch,
 		Send:                   fs.send,
 		LogFatalAndStopWorking: fs.logFatalAndStopWorking,
+		Spill:                  spill,
+		Checkpoint:             checkpoint,
+		Metrics:                fs.metrics,
+		OnAccepted:             fs.historyAcks.accept,
+		Abandon:                fs.drainCtx.Done(),
+		DropAbandoned:          fs.isDropOnDrainTimeout,
+		Logger:                 fs.logger,
+		Printer:                fs.printer,
 	}.Start(transmissions, initialOffsets)
 
 	return feedback
 }
 
+// spillPath returns where to store requests that can't be sent yet.
+//
+// The run's sync directory is preferred, but a temporary file is used
+// if it's unset so that uploads can always recover from failures. The
+// file is deleted when the filestream finishes.
+func (fs *fileStream) spillPath() string {
+	if syncDir := fs.settings.GetSyncDir(); syncDir != "" {
+		return filepath.Join(syncDir, SpillFileName)
+	}
+
+	return filepath.Join(
+		os.TempDir(),
+		fmt.Sprintf("wandb-%s-%d-%s",
+			fs.settings.GetRunID(),
+			os.Getpid(),
+			SpillFileName),
+	)
+}
+
 // startProcessingFeedback processes feedback from the filestream API.
 //
 // This increments the wait group and decrements it after completing
@@ -92,7 +144,8 @@ func (fs *fileStream) startProcessingFeedback(
 	go func() {
 		defer wg.Done()
 
-		for range feedback {
+		for res := range feedback {
+			fs.processFeedback(res)
 		}
 	}()
 }
@@ -101,42 +154,119 @@ func (fs *fileStream) send(
 	data *FileStreamRequestJSON,
 	feedbackChan chan<- map[string]any,
 ) error {
+	parts, err := splitToFit(data, fs.maxRequestBodyBytes, fs.wireFormat)
+	if err != nil {
+		return fmt.Errorf("filestream: marshal error in send(): %v", err)
+	}
+
+	if len(parts) > 1 {
+		fs.logger.Info(
+			"filestream: splitting large request",
+			"parts", len(parts),
+			"limit", fs.maxRequestBodyBytes)
+	}
+
+	for _, part := range parts {
+		if err := fs.sendPart(part, feedbackChan); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendPart makes a request, resending it if the server rejects its
+// checksum.
+func (fs *fileStream) sendPart(
+	data *FileStreamRequestJSON,
+	feedbackChan chan<- map[string]any,
+) error {
+	for attempt := 1; ; attempt++ {
+		res, err := fs.post(data.withChecksum())
+		if err != nil {
+			return err
+		}
+
+		offsets, isRejected := checksumRejection(res)
+		if !isRejected {
+			feedbackChan <- res
+			return nil
+		}
+
+		if attempt >= maxChecksumAttempts {
+			return fmt.Errorf(
+				"filestream: server rejected request checksum %d times",
+				attempt)
+		}
+
+		fs.logger.Warn(
+			"filestream: server rejected request checksum, resending",
+			"offsets", offsets)
+		data = data.trimmedTo(offsets)
+	}
+}
+
+// post makes a request to the filestream API and returns the response.
+func (fs *fileStream) post(data *FileStreamRequestJSON) (map[string]any, error) {
 	// Stop working after death to avoid data corruption.
 	if fs.isDead() {
-		return fmt.Errorf("filestream: can't send because I am dead")
+		return nil, fmt.Errorf("filestream: can't send because I am dead")
 	}
 
-	jsonData, err := json.Marshal(data)
+	body, contentType, err := data.marshal(fs.wireFormat)
 	if err != nil {
-		return fmt.Errorf("filestream: json marshal error in send(): %v", err)
+		return nil, fmt.Errorf("filestream: marshal error in send(): %v", err)
+	}
+	if fs.wireFormat == wireFormatJSON {
+		fs.logger.Debug("filestream: post request", "request", string(body))
+	} else {
+		fs.logger.Debug("filestream: post request", "request", data)
 	}
-	fs.logger.Debug("filestream: post request", "request", string(jsonData))
 
 	req := &api.Request{
 		Method: http.MethodPost,
 		Path:   fs.path,
-		Body:   jsonData,
+		Body:   body,
 		Headers: map[string]string{
-			"Content-Type": "application/json",
+			"Content-Type": contentType,
 		},
+		Context: fs.drainCtx,
 	}
 
+	start := time.Now()
 	resp, err := fs.apiClient.Send(req)
 
+	// Rather than giving up when the server is overloaded, keep trying
+	// at the reduced rate until it accepts the request.
+	for err != nil && fs.throttle.IsThrottled() && !fs.isDead() {
+		fs.logger.Warn(
+			"filestream: rate limited after retries, waiting to resend",
+			"limit", fs.transmitRateLimit.Limit())
+		if waitErr := fs.transmitRateLimit.Wait(fs.drainCtx); waitErr != nil {
+			break
+		}
+		resp, err = fs.apiClient.Send(req)
+	}
+
+	latency := time.Since(start)
+
 	switch {
 	case err != nil:
-		return fmt.Errorf(
+		fs.metrics.recordFailedRequest()
+		return nil, fmt.Errorf(
 			"filestream: error making HTTP request: %v. got response: %v",
 			err,
 			resp,
 		)
 	case resp == nil:
 		// Sometimes resp and err can both be nil in retryablehttp's Client.
-		return fmt.Errorf("filestream: nil response and nil error for request to %v", req.Path)
+		fs.metrics.recordFailedRequest()
+		return nil, fmt.Errorf("filestream: nil response and nil error for request to %v", req.Path)
 	case resp.StatusCode < 200 || resp.StatusCode > 300:
 		// If we reach here, that means all retries were exhausted. This could
 		// mean, for instance, that the user's internet connection broke.
-		return fmt.Errorf(
+		fs.metrics.recordFailedRequest()
+		return nil, fmt.Errorf(
 			"filestream: failed to upload: %v path=%v",
 			resp.Status,
 			req.Path,
@@ -150,13 +280,16 @@ func (fs *fileStream) send(
 		}
 	}(resp.Body)
 
+	fs.metrics.recordRequest(len(body), latency)
+	fs.metrics.recordSentFiles(data)
+	fs.wireFormat = wireFormatFromHeader(resp.Header)
+
 	var res map[string]interface{}
 	err = json.NewDecoder(resp.Body).Decode(&res)
 	if err != nil {
 		fs.logger.CaptureError(
 			fmt.Errorf("filestream: json decode error: %v", err))
 	}
-	feedbackChan <- res
 	fs.logger.Debug("filestream: post response", "response", res)
-	return nil
+	return res, nil
 }
@@ -83,6 +83,39 @@ func (r *FileStreamRequest) Merge(next *FileStreamRequest) {
 	}
 }
 
+// sizeBytes returns the approximate size of the request's data.
+func (r *FileStreamRequest) sizeBytes() int {
+	size := len(r.LatestSummary)
+
+	for _, line := range r.HistoryLines {
+		size += len(line)
+	}
+	for _, line := range r.EventsLines {
+		size += len(line)
+	}
+	r.ConsoleLines.ForEach(func(_ int, line string) {
+		size += len(line)
+	})
+
+	return size
+}
+
+// numLines returns the number of history, events and console lines
+// in the request.
+func (r *FileStreamRequest) numLines() int {
+	return len(r.HistoryLines) + len(r.EventsLines) + r.ConsoleLines.Len()
+}
+
+// hasPriorityData reports whether the request contains small, important
+// data that should not wait behind bulk lines.
+//
+// This is the summary, uploaded files and the preempting signal. The
+// exit code is not included because the server treats it as the end of
+// the run's data, so it must be sent last.
+func (r *FileStreamRequest) hasPriorityData() bool {
+	return r.LatestSummary != "" || len(r.UploadedFiles) > 0 || r.Preempting
+}
+
 // FileStreamRequestJSON is the actual JSON request we make to the API.
 //
 // A [FileStreamRequest] sometimes requires multiple JSON requests to
@@ -99,6 +132,28 @@ type FileStreamRequestJSON struct {
 
 	Complete *bool  `json:"complete,omitempty"`
 	ExitCode *int32 `json:"exitcode,omitempty"`
+
+	// Checksum is a hash of the request's file contents and offsets,
+	// set just before the request is sent.
+	Checksum string `json:"checksum,omitempty"`
+}
+
+// isEmpty reports whether the request carries no data, like a heartbeat.
+func (r *FileStreamRequestJSON) isEmpty() bool {
+	return len(r.Files) == 0 &&
+		len(r.Uploaded) == 0 &&
+		r.Preempting == nil &&
+		r.Complete == nil &&
+		r.ExitCode == nil
+}
+
+// numLines returns the number of lines in the request's files.
+func (r *FileStreamRequestJSON) numLines() int {
+	lines := 0
+	for _, chunk := range r.Files {
+		lines += len(chunk.Content)
+	}
+	return lines
 }
 
 // offsetAndContent is a run of lines to update in a filestream file.
@@ -127,6 +182,19 @@ type FileStreamRequestReader struct {
 	// isFullRequest is whether the entire [FileStreamRequest] can
 	// be represented by a single JSON request.
 	isFullRequest bool
+
+	// isPriorityOnly is whether to send only the request's priority data.
+	isPriorityOnly bool
+}
+
+// NewPriorityRequestReader makes a request reader that consumes only the
+// request's summary, uploaded files and preempting signal.
+//
+// This lets those be sent in a small request ahead of a large backlog
+// of lines. Like [NewRequestReader], the reader takes ownership of
+// the request.
+func NewPriorityRequestReader(request *FileStreamRequest) *FileStreamRequestReader {
+	return &FileStreamRequestReader{request: request, isPriorityOnly: true}
 }
 
 // NewRequestReader makes a request reader and computes the request's size.
@@ -246,6 +314,11 @@ func (r *FileStreamRequestReader) GetJSON(
 		Files: map[string]offsetAndContent{},
 	}
 
+	if r.isPriorityOnly {
+		r.addPriorityData(json, state)
+		return json
+	}
+
 	if r.historyLinesToSend > 0 {
 		json.Files[HistoryFileName] = offsetAndContent{
 			Offset:  state.HistoryLineNum,
@@ -260,12 +333,6 @@ func (r *FileStreamRequestReader) GetJSON(
 		}
 		state.EventsLineNum += r.eventsLinesToSend
 	}
-	if r.request.LatestSummary != "" {
-		json.Files[SummaryFileName] = offsetAndContent{
-			Offset:  state.SummaryLineNum,
-			Content: []string{r.request.LatestSummary},
-		}
-	}
 	if len(r.consoleLineRuns) > 0 {
 		run := r.consoleLineRuns[0]
 		json.Files[OutputFileName] = offsetAndContent{
@@ -274,15 +341,7 @@ func (r *FileStreamRequestReader) GetJSON(
 		}
 	}
 
-	json.Uploaded = make([]string, 0, len(r.request.UploadedFiles))
-	for file := range r.request.UploadedFiles {
-		json.Uploaded = append(json.Uploaded, file)
-	}
-
-	if r.request.Preempting {
-		boolTrue := true
-		json.Preempting = &boolTrue
-	}
+	r.addPriorityData(json, state)
 
 	if r.request.Complete && r.isFullRequest {
 		boolTrue := true
@@ -294,10 +353,45 @@ func (r *FileStreamRequestReader) GetJSON(
 	return json
 }
 
+// addPriorityData adds the summary, uploaded files and preempting
+// signal to the JSON request.
+func (r *FileStreamRequestReader) addPriorityData(
+	json *FileStreamRequestJSON,
+	state *FileStreamState,
+) {
+	if r.request.LatestSummary != "" {
+		json.Files[SummaryFileName] = offsetAndContent{
+			Offset:  state.SummaryLineNum,
+			Content: []string{r.request.LatestSummary},
+		}
+	}
+
+	json.Uploaded = make([]string, 0, len(r.request.UploadedFiles))
+	for file := range r.request.UploadedFiles {
+		json.Uploaded = append(json.Uploaded, file)
+	}
+
+	if r.request.Preempting {
+		boolTrue := true
+		json.Preempting = &boolTrue
+	}
+}
+
 // Next returns the request minus the data consumed in [GetJSON].
 //
 // The second return value indicates whether the entire request was consumed.
 func (r *FileStreamRequestReader) Next() (*FileStreamRequest, bool) {
+	if r.isPriorityOnly {
+		next := &FileStreamRequest{
+			HistoryLines: r.request.HistoryLines,
+			EventsLines:  r.request.EventsLines,
+			Complete:     r.request.Complete,
+			ExitCode:     r.request.ExitCode,
+		}
+		next.ConsoleLines.Update(r.request.ConsoleLines)
+		return next, next.numLines() == 0 && !next.Complete
+	}
+
 	next := &FileStreamRequest{
 		HistoryLines: slices.Clone(
 			r.request.HistoryLines[r.historyLinesToSend:]),
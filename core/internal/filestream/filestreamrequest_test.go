@@ -279,3 +279,32 @@ func TestExitCode_Read_NotDone(t *testing.T) {
 	assert.Nil(t, json.Complete)
 	assert.Nil(t, json.ExitCode)
 }
+
+func TestPriority_ReadOnlyPriorityData(t *testing.T) {
+	request := &FileStreamRequest{
+		HistoryLines:  []string{"history"},
+		LatestSummary: "summary",
+		UploadedFiles: map[string]struct{}{"file": {}},
+		Preempting:    true,
+		Complete:      true,
+		ExitCode:      1,
+	}
+	request.ConsoleLines.Put(0, "console")
+	reader := NewPriorityRequestReader(request)
+	state := &FileStreamState{SummaryLineNum: 2}
+
+	json := reader.GetJSON(state)
+	next, done := reader.Next()
+
+	assert.Len(t, json.Files, 1)
+	assert.Equal(t, 2, json.Files[SummaryFileName].Offset)
+	assert.Equal(t, []string{"summary"}, json.Files[SummaryFileName].Content)
+	assert.Equal(t, []string{"file"}, json.Uploaded)
+	assert.True(t, *json.Preempting)
+	assert.Nil(t, json.Complete)
+	assert.Equal(t, []string{"history"}, next.HistoryLines)
+	assert.Equal(t, 1, next.ConsoleLines.Len())
+	assert.Empty(t, next.LatestSummary)
+	assert.True(t, next.Complete)
+	assert.False(t, done)
+}
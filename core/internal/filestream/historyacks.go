@@ -0,0 +1,119 @@
+package filestream
+
+import "sync"
+
+// historyAcks tells callers when history lines are accepted by the server.
+//
+// Lines are identified by their line number in the history file. The
+// number of lines queued is counted as updates are processed, and the
+// number accepted as requests succeed.
+type historyAcks struct {
+	mu sync.Mutex
+
+	// queued is one more than the line number of the last queued line.
+	queued int
+
+	// accepted is one more than the line number of the last line that
+	// the server accepted.
+	accepted int
+
+	// pending are callbacks waiting for lines to be accepted,
+	// in increasing order of line.
+	pending []pendingHistoryAck
+
+	// isFinished is whether no more lines will be accepted.
+	isFinished bool
+}
+
+// pendingHistoryAck is a callback waiting for all lines before line
+// to be accepted.
+type pendingHistoryAck struct {
+	line     int
+	callback func(accepted bool)
+}
+
+// start sets the number of lines that were uploaded before this process,
+// if the run is being resumed.
+func (a *historyAcks) start(initialOffset int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.queued = initialOffset
+	a.accepted = initialOffset
+}
+
+// addQueued counts lines queued for upload.
+func (a *historyAcks) addQueued(n int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.queued += n
+}
+
+// waitForQueued invokes the callback once all queued lines are accepted.
+//
+// The callback is invoked with false if the filestream finishes first.
+// It may be invoked immediately, and otherwise runs on the goroutine
+// making requests, so it must not block.
+func (a *historyAcks) waitForQueued(callback func(accepted bool)) {
+	a.mu.Lock()
+
+	switch {
+	case a.accepted >= a.queued:
+		a.mu.Unlock()
+		callback(true)
+	case a.isFinished:
+		a.mu.Unlock()
+		callback(false)
+	default:
+		a.pending = append(a.pending,
+			pendingHistoryAck{line: a.queued, callback: callback})
+		a.mu.Unlock()
+	}
+}
+
+// accept records that the server accepted a request.
+func (a *historyAcks) accept(x *FileStreamRequestJSON) {
+	chunk, ok := x.Files[HistoryFileName]
+	if !ok {
+		return
+	}
+
+	a.mu.Lock()
+	a.accepted = max(a.accepted, chunk.Offset+len(chunk.Content))
+
+	var done []pendingHistoryAck
+	for len(a.pending) > 0 && a.pending[0].line <= a.accepted {
+		done = append(done, a.pending[0])
+		a.pending = a.pending[1:]
+	}
+	a.mu.Unlock()
+
+	for _, ack := range done {
+		ack.callback(true)
+	}
+}
+
+// finish invokes all pending callbacks with false.
+func (a *historyAcks) finish() {
+	a.mu.Lock()
+	a.isFinished = true
+	pending := a.pending
+	a.pending = nil
+	a.mu.Unlock()
+
+	for _, ack := range pending {
+		ack.callback(false)
+	}
+}
+
+// historyAckUpdate waits for all history lines queued before it.
+type historyAckUpdate struct {
+	acks     *historyAcks
+	callback func(accepted bool)
+}
+
+func (u *historyAckUpdate) Apply(UpdateContext) error {
+	u.acks.waitForQueued(u.callback)
+	return nil
+}
@@ -0,0 +1,76 @@
+package filestream_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	. "github.com/wandb/wandb/core/internal/filestream"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"golang.org/x/time/rate"
+)
+
+func newAckTestFileStream(client *recordingClient) FileStream {
+	return NewFileStream(FileStreamParams{
+		Settings:          settings.From(&service.Settings{}),
+		Logger:            observability.NewNoOpLogger(),
+		Printer:           observability.NewPrinter(),
+		ApiClient:         client,
+		TransmitRateLimit: rate.NewLimiter(rate.Inf, 1),
+	})
+}
+
+func logStep(fs FileStream, step string) {
+	fs.StreamUpdate(&HistoryUpdate{
+		Record: &service.HistoryRecord{
+			Item: []*service.HistoryItem{{Key: "_step", ValueJson: step}},
+		},
+	})
+}
+
+func TestAckHistory_AfterAccepted(t *testing.T) {
+	client := &recordingClient{}
+	fs := newAckTestFileStream(client)
+	acks := make(chan bool, 1)
+
+	fs.Start("entity", "project", "run", nil)
+	logStep(fs, "0")
+	logStep(fs, "1")
+	fs.AckHistory(func(accepted bool) { acks <- accepted })
+	fs.FinishWithExit(0)
+
+	assert.True(t, <-acks)
+	var historyLines int
+	for _, request := range sentRequests(t, client) {
+		historyLines += len(request.Files[HistoryFileName].Content)
+	}
+	assert.Equal(t, 2, historyLines)
+}
+
+func TestAckHistory_NoHistory(t *testing.T) {
+	fs := newAckTestFileStream(&recordingClient{})
+	acks := make(chan bool, 1)
+
+	fs.Start("entity", "project", "run", FileStreamOffsetMap{HistoryChunk: 5})
+	fs.AckHistory(func(accepted bool) { acks <- accepted })
+
+	assert.True(t, <-acks)
+	fs.FinishWithExit(0)
+}
+
+func TestAckHistory_NotAcceptedIfFailed(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir()) // for the spill queue
+	client := &recordingClient{
+		body: `{"checksum_rejected": {}}`,
+	}
+	fs := newAckTestFileStream(client)
+	acks := make(chan bool, 1)
+
+	fs.Start("entity", "project", "run", nil)
+	logStep(fs, "0")
+	fs.AckHistory(func(accepted bool) { acks <- accepted })
+	fs.FinishWithExit(0)
+
+	assert.False(t, <-acks)
+}
@@ -0,0 +1,218 @@
+package filestream
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// Metrics counts the work done by a filestream.
+//
+// These are for diagnosing delayed or missing run data, such as when
+// requests are slow, retried often, or when lines are dropped for being
+// too large. A nil *Metrics is valid and records nothing.
+type Metrics struct {
+	requests       atomic.Int64
+	failedRequests atomic.Int64
+	retries        atomic.Int64
+
+	bytesSent       atomic.Int64
+	maxRequestBytes atomic.Int64
+
+	totalLatency atomic.Int64 // nanoseconds
+	maxLatency   atomic.Int64 // nanoseconds
+
+	droppedLines  atomic.Int64
+	deferredLines atomic.Int64
+
+	drainTime atomic.Int64 // nanoseconds
+
+	filesMu sync.Mutex
+	files   map[string]FileMetrics
+}
+
+// FileMetrics counts the data sent for one filestream file.
+type FileMetrics struct {
+	// Lines is the number of lines sent, including lines that were
+	// sent again after a failure.
+	Lines int64
+
+	// Bytes is the total length of the lines sent.
+	Bytes int64
+}
+
+// MetricsSnapshot is the state of Metrics at one time.
+type MetricsSnapshot struct {
+	// Requests is the number of requests that succeeded.
+	Requests int64
+
+	// FailedRequests is the number of requests that failed after
+	// exhausting all retries.
+	FailedRequests int64
+
+	// Retries is the number of times a request was retried.
+	Retries int64
+
+	// BytesSent is the total size of the bodies of successful requests.
+	BytesSent int64
+
+	// MaxRequestBytes is the size of the largest request body sent.
+	MaxRequestBytes int64
+
+	// MeanLatency is the average time to complete a successful request,
+	// including retries.
+	MeanLatency time.Duration
+
+	// MaxLatency is the longest time taken by a successful request.
+	MaxLatency time.Duration
+
+	// DroppedLines is the number of lines that were never uploaded
+	// because they exceeded the size limit.
+	DroppedLines int64
+
+	// DeferredLines is the number of lines spilled to disk to be sent
+	// later because a request failed.
+	DeferredLines int64
+
+	// Files counts the data sent for each file, such as the history
+	// and console output, by file name.
+	Files map[string]FileMetrics
+
+	// DrainTime is how long it took to send remaining data when the
+	// filestream was finished, or 0 if it is not finished.
+	DrainTime time.Duration
+}
+
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+// Snapshot returns the current values of the metrics.
+//
+// The values are read separately, so they may be slightly out of sync.
+func (m *Metrics) Snapshot() MetricsSnapshot {
+	if m == nil {
+		return MetricsSnapshot{}
+	}
+
+	snapshot := MetricsSnapshot{
+		Requests:        m.requests.Load(),
+		FailedRequests:  m.failedRequests.Load(),
+		Retries:         m.retries.Load(),
+		BytesSent:       m.bytesSent.Load(),
+		MaxRequestBytes: m.maxRequestBytes.Load(),
+		MaxLatency:      time.Duration(m.maxLatency.Load()),
+		DroppedLines:    m.droppedLines.Load(),
+		DeferredLines:   m.deferredLines.Load(),
+		DrainTime:       time.Duration(m.drainTime.Load()),
+	}
+
+	m.filesMu.Lock()
+	snapshot.Files = maps.Clone(m.files)
+	m.filesMu.Unlock()
+
+	if snapshot.Requests > 0 {
+		snapshot.MeanLatency = time.Duration(
+			m.totalLatency.Load() / snapshot.Requests)
+	}
+
+	return snapshot
+}
+
+// CheckRetry wraps a retry policy to count retried requests.
+func (m *Metrics) CheckRetry(policy retryablehttp.CheckRetry) retryablehttp.CheckRetry {
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		shouldRetry, err := policy(ctx, resp, err)
+		if shouldRetry && m != nil {
+			m.retries.Add(1)
+		}
+		return shouldRetry, err
+	}
+}
+
+// recordRequest counts a request that succeeded.
+func (m *Metrics) recordRequest(bodyBytes int, latency time.Duration) {
+	if m == nil {
+		return
+	}
+
+	m.requests.Add(1)
+	m.bytesSent.Add(int64(bodyBytes))
+	m.totalLatency.Add(int64(latency))
+	storeMax(&m.maxRequestBytes, int64(bodyBytes))
+	storeMax(&m.maxLatency, int64(latency))
+}
+
+// recordSentFiles counts the lines in a request that succeeded.
+func (m *Metrics) recordSentFiles(x *FileStreamRequestJSON) {
+	if m == nil || len(x.Files) == 0 {
+		return
+	}
+
+	m.filesMu.Lock()
+	defer m.filesMu.Unlock()
+
+	if m.files == nil {
+		m.files = make(map[string]FileMetrics)
+	}
+
+	for name, chunk := range x.Files {
+		file := m.files[name]
+		file.Lines += int64(len(chunk.Content))
+		for _, line := range chunk.Content {
+			file.Bytes += int64(len(line))
+		}
+		m.files[name] = file
+	}
+}
+
+// recordDrainTime records how long it took to finish the filestream.
+func (m *Metrics) recordDrainTime(duration time.Duration) {
+	if m == nil {
+		return
+	}
+
+	m.drainTime.Store(int64(duration))
+}
+
+// recordFailedRequest counts a request that failed.
+func (m *Metrics) recordFailedRequest() {
+	if m == nil {
+		return
+	}
+
+	m.failedRequests.Add(1)
+}
+
+// recordDroppedLines counts lines that will never be uploaded.
+func (m *Metrics) recordDroppedLines(n int) {
+	if m == nil {
+		return
+	}
+
+	m.droppedLines.Add(int64(n))
+}
+
+// recordDeferredLines counts lines that were spilled to disk.
+func (m *Metrics) recordDeferredLines(n int) {
+	if m == nil {
+		return
+	}
+
+	m.deferredLines.Add(int64(n))
+}
+
+// storeMax sets x to value if value is larger.
+func storeMax(x *atomic.Int64, value int64) {
+	for {
+		old := x.Load()
+		if value <= old || x.CompareAndSwap(old, value) {
+			return
+		}
+	}
+}
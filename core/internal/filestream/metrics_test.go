@@ -0,0 +1,91 @@
+package filestream_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	. "github.com/wandb/wandb/core/internal/filestream"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestMetrics_CountsRequestsAndDroppedLines(t *testing.T) {
+	metrics := NewMetrics()
+	fs := NewFileStream(FileStreamParams{
+		Settings: settings.From(&service.Settings{
+			XFileStreamMaxLineBytes: wrapperspb.Int32(5),
+		}),
+		Logger:            observability.NewNoOpLogger(),
+		Printer:           observability.NewPrinter(),
+		ApiClient:         &recordingClient{},
+		TransmitRateLimit: rate.NewLimiter(rate.Inf, 1),
+		Metrics:           metrics,
+	})
+
+	fs.Start("entity", "project", "run", nil)
+	fs.StreamUpdate(&HistoryUpdate{
+		Record: &service.HistoryRecord{
+			Item: []*service.HistoryItem{{Key: "_step", ValueJson: "123"}},
+		},
+	})
+	fs.FinishWithExit(0)
+
+	snapshot := fs.Metrics()
+	assert.Equal(t, metrics.Snapshot(), snapshot)
+	assert.Positive(t, snapshot.Requests)
+	assert.Positive(t, snapshot.BytesSent)
+	assert.Positive(t, snapshot.MaxRequestBytes)
+	assert.Zero(t, snapshot.FailedRequests)
+	assert.EqualValues(t, 1, snapshot.DroppedLines)
+}
+
+func TestMetrics_CheckRetryCountsRetries(t *testing.T) {
+	metrics := NewMetrics()
+	retryOnServerError := metrics.CheckRetry(
+		func(_ context.Context, resp *http.Response, _ error) (bool, error) {
+			return resp.StatusCode >= 500, nil
+		})
+
+	_, _ = retryOnServerError(context.Background(), &http.Response{StatusCode: 503}, nil)
+	_, _ = retryOnServerError(context.Background(), &http.Response{StatusCode: 503}, nil)
+	_, _ = retryOnServerError(context.Background(), &http.Response{StatusCode: 200}, nil)
+
+	assert.EqualValues(t, 2, metrics.Snapshot().Retries)
+}
+
+func TestMetrics_NilIsEmpty(t *testing.T) {
+	var metrics *Metrics
+
+	assert.Equal(t, MetricsSnapshot{}, metrics.Snapshot())
+}
+
+func TestMetrics_TransmissionSummary(t *testing.T) {
+	fs := NewFileStream(FileStreamParams{
+		Settings:          settings.From(&service.Settings{}),
+		Logger:            observability.NewNoOpLogger(),
+		Printer:           observability.NewPrinter(),
+		ApiClient:         &recordingClient{},
+		TransmitRateLimit: rate.NewLimiter(rate.Inf, 1),
+	})
+
+	fs.Start("entity", "project", "run", nil)
+	for _, step := range []string{"1", "2"} {
+		fs.StreamUpdate(&HistoryUpdate{
+			Record: &service.HistoryRecord{
+				Item: []*service.HistoryItem{{Key: "_step", ValueJson: step}},
+			},
+		})
+	}
+	fs.FinishWithExit(0)
+
+	snapshot := fs.Metrics()
+	assert.Equal(t,
+		FileMetrics{Lines: 2, Bytes: int64(2 * len(`{"_step":1}`))},
+		snapshot.Files[HistoryFileName])
+	assert.Positive(t, snapshot.DrainTime)
+}
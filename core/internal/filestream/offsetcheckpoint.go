@@ -0,0 +1,153 @@
+package filestream
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"time"
+)
+
+const (
+	// OffsetsFileName is the name of the file storing acknowledged
+	// filestream offsets, kept next to the run's transaction log.
+	OffsetsFileName = "filestream-offsets.json"
+
+	// defaultOffsetSaveInterval is how often acknowledged offsets
+	// are written to disk.
+	defaultOffsetSaveInterval = 10 * time.Second
+)
+
+// OffsetCheckpoint tracks how many history and system metrics lines the
+// server has acknowledged, and periodically saves this to disk.
+//
+// When a crashed run's transaction log is replayed, for example by
+// `wandb sync`, the saved offsets let us skip history and system metrics
+// lines that were already uploaded instead of sending them again.
+// Console lines are never skipped since they may be updated in place.
+//
+// An OffsetCheckpoint is not safe for concurrent use.
+type OffsetCheckpoint struct {
+	path string
+
+	// runPath identifies the run; offsets saved for other runs are ignored.
+	runPath string
+
+	// acked maps file names to the number of lines acknowledged.
+	//
+	// Only files that Trim skips are tracked.
+	acked map[string]int
+
+	// saveInterval is the minimum time between saves in Acknowledge.
+	saveInterval time.Duration
+
+	lastSave time.Time
+	isDirty  bool
+}
+
+// offsetCheckpointJSON is the on-disk format of an OffsetCheckpoint.
+type offsetCheckpointJSON struct {
+	Run     string         `json:"run"`
+	Offsets map[string]int `json:"offsets"`
+}
+
+// NewOffsetCheckpoint returns an empty checkpoint for the run whose
+// filestream API path is runPath, saved at the given path.
+func NewOffsetCheckpoint(path, runPath string) *OffsetCheckpoint {
+	return &OffsetCheckpoint{
+		path:         path,
+		runPath:      runPath,
+		acked:        make(map[string]int),
+		saveInterval: defaultOffsetSaveInterval,
+		lastSave:     time.Now(),
+	}
+}
+
+// Load reads previously saved offsets, if any.
+//
+// Nothing is loaded if the file does not exist or belongs to a different run.
+func (c *OffsetCheckpoint) Load() error {
+	data, err := os.ReadFile(c.path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil
+	case err != nil:
+		return fmt.Errorf("filestream: failed to read offsets: %v", err)
+	}
+
+	var saved offsetCheckpointJSON
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return fmt.Errorf("filestream: failed to parse offsets: %v", err)
+	}
+
+	if saved.Run == c.runPath {
+		maps.Copy(c.acked, saved.Offsets)
+	}
+
+	return nil
+}
+
+// Trim removes history and system metrics lines that were already
+// acknowledged from the request.
+//
+// The given request is not modified.
+func (c *OffsetCheckpoint) Trim(x *FileStreamRequestJSON) *FileStreamRequestJSON {
+	return x.trimmedTo(map[string]int{
+		HistoryFileName: c.acked[HistoryFileName],
+		EventsFileName:  c.acked[EventsFileName],
+	})
+}
+
+// Acknowledge records that the server accepted the request.
+//
+// Offsets are saved if enough time has passed since the last save.
+func (c *OffsetCheckpoint) Acknowledge(x *FileStreamRequestJSON) error {
+	for _, name := range []string{HistoryFileName, EventsFileName} {
+		chunk, ok := x.Files[name]
+		if !ok {
+			continue
+		}
+
+		end := chunk.Offset + len(chunk.Content)
+		if end > c.acked[name] {
+			c.acked[name] = end
+			c.isDirty = true
+		}
+	}
+
+	if time.Since(c.lastSave) < c.saveInterval {
+		return nil
+	}
+
+	return c.Save()
+}
+
+// Save writes the acknowledged offsets to disk if they changed.
+func (c *OffsetCheckpoint) Save() error {
+	if !c.isDirty {
+		return nil
+	}
+
+	data, err := json.Marshal(offsetCheckpointJSON{
+		Run:     c.runPath,
+		Offsets: c.acked,
+	})
+	if err != nil {
+		return fmt.Errorf("filestream: failed to encode offsets: %v", err)
+	}
+
+	// Write to a temporary file first so that a crash can't leave
+	// a partially written checkpoint.
+	tmpPath := c.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("filestream: failed to save offsets: %v", err)
+	}
+	if err := os.Rename(tmpPath, c.path); err != nil {
+		return fmt.Errorf("filestream: failed to save offsets: %v", err)
+	}
+
+	c.lastSave = time.Now()
+	c.isDirty = false
+	return nil
+}
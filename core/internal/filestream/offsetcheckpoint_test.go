@@ -0,0 +1,95 @@
+package filestream_test
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/wandb/wandb/core/internal/filestream"
+)
+
+// requestJSON parses a filestream request from its JSON form.
+func requestJSON(t *testing.T, data string) *FileStreamRequestJSON {
+	t.Helper()
+	request := &FileStreamRequestJSON{}
+	require.NoError(t, json.Unmarshal([]byte(data), request))
+	return request
+}
+
+func TestOffsetCheckpoint_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), OffsetsFileName)
+	checkpoint := NewOffsetCheckpoint(path, "files/e/p/r/file_stream")
+	require.NoError(t, checkpoint.Acknowledge(requestJSON(t, `{"files": {
+		"wandb-history.jsonl": {"offset": 0, "content": ["a", "b", "c"]}
+	}}`)))
+	require.NoError(t, checkpoint.Save())
+
+	loaded := NewOffsetCheckpoint(path, "files/e/p/r/file_stream")
+	require.NoError(t, loaded.Load())
+	trimmed := loaded.Trim(requestJSON(t, `{"files": {
+		"wandb-history.jsonl": {"offset": 1, "content": ["b", "c", "d"]},
+		"wandb-events.jsonl": {"offset": 0, "content": ["x"]},
+		"output.log": {"offset": 0, "content": ["out"]}
+	}}`))
+
+	assert.Equal(t,
+		requestJSON(t, `{"files": {
+			"wandb-history.jsonl": {"offset": 3, "content": ["d"]},
+			"wandb-events.jsonl": {"offset": 0, "content": ["x"]},
+			"output.log": {"offset": 0, "content": ["out"]}
+		}}`),
+		trimmed)
+}
+
+func TestOffsetCheckpoint_TrimsFullyAcknowledgedFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), OffsetsFileName)
+	checkpoint := NewOffsetCheckpoint(path, "run")
+	require.NoError(t, checkpoint.Acknowledge(requestJSON(t, `{"files": {
+		"wandb-events.jsonl": {"offset": 0, "content": ["x", "y"]}
+	}}`)))
+	original := requestJSON(t, `{"files": {
+		"wandb-events.jsonl": {"offset": 0, "content": ["x", "y"]}
+	}}`)
+
+	trimmed := checkpoint.Trim(original)
+
+	assert.Empty(t, trimmed.Files)
+	assert.Len(t, original.Files, 1, "original request was modified")
+}
+
+func TestOffsetCheckpoint_IgnoresOtherRuns(t *testing.T) {
+	path := filepath.Join(t.TempDir(), OffsetsFileName)
+	checkpoint := NewOffsetCheckpoint(path, "run1")
+	require.NoError(t, checkpoint.Acknowledge(requestJSON(t, `{"files": {
+		"wandb-history.jsonl": {"offset": 0, "content": ["a"]}
+	}}`)))
+	require.NoError(t, checkpoint.Save())
+
+	other := NewOffsetCheckpoint(path, "run2")
+	require.NoError(t, other.Load())
+	request := requestJSON(t, `{"files": {
+		"wandb-history.jsonl": {"offset": 0, "content": ["a"]}
+	}}`)
+
+	assert.Equal(t, request, other.Trim(request))
+}
+
+func TestOffsetCheckpoint_IgnoresConsoleOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), OffsetsFileName)
+	checkpoint := NewOffsetCheckpoint(path, "run")
+	require.NoError(t, checkpoint.Acknowledge(requestJSON(t, `{"files": {
+		"output.log": {"offset": 0, "content": ["out"]}
+	}}`)))
+
+	require.NoError(t, checkpoint.Save())
+
+	assert.NoFileExists(t, path)
+}
+
+func TestOffsetCheckpoint_LoadMissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), OffsetsFileName)
+
+	assert.NoError(t, NewOffsetCheckpoint(path, "run").Load())
+}
@@ -0,0 +1,117 @@
+package filestream
+
+// defaultMaxRequestBodyBytes is the default hard limit on the size of
+// a request body.
+//
+// It is well above the approximate limit used when batching lines, so
+// that it only applies when lines are unusually expensive to encode.
+const defaultMaxRequestBodyBytes = 32 << 20 // 32 MB
+
+// splitToFit splits a request into a sequence of requests whose
+// encoded bodies are at most maxBytes.
+//
+// Offsets are preserved so that sending the requests in order has the
+// same effect as sending the original. Priority data goes in the first
+// request and the exit code in the last. A request that cannot be split
+// further, such as one with a single huge line, is returned as is.
+func splitToFit(
+	request *FileStreamRequestJSON,
+	maxBytes int,
+	format wireFormat,
+) ([]*FileStreamRequestJSON, error) {
+	body, _, err := request.withChecksum().marshal(format)
+	if err != nil {
+		return nil, err
+	}
+	if len(body) <= maxBytes {
+		return []*FileStreamRequestJSON{request}, nil
+	}
+
+	first, rest, ok := request.split()
+	if !ok {
+		return []*FileStreamRequestJSON{request}, nil
+	}
+
+	firstParts, err := splitToFit(first, maxBytes, format)
+	if err != nil {
+		return nil, err
+	}
+	restParts, err := splitToFit(rest, maxBytes, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(firstParts, restParts...), nil
+}
+
+// split divides the request's lines into two roughly equal requests.
+//
+// The file with the most lines is split in half. If every file has
+// at most one line, the largest file is moved to the second request
+// instead. Returns false if the request has at most one line.
+func (r *FileStreamRequestJSON) split() (
+	first *FileStreamRequestJSON,
+	rest *FileStreamRequestJSON,
+	ok bool,
+) {
+	var splitName string
+	var splitChunk offsetAndContent
+	for _, name := range []string{HistoryFileName, EventsFileName, OutputFileName} {
+		chunk, exists := r.Files[name]
+		if exists && len(chunk.Content) > len(splitChunk.Content) {
+			splitName = name
+			splitChunk = chunk
+		}
+	}
+
+	first = &FileStreamRequestJSON{
+		Files:      make(map[string]offsetAndContent, len(r.Files)),
+		Uploaded:   r.Uploaded,
+		Preempting: r.Preempting,
+	}
+	rest = &FileStreamRequestJSON{
+		Files:    make(map[string]offsetAndContent),
+		Complete: r.Complete,
+		ExitCode: r.ExitCode,
+	}
+
+	switch {
+	case len(splitChunk.Content) > 1:
+		half := len(splitChunk.Content) / 2
+		for name, chunk := range r.Files {
+			first.Files[name] = chunk
+		}
+		first.Files[splitName] = offsetAndContent{
+			Offset:  splitChunk.Offset,
+			Content: splitChunk.Content[:half],
+		}
+		rest.Files[splitName] = offsetAndContent{
+			Offset:  splitChunk.Offset + half,
+			Content: splitChunk.Content[half:],
+		}
+
+	case len(r.Files) > 1:
+		largestName, largestSize := "", -1
+		for name, chunk := range r.Files {
+			size := 0
+			for _, line := range chunk.Content {
+				size += len(line)
+			}
+			if size > largestSize {
+				largestName, largestSize = name, size
+			}
+		}
+		for name, chunk := range r.Files {
+			if name == largestName {
+				rest.Files[name] = chunk
+			} else {
+				first.Files[name] = chunk
+			}
+		}
+
+	default:
+		return nil, nil, false
+	}
+
+	return first, rest, true
+}
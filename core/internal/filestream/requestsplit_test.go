@@ -0,0 +1,57 @@
+package filestream_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/wandb/wandb/core/internal/filestream"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestSplit_LargeRequestsAreSplitInOrder(t *testing.T) {
+	client := &recordingClient{}
+	fs := NewFileStream(FileStreamParams{
+		Settings: settings.From(&service.Settings{
+			XFileStreamMaxRequestBytes: wrapperspb.Int32(500),
+		}),
+		Logger:            observability.NewNoOpLogger(),
+		Printer:           observability.NewPrinter(),
+		ApiClient:         client,
+		TransmitRateLimit: rate.NewLimiter(rate.Every(time.Hour), 1),
+	})
+
+	fs.Start("entity", "project", "run", nil)
+	for i := range 10 {
+		fs.StreamUpdate(&HistoryUpdate{
+			Record: &service.HistoryRecord{
+				Item: []*service.HistoryItem{
+					{Key: "_step", ValueJson: fmt.Sprint(i)},
+					{Key: "x", ValueJson: `"` + strings.Repeat("x", 100) + `"`},
+				},
+			},
+		})
+	}
+	fs.FinishWithExit(0)
+
+	nextOffset := 0
+	for i, request := range sentRequests(t, client) {
+		isLast := i == len(client.requests)-1
+		assert.LessOrEqual(t, len(client.requests[i].Body), 500)
+		assert.Equal(t, isLast, request.Complete != nil)
+
+		if history, ok := request.Files[HistoryFileName]; ok {
+			assert.Equal(t, nextOffset, history.Offset)
+			nextOffset += len(history.Content)
+		}
+	}
+	require.Greater(t, len(client.requests), 2)
+	assert.Equal(t, 10, nextOffset)
+}
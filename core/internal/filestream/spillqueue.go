@@ -0,0 +1,157 @@
+package filestream
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// SpillFileName is the name of the spill queue file in the run directory.
+const SpillFileName = "filestream-spill.jsonl"
+
+// SpillQueue is an on-disk overflow buffer for filestream requests that
+// could not be sent.
+//
+// It keeps memory use bounded while the backend is unreachable, but it
+// is not a durable store. Requests are appended to a file as JSON lines
+// and consumed in order, and the file is deleted once the queue is empty
+// or removed, including when the filestream finishes with requests left
+// in it. A file left behind by a process that was killed is never
+// replayed, and a new queue at the same path overwrites it. The run's
+// transaction log is the source of truth: data that was never sent is
+// uploaded from it with `wandb sync`.
+//
+// A SpillQueue is not safe for concurrent use.
+type SpillQueue struct {
+	path string
+
+	// file is the open queue file, or nil if the queue is empty.
+	file *os.File
+
+	// readOffset is the position of the first unconsumed request.
+	readOffset int64
+
+	// nextOffset is the position after the request returned by Peek.
+	nextOffset int64
+
+	length int
+}
+
+// NewSpillQueue returns an empty queue that stores data at the given path.
+//
+// The file is not created until the first request is pushed, and any
+// existing file at the path is overwritten.
+func NewSpillQueue(path string) *SpillQueue {
+	return &SpillQueue{path: path}
+}
+
+// Path returns the location of the queue file.
+func (q *SpillQueue) Path() string {
+	return q.path
+}
+
+// Len returns the number of requests in the queue.
+func (q *SpillQueue) Len() int {
+	return q.length
+}
+
+// Push appends a request to the end of the queue.
+func (q *SpillQueue) Push(request *FileStreamRequestJSON) error {
+	if q.file == nil {
+		file, err := os.OpenFile(
+			q.path,
+			os.O_RDWR|os.O_CREATE|os.O_TRUNC|os.O_APPEND,
+			0o600,
+		)
+		if err != nil {
+			return fmt.Errorf("filestream: failed to create spill file: %v", err)
+		}
+
+		q.file = file
+	}
+
+	line, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("filestream: failed to encode spilled request: %v", err)
+	}
+
+	if _, err := q.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("filestream: failed to write spill file: %v", err)
+	}
+
+	q.length++
+	return nil
+}
+
+// Peek returns the request at the front of the queue without removing it.
+func (q *SpillQueue) Peek() (*FileStreamRequestJSON, error) {
+	if q.length == 0 {
+		return nil, errors.New("filestream: spill queue is empty")
+	}
+
+	reader := bufio.NewReader(
+		io.NewSectionReader(q.file, q.readOffset, 1<<62))
+	line, err := reader.ReadBytes('\n')
+	if err != nil {
+		return nil, fmt.Errorf("filestream: failed to read spill file: %v", err)
+	}
+
+	request := &FileStreamRequestJSON{}
+	if err := json.Unmarshal(line, request); err != nil {
+		return nil, fmt.Errorf("filestream: failed to decode spilled request: %v", err)
+	}
+
+	q.nextOffset = q.readOffset + int64(len(line))
+	return request, nil
+}
+
+// Pop removes the request returned by the last call to Peek.
+//
+// The queue file is deleted when the last request is removed.
+func (q *SpillQueue) Pop() error {
+	if q.nextOffset <= q.readOffset {
+		return errors.New("filestream: Pop called without Peek")
+	}
+
+	q.readOffset = q.nextOffset
+	q.length--
+
+	if q.length > 0 {
+		return nil
+	}
+
+	closeErr := q.file.Close()
+	removeErr := os.Remove(q.path)
+	q.file = nil
+	q.readOffset = 0
+	q.nextOffset = 0
+
+	if err := errors.Join(closeErr, removeErr); err != nil {
+		return fmt.Errorf("filestream: failed to delete spill file: %v", err)
+	}
+
+	return nil
+}
+
+// Remove deletes the queue file and any unsent requests in it.
+func (q *SpillQueue) Remove() error {
+	if q.file == nil {
+		return nil
+	}
+
+	closeErr := q.file.Close()
+	removeErr := os.Remove(q.path)
+	q.file = nil
+	q.readOffset = 0
+	q.nextOffset = 0
+	q.length = 0
+
+	if err := errors.Join(closeErr, removeErr); err != nil {
+		return fmt.Errorf("filestream: failed to delete spill file: %v", err)
+	}
+
+	return nil
+}
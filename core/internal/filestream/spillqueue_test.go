@@ -0,0 +1,58 @@
+package filestream_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/wandb/wandb/core/internal/filestream"
+)
+
+func TestSpillQueue_FIFO(t *testing.T) {
+	path := filepath.Join(t.TempDir(), SpillFileName)
+	q := NewSpillQueue(path)
+
+	require.NoError(t, q.Push(&FileStreamRequestJSON{Uploaded: []string{"a"}}))
+	require.NoError(t, q.Push(&FileStreamRequestJSON{Uploaded: []string{"b"}}))
+	assert.Equal(t, 2, q.Len())
+	assert.FileExists(t, path)
+
+	first, err := q.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, first.Uploaded)
+	require.NoError(t, q.Pop())
+
+	require.NoError(t, q.Push(&FileStreamRequestJSON{Uploaded: []string{"c"}}))
+
+	second, err := q.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, second.Uploaded)
+	require.NoError(t, q.Pop())
+
+	third, err := q.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c"}, third.Uploaded)
+	require.NoError(t, q.Pop())
+
+	assert.Zero(t, q.Len())
+	assert.NoFileExists(t, path)
+}
+
+func TestSpillQueue_PopWithoutPeek(t *testing.T) {
+	q := NewSpillQueue(filepath.Join(t.TempDir(), SpillFileName))
+	require.NoError(t, q.Push(&FileStreamRequestJSON{}))
+
+	assert.Error(t, q.Pop())
+}
+
+func TestSpillQueue_Remove(t *testing.T) {
+	path := filepath.Join(t.TempDir(), SpillFileName)
+	q := NewSpillQueue(path)
+	require.NoError(t, q.Push(&FileStreamRequestJSON{}))
+
+	require.NoError(t, q.Remove())
+
+	assert.Zero(t, q.Len())
+	assert.NoFileExists(t, path)
+}
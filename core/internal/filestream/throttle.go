@@ -0,0 +1,164 @@
+package filestream
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/wandb/wandb/core/internal/api"
+	"golang.org/x/time/rate"
+)
+
+// maxThrottledInterval is the longest we wait between requests while
+// the server is asking us to slow down.
+const maxThrottledInterval = 5 * time.Minute
+
+// Throttle adapts the filestream's transmit rate to backpressure from
+// the server.
+//
+// The rate is cut whenever a response is 429 Too Many Requests or its
+// RateLimit headers show that the quota is running low. It is gradually
+// restored as requests succeed.
+//
+// A Throttle observes responses through [Throttle.CheckRetry], so that
+// every attempt is seen, including ones that are retried.
+type Throttle struct {
+	mu sync.Mutex
+
+	// limiter is the transmit rate limit that is adjusted.
+	limiter *rate.Limiter
+
+	// baseLimit is the rate to restore once the server recovers.
+	//
+	// It is the slower of serverLimit and userLimit, or defaultLimit
+	// if neither is set.
+	baseLimit rate.Limit
+
+	// defaultLimit is the limiter's initial rate.
+	defaultLimit rate.Limit
+
+	// serverLimit is the rate requested by the server, or 0 if unset.
+	serverLimit rate.Limit
+
+	// userLimit is the rate chosen by the user, or 0 if unset.
+	userLimit rate.Limit
+
+	// isThrottled is whether the last response was 429.
+	isThrottled bool
+}
+
+// NewThrottle returns a Throttle that adjusts the given rate limit.
+//
+// The limiter's current rate is the default, used unless the server
+// or the user chooses a different one.
+func NewThrottle(limiter *rate.Limiter) *Throttle {
+	return &Throttle{
+		limiter:      limiter,
+		baseLimit:    limiter.Limit(),
+		defaultLimit: limiter.Limit(),
+	}
+}
+
+// CheckRetry wraps a retry policy to adjust the transmit rate based on
+// each response.
+func (t *Throttle) CheckRetry(policy retryablehttp.CheckRetry) retryablehttp.CheckRetry {
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		if resp != nil {
+			t.Observe(resp)
+		}
+		return policy(ctx, resp, err)
+	}
+}
+
+// Observe adjusts the transmit rate based on a response.
+func (t *Throttle) Observe(resp *http.Response) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.isThrottled = resp.StatusCode == http.StatusTooManyRequests
+	if t.isThrottled {
+		t.slowDown(resp)
+		return
+	}
+
+	ceiling := t.baseLimit
+	if quota, ok := api.ParseRateLimitHeaders(resp.Header); ok && quota.Reset > 0 {
+		ceiling = min(ceiling, rate.Limit(quota.Remaining/quota.Reset))
+	}
+	ceiling = max(ceiling, rate.Every(maxThrottledInterval))
+
+	current := t.limiter.Limit()
+	if current < ceiling {
+		// Recover gradually in case the server is still near its limit.
+		t.limiter.SetLimit(min(current*2, ceiling))
+	} else if current > ceiling {
+		t.limiter.SetLimit(ceiling)
+	}
+}
+
+// SetServerLimit changes the rate requested by the server.
+//
+// A zero limit clears it.
+func (t *Throttle) SetServerLimit(limit rate.Limit) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.serverLimit = limit
+	t.updateBaseLimit()
+}
+
+// SetUserLimit changes the rate chosen by the user.
+//
+// The user can slow down transmission, but not make it faster than
+// the server allows. A zero limit clears it.
+func (t *Throttle) SetUserLimit(limit rate.Limit) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.userLimit = limit
+	t.updateBaseLimit()
+}
+
+// updateBaseLimit recomputes baseLimit and applies it to the limiter.
+func (t *Throttle) updateBaseLimit() {
+	switch {
+	case t.serverLimit > 0 && t.userLimit > 0:
+		t.baseLimit = min(t.serverLimit, t.userLimit)
+	case t.serverLimit > 0:
+		t.baseLimit = t.serverLimit
+	case t.userLimit > 0:
+		t.baseLimit = t.userLimit
+	default:
+		t.baseLimit = t.defaultLimit
+	}
+
+	t.limiter.SetLimit(t.baseLimit)
+}
+
+// IsThrottled reports whether the last response was 429 Too Many Requests.
+func (t *Throttle) IsThrottled() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.isThrottled
+}
+
+// slowDown halves the transmit rate after a 429 response, or reduces
+// it further if the response has a Retry-After header.
+func (t *Throttle) slowDown(resp *http.Response) {
+	limit := t.limiter.Limit()
+	if limit == rate.Inf {
+		limit = 1
+	}
+	limit /= 2
+
+	seconds, err := strconv.ParseFloat(resp.Header.Get("Retry-After"), 64)
+	if err == nil && seconds > 0 {
+		limit = min(limit, rate.Limit(1/seconds))
+	}
+
+	t.limiter.SetLimit(max(limit, rate.Every(maxThrottledInterval)))
+}
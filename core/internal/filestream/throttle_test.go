@@ -0,0 +1,170 @@
+package filestream_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/api"
+	. "github.com/wandb/wandb/core/internal/filestream"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"golang.org/x/time/rate"
+)
+
+func response(status int, header ...string) *http.Response {
+	resp := &http.Response{StatusCode: status, Header: http.Header{}}
+	for i := 0; i+1 < len(header); i += 2 {
+		resp.Header.Set(header[i], header[i+1])
+	}
+	return resp
+}
+
+func TestThrottle_TooManyRequestsHalvesRate(t *testing.T) {
+	limiter := rate.NewLimiter(4, 1)
+	throttle := NewThrottle(limiter)
+
+	throttle.Observe(response(http.StatusTooManyRequests))
+
+	assert.EqualValues(t, 2, limiter.Limit())
+	assert.True(t, throttle.IsThrottled())
+}
+
+func TestThrottle_RespectsRetryAfter(t *testing.T) {
+	limiter := rate.NewLimiter(4, 1)
+	throttle := NewThrottle(limiter)
+
+	throttle.Observe(response(http.StatusTooManyRequests, "Retry-After", "10"))
+
+	assert.EqualValues(t, 0.1, limiter.Limit())
+}
+
+func TestThrottle_HasMinimumRate(t *testing.T) {
+	limiter := rate.NewLimiter(4, 1)
+	throttle := NewThrottle(limiter)
+
+	throttle.Observe(response(http.StatusTooManyRequests, "Retry-After", "86400"))
+
+	assert.Equal(t, rate.Every(5*time.Minute), limiter.Limit())
+}
+
+func TestThrottle_RecoversGradually(t *testing.T) {
+	limiter := rate.NewLimiter(4, 1)
+	throttle := NewThrottle(limiter)
+	throttle.Observe(response(http.StatusTooManyRequests))
+	throttle.Observe(response(http.StatusTooManyRequests))
+
+	throttle.Observe(response(http.StatusOK))
+	assert.EqualValues(t, 2, limiter.Limit())
+	assert.False(t, throttle.IsThrottled())
+
+	throttle.Observe(response(http.StatusOK))
+	throttle.Observe(response(http.StatusOK))
+	assert.EqualValues(t, 4, limiter.Limit())
+}
+
+func TestThrottle_RateLimitHeadersCapRate(t *testing.T) {
+	limiter := rate.NewLimiter(4, 1)
+	throttle := NewThrottle(limiter)
+
+	throttle.Observe(response(http.StatusOK,
+		"RateLimit-Remaining", "5",
+		"RateLimit-Reset", "10"))
+
+	assert.EqualValues(t, 0.5, limiter.Limit())
+}
+
+func TestThrottle_SetServerLimit(t *testing.T) {
+	limiter := rate.NewLimiter(4, 1)
+	throttle := NewThrottle(limiter)
+
+	throttle.SetServerLimit(1)
+	throttle.Observe(response(http.StatusOK))
+
+	assert.EqualValues(t, 1, limiter.Limit())
+}
+
+func TestThrottle_UserLimitCannotExceedServerLimit(t *testing.T) {
+	limiter := rate.NewLimiter(4, 1)
+	throttle := NewThrottle(limiter)
+
+	throttle.SetServerLimit(1)
+	throttle.SetUserLimit(2)
+	assert.EqualValues(t, 1, limiter.Limit())
+
+	throttle.SetUserLimit(0.5)
+	assert.EqualValues(t, 0.5, limiter.Limit())
+}
+
+func TestThrottle_ClearingLimitsRestoresDefault(t *testing.T) {
+	limiter := rate.NewLimiter(4, 1)
+	throttle := NewThrottle(limiter)
+
+	throttle.SetUserLimit(0.5)
+	throttle.SetUserLimit(0)
+
+	assert.EqualValues(t, 4, limiter.Limit())
+}
+
+func TestThrottle_CheckRetryObservesResponses(t *testing.T) {
+	limiter := rate.NewLimiter(4, 1)
+	throttle := NewThrottle(limiter)
+	policy := throttle.CheckRetry(
+		func(context.Context, *http.Response, error) (bool, error) {
+			return true, nil
+		})
+
+	shouldRetry, _ := policy(
+		context.Background(),
+		response(http.StatusTooManyRequests),
+		nil,
+	)
+
+	assert.True(t, shouldRetry)
+	assert.EqualValues(t, 2, limiter.Limit())
+}
+
+// throttledClient fails the first request as if the server responded
+// with 429 until retries were exhausted.
+type throttledClient struct {
+	recordingClient
+	throttle *Throttle
+	failures int
+}
+
+func (c *throttledClient) Send(req *api.Request) (*http.Response, error) {
+	if c.failures > 0 {
+		c.failures--
+		c.throttle.Observe(response(http.StatusTooManyRequests))
+		return nil, errors.New("giving up after 1 attempt(s)")
+	}
+
+	c.throttle.Observe(response(http.StatusOK))
+	return c.recordingClient.Send(req)
+}
+
+func TestThrottle_ExhaustedRetriesAreNotFatal(t *testing.T) {
+	limiter := rate.NewLimiter(rate.Inf, 1)
+	throttle := NewThrottle(limiter)
+	printer := observability.NewPrinter()
+	client := &throttledClient{throttle: throttle, failures: 2}
+	fs := NewFileStream(FileStreamParams{
+		Settings:          settings.From(&service.Settings{}),
+		Logger:            observability.NewNoOpLogger(),
+		Printer:           printer,
+		ApiClient:         client,
+		TransmitRateLimit: limiter,
+		Throttle:          throttle,
+	})
+
+	fs.Start("entity", "project", "run", nil)
+	fs.FinishWithExit(0)
+
+	assert.Empty(t, printer.Read())
+	assert.NotEmpty(t, client.requests)
+	assert.EqualValues(t, 0, fs.Metrics().FailedRequests)
+}
@@ -1,7 +1,11 @@
 package filestream
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/wandb/wandb/core/internal/waiting"
+	"github.com/wandb/wandb/core/pkg/observability"
 )
 
 // TransmitLoop makes requests to the backend.
@@ -9,6 +13,43 @@ type TransmitLoop struct {
 	HeartbeatStopwatch     waiting.Stopwatch
 	Send                   func(*FileStreamRequestJSON, chan<- map[string]any) error
 	LogFatalAndStopWorking func(error)
+
+	// Spill, if set, stores requests that fail to send so that this
+	// process can retry them later instead of killing the filestream.
+	//
+	// While the queue is non-empty, new requests are added to it to
+	// preserve their order, and each new request or heartbeat triggers
+	// an attempt to send the queued ones.
+	Spill *SpillQueue
+
+	// Checkpoint, if set, records acknowledged offsets and is used to
+	// skip lines that the server already has.
+	Checkpoint *OffsetCheckpoint
+
+	// Metrics, if set, counts lines added to the spill queue.
+	Metrics *Metrics
+
+	// OnAccepted, if set, is called after each request that the server
+	// accepts.
+	OnAccepted func(*FileStreamRequestJSON)
+
+	// Abandon, if set, is closed to stop making requests, such as when
+	// the filestream takes too long to finish.
+	//
+	// Remaining requests are then added to the spill queue, or discarded
+	// if there is none or if DropAbandoned is set.
+	Abandon <-chan struct{}
+
+	// DropAbandoned is whether to discard requests after Abandon is
+	// closed even if there is a spill queue.
+	DropAbandoned bool
+
+	// Logger is required if Spill, Checkpoint or Abandon is set.
+	Logger *observability.CoreLogger
+
+	// Printer, if set, tells the user when uploads start failing and
+	// when they recover.
+	Printer *observability.Printer
 }
 
 // Start makes requests to the filestream API.
@@ -45,18 +86,209 @@ func (tr TransmitLoop) Start(
 			}
 
 			tr.HeartbeatStopwatch.Reset()
-			err := tr.Send(x, feedback)
+			err := tr.sendOrSpill(x, feedback)
 
 			if err != nil {
 				tr.LogFatalAndStopWorking(err)
 				break
 			}
 		}
+
+		tr.finishSpill(feedback)
+
+		if tr.Checkpoint != nil {
+			if err := tr.Checkpoint.Save(); err != nil {
+				tr.Logger.CaptureError(err)
+			}
+		}
 	}()
 
 	return feedback
 }
 
+// sendOrSpill sends a request, or adds it to the spill queue if it
+// cannot be sent yet.
+//
+// Returns an error only if the request can be neither sent nor spilled.
+func (tr TransmitLoop) sendOrSpill(
+	x *FileStreamRequestJSON,
+	feedback chan<- map[string]any,
+) error {
+	if tr.Checkpoint != nil {
+		x = tr.Checkpoint.Trim(x)
+	}
+
+	if tr.isAbandoned() {
+		return tr.abandon(x)
+	}
+
+	if tr.Spill == nil {
+		err := tr.send(x, feedback)
+		if err != nil && tr.isAbandoned() {
+			return tr.abandon(x)
+		}
+		return err
+	}
+
+	if tr.Spill.Len() > 0 {
+		drained, err := tr.drainSpill(feedback)
+		switch {
+		case err != nil:
+			return err
+		case !drained:
+			return tr.spill(x)
+		}
+	}
+
+	if err := tr.send(x, feedback); err != nil {
+		if tr.isAbandoned() {
+			return tr.abandon(x)
+		}
+
+		tr.Logger.CaptureError(
+			fmt.Errorf("filestream: spilling requests to disk: %v", err),
+			"path", tr.Spill.Path())
+		if tr.Printer != nil {
+			tr.Printer.
+				AtMostEvery(time.Minute).
+				Writef("Failed to upload run data. It will be kept on disk" +
+					" and uploaded once the connection recovers.")
+		}
+		return tr.spill(x)
+	}
+
+	return nil
+}
+
+// send makes a request and updates the checkpoint if it succeeds.
+func (tr TransmitLoop) send(
+	x *FileStreamRequestJSON,
+	feedback chan<- map[string]any,
+) error {
+	if err := tr.Send(x, feedback); err != nil {
+		return err
+	}
+
+	if tr.Checkpoint != nil {
+		if err := tr.Checkpoint.Acknowledge(x); err != nil {
+			tr.Logger.CaptureError(err)
+		}
+	}
+
+	if tr.OnAccepted != nil {
+		tr.OnAccepted(x)
+	}
+
+	return nil
+}
+
+// isAbandoned reports whether Abandon is closed.
+func (tr TransmitLoop) isAbandoned() bool {
+	select {
+	case <-tr.Abandon:
+		return true
+	default:
+		return false
+	}
+}
+
+// abandon spills or discards a request that won't be sent.
+func (tr TransmitLoop) abandon(x *FileStreamRequestJSON) error {
+	if tr.Spill != nil && !tr.DropAbandoned {
+		return tr.spill(x)
+	}
+
+	if numLines := x.numLines(); numLines > 0 {
+		tr.Metrics.recordDroppedLines(numLines)
+		tr.Logger.Warn(
+			"filestream: dropped request after drain timeout",
+			"lines", numLines)
+	}
+
+	return nil
+}
+
+// spill adds a request to the spill queue.
+//
+// Heartbeats are dropped since there is no point in sending them late.
+func (tr TransmitLoop) spill(x *FileStreamRequestJSON) error {
+	if x.isEmpty() {
+		return nil
+	}
+
+	if err := tr.Spill.Push(x); err != nil {
+		return err
+	}
+
+	tr.Metrics.recordDeferredLines(x.numLines())
+	return nil
+}
+
+// drainSpill sends spilled requests in order until the queue is empty
+// or a request fails to send.
+//
+// Returns whether the queue was emptied, and an error if the queue
+// could not be read.
+func (tr TransmitLoop) drainSpill(
+	feedback chan<- map[string]any,
+) (bool, error) {
+	for tr.Spill.Len() > 0 {
+		x, err := tr.Spill.Peek()
+		if err != nil {
+			return false, err
+		}
+
+		if err := tr.send(x, feedback); err != nil {
+			tr.Logger.Debug(
+				"filestream: failed to send spilled request",
+				"error", err,
+				"queued", tr.Spill.Len())
+			return false, nil
+		}
+
+		if err := tr.Spill.Pop(); err != nil {
+			return false, err
+		}
+	}
+
+	tr.Logger.Info("filestream: sent all spilled requests")
+	if tr.Printer != nil {
+		tr.Printer.
+			AtMostEvery(time.Minute).
+			Writef("Resumed uploading run data.")
+	}
+	return true, nil
+}
+
+// finishSpill makes a final attempt to send spilled requests, then
+// deletes the spill file.
+//
+// If any requests remain, the filestream is marked dead so that the user
+// is told to upload the run with `wandb sync`.
+func (tr TransmitLoop) finishSpill(feedback chan<- map[string]any) {
+	if tr.Spill == nil || tr.Spill.Len() == 0 {
+		return
+	}
+
+	var drained bool
+	var err error
+	if !tr.isAbandoned() {
+		drained, err = tr.drainSpill(feedback)
+	}
+	unsent := tr.Spill.Len()
+	if removeErr := tr.Spill.Remove(); removeErr != nil {
+		tr.Logger.CaptureError(removeErr, "path", tr.Spill.Path())
+	}
+
+	switch {
+	case err != nil:
+		tr.LogFatalAndStopWorking(err)
+	case !drained:
+		tr.LogFatalAndStopWorking(fmt.Errorf(
+			"filestream: %d requests were not sent", unsent))
+	}
+}
+
 // readWithHeartbeat waits for data or a heartbeat.
 //
 // A heartbeat is an empty request that is sent if no data is sent
@@ -1,12 +1,23 @@
 package filestream_test
 
 import (
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/api"
 	. "github.com/wandb/wandb/core/internal/filestream"
+	"github.com/wandb/wandb/core/internal/settings"
 	"github.com/wandb/wandb/core/internal/waitingtest"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestTransmitLoop_Sends(t *testing.T) {
@@ -66,3 +77,127 @@ func TestTransmitLoop_SendsHeartbeats(t *testing.T) {
 		t.Error("timeout after 1 second")
 	}
 }
+
+func TestTransmitLoop_SpillsWhileSendFails(t *testing.T) {
+	heartbeat := waitingtest.NewFakeStopwatch()
+	path := filepath.Join(t.TempDir(), SpillFileName)
+	printer := observability.NewPrinter()
+	isOnline := &atomic.Bool{}
+	var sent []string
+	loop := TransmitLoop{
+		HeartbeatStopwatch:     heartbeat,
+		LogFatalAndStopWorking: func(err error) { t.Error(err) },
+		Send: func(
+			ftd *FileStreamRequestJSON,
+			c chan<- map[string]any,
+		) error {
+			if !isOnline.Load() {
+				return errors.New("offline")
+			}
+			sent = append(sent, ftd.Uploaded...)
+			return nil
+		},
+		Spill:   NewSpillQueue(path),
+		Logger:  observability.NewNoOpLogger(),
+		Printer: printer,
+	}
+
+	inputs := make(chan *FileStreamRequestReader)
+	feedback := loop.Start(inputs, FileStreamOffsetMap{})
+	send := func(file string) {
+		reader, _ := NewRequestReader(
+			&FileStreamRequest{
+				UploadedFiles: map[string]struct{}{file: {}},
+			},
+			999,
+		)
+		inputs <- reader
+	}
+
+	send("one")
+	send("two")
+	assert.FileExists(t, path)
+	isOnline.Store(true)
+	send("three")
+	close(inputs)
+	for range feedback {
+	}
+
+	assert.Equal(t, []string{"one", "two", "three"}, sent)
+	assert.NoFileExists(t, path)
+	assert.Equal(t,
+		[]string{
+			"Failed to upload run data. It will be kept on disk" +
+				" and uploaded once the connection recovers.",
+			"Resumed uploading run data.",
+		},
+		printer.Read())
+}
+
+func TestTransmitLoop_UnsentSpillIsFatal(t *testing.T) {
+	heartbeat := waitingtest.NewFakeStopwatch()
+	path := filepath.Join(t.TempDir(), SpillFileName)
+	var fatalErr error
+	loop := TransmitLoop{
+		HeartbeatStopwatch:     heartbeat,
+		LogFatalAndStopWorking: func(err error) { fatalErr = err },
+		Send: func(
+			ftd *FileStreamRequestJSON,
+			c chan<- map[string]any,
+		) error {
+			return errors.New("offline")
+		},
+		Spill:  NewSpillQueue(path),
+		Logger: observability.NewNoOpLogger(),
+	}
+
+	inputs := make(chan *FileStreamRequestReader, 1)
+	reader, _ := NewRequestReader(&FileStreamRequest{Preempting: true}, 999)
+	inputs <- reader
+	close(inputs)
+	for range loop.Start(inputs, FileStreamOffsetMap{}) {
+	}
+
+	assert.ErrorContains(t, fatalErr, "1 requests were not sent")
+	assert.NoFileExists(t, path)
+}
+
+// flakyClient fails a number of requests before succeeding.
+type flakyClient struct {
+	recordingClient
+	failures int
+}
+
+func (c *flakyClient) Send(req *api.Request) (*http.Response, error) {
+	if c.failures > 0 {
+		c.failures--
+		return nil, errors.New("backend unavailable")
+	}
+
+	return c.recordingClient.Send(req)
+}
+
+func TestFileStream_RecoversWithoutSyncDir(t *testing.T) {
+	client := &flakyClient{failures: 1}
+	fs := NewFileStream(FileStreamParams{
+		Settings: settings.From(&service.Settings{
+			RunId: wrapperspb.String(t.Name()),
+		}),
+		Logger:            observability.NewNoOpLogger(),
+		Printer:           observability.NewPrinter(),
+		ApiClient:         client,
+		TransmitRateLimit: rate.NewLimiter(rate.Inf, 1),
+	})
+
+	fs.Start("entity", "project", "run", nil)
+	fs.StreamUpdate(&FilesUploadedUpdate{RelativePath: "file.txt"})
+	fs.FinishWithExit(0)
+
+	var bodies []string
+	for _, req := range client.requests {
+		bodies = append(bodies, string(req.Body))
+	}
+	assert.Contains(t, strings.Join(bodies, "\n"), "file.txt")
+	assert.Contains(t, strings.Join(bodies, "\n"), `"complete":true`)
+	assert.Zero(t, fs.Metrics().DroppedLines)
+}
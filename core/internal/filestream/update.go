@@ -19,4 +19,21 @@ type UpdateContext struct {
 
 	Logger  *observability.CoreLogger
 	Printer *observability.Printer
+
+	// Metrics, if set, counts lines that are dropped.
+	Metrics *Metrics
+}
+
+// maxLineBytes returns the maximum length of a line in a filestream file.
+func (ctx UpdateContext) maxLineBytes() int {
+	if ctx.Settings == nil {
+		return maxFileLineBytes
+	}
+
+	limit := int(ctx.Settings.GetFileStreamMaxLineBytes())
+	if limit <= 0 || limit > maxFileLineBytes {
+		return maxFileLineBytes
+	}
+
+	return limit
 }
@@ -2,6 +2,8 @@ package filestream
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 	"unsafe"
 
@@ -15,9 +17,70 @@ type HistoryUpdate struct {
 }
 
 func (u *HistoryUpdate) Apply(ctx UpdateContext) error {
+	maxLineBytes := ctx.maxLineBytes()
+
+	line, err := historyLine(ctx, u.Record.Item)
+	if err != nil {
+		return err
+	}
+
+	var droppedKeys []string
+	if len(line) > maxLineBytes {
+		line, droppedKeys, err = dropLargestValues(
+			ctx, u.Record.Item, len(line), maxLineBytes)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case line == nil:
+		// We consider this non-blocking. We'll upload a run with some missing
+		// data, but it's better than not uploading anything at all, as long
+		// as we inform the user.
+		ctx.Metrics.recordDroppedLines(1)
+		ctx.Logger.CaptureWarn(
+			"filestream: run history line too long, skipping",
+			"max", maxLineBytes,
+		)
+		ctx.Printer.
+			AtMostEvery(time.Minute).
+			Writef(
+				"Skipped uploading run.log() data that exceeded"+
+					" size limit (%d bytes).",
+				maxLineBytes)
+		return nil
+
+	case len(droppedKeys) > 0:
+		ctx.Logger.CaptureWarn(
+			"filestream: run history line too long, dropped values",
+			"keys", droppedKeys,
+			"max", maxLineBytes,
+		)
+		ctx.Printer.
+			AtMostEvery(time.Minute).
+			Writef(
+				"Skipped uploading values for %s in run.log() data"+
+					" that exceeded size limit (%d bytes).",
+				strings.Join(droppedKeys, ", "),
+				maxLineBytes)
+	}
+
+	ctx.MakeRequest(&FileStreamRequest{
+		HistoryLines: []string{string(line)},
+	})
+
+	return nil
+}
+
+// historyLine serializes history items as a line of the history file.
+func historyLine(
+	ctx UpdateContext,
+	items []*service.HistoryItem,
+) ([]byte, error) {
 	rh := runhistory.New()
 
-	for _, item := range u.Record.Item {
+	for _, item := range items {
 		err := rh.SetFromRecord(item)
 		if err != nil {
 			// TODO(corruption): Remove after data corruption is resolved.
@@ -36,33 +99,77 @@ func (u *HistoryUpdate) Apply(ctx UpdateContext) error {
 				"&value_json", unsafe.StringData(item.ValueJson))
 		}
 	}
+
 	line, err := rh.ToExtendedJSON()
 	if err != nil {
-		return fmt.Errorf(
+		return nil, fmt.Errorf(
 			"filestream: failed to serialize history: %v", err)
 	}
 
-	if len(line) > maxFileLineBytes {
-		// We consider this non-blocking. We'll upload a run with some missing
-		// data, but it's better than not uploading anything at all, as long
-		// as we inform the user.
-		ctx.Logger.CaptureWarn(
-			"filestream: run history line too long, skipping",
-			"len", len(line),
-			"max", maxFileLineBytes,
-		)
-		ctx.Printer.
-			AtMostEvery(time.Minute).
-			Writef(
-				"Skipped uploading run.log() data that exceeded"+
-					" size limit (%d > %d).",
-				len(line),
-				maxFileLineBytes)
-	} else {
-		ctx.MakeRequest(&FileStreamRequest{
-			HistoryLines: []string{string(line)},
-		})
+	return line, nil
+}
+
+// dropLargestValues removes the largest values from an oversized history
+// line until it fits in maxLineBytes.
+//
+// A row of history can't be split into multiple lines, but dropping
+// a few huge values lets us upload the rest of the row. Internal keys
+// like "_step" are never dropped.
+//
+// Returns the new line and the dropped keys, or a nil line if the row
+// can't be made to fit.
+func dropLargestValues(
+	ctx UpdateContext,
+	items []*service.HistoryItem,
+	lineBytes int,
+	maxLineBytes int,
+) ([]byte, []string, error) {
+	candidates := make([]int, 0, len(items))
+	for i, item := range items {
+		if !strings.HasPrefix(historyItemKey(item), "_") {
+			candidates = append(candidates, i)
+		}
 	}
+	slices.SortStableFunc(candidates, func(a, b int) int {
+		return len(items[b].ValueJson) - len(items[a].ValueJson)
+	})
 
-	return nil
+	dropped := make(map[int]bool)
+	var droppedKeys []string
+	for _, i := range candidates {
+		dropped[i] = true
+		droppedKeys = append(droppedKeys, historyItemKey(items[i]))
+		lineBytes -= len(items[i].ValueJson)
+
+		// Only re-serialize once the estimate says the line could fit.
+		if lineBytes > maxLineBytes {
+			continue
+		}
+
+		kept := make([]*service.HistoryItem, 0, len(items)-len(dropped))
+		for j, item := range items {
+			if !dropped[j] {
+				kept = append(kept, item)
+			}
+		}
+
+		line, err := historyLine(ctx, kept)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(line) <= maxLineBytes {
+			return line, droppedKeys, nil
+		}
+		lineBytes = len(line)
+	}
+
+	return nil, nil, nil
+}
+
+// historyItemKey returns the dotted path of a history item.
+func historyItemKey(item *service.HistoryItem) string {
+	if len(item.NestedKey) > 0 {
+		return strings.Join(item.NestedKey, ".")
+	}
+	return item.Key
 }
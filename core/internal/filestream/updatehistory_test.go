@@ -0,0 +1,70 @@
+package filestream_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/wandb/wandb/core/internal/filestream"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// applyHistory applies a history update with the given line size limit
+// and returns the resulting request.
+func applyHistory(
+	t *testing.T,
+	maxLineBytes int32,
+	items ...*service.HistoryItem,
+) *FileStreamRequest {
+	t.Helper()
+
+	request := &FileStreamRequest{}
+	update := &HistoryUpdate{Record: &service.HistoryRecord{Item: items}}
+	err := update.Apply(UpdateContext{
+		MakeRequest: request.Merge,
+		Settings: settings.From(&service.Settings{
+			XFileStreamMaxLineBytes: wrapperspb.Int32(maxLineBytes),
+		}),
+		Logger:  observability.NewNoOpLogger(),
+		Printer: observability.NewPrinter(),
+	})
+	require.NoError(t, err)
+
+	return request
+}
+
+func TestHistoryUpdate_DropsLargestValues(t *testing.T) {
+	big := `"` + strings.Repeat("x", 200) + `"`
+
+	request := applyHistory(t, 100,
+		&service.HistoryItem{Key: "_step", ValueJson: "1"},
+		&service.HistoryItem{Key: "small", ValueJson: "2"},
+		&service.HistoryItem{NestedKey: []string{"a", "big"}, ValueJson: big},
+	)
+
+	require.Len(t, request.HistoryLines, 1)
+	assert.JSONEq(t, `{"_step": 1, "small": 2}`, request.HistoryLines[0])
+}
+
+func TestHistoryUpdate_SkipsRowThatCannotFit(t *testing.T) {
+	request := applyHistory(t, 5,
+		&service.HistoryItem{Key: "_step", ValueJson: "1"},
+		&service.HistoryItem{Key: "small", ValueJson: "2"},
+	)
+
+	assert.Empty(t, request.HistoryLines)
+}
+
+func TestHistoryUpdate_UnderLimit(t *testing.T) {
+	request := applyHistory(t, 0,
+		&service.HistoryItem{Key: "_step", ValueJson: "1"},
+		&service.HistoryItem{Key: "x", ValueJson: `"abc"`},
+	)
+
+	require.Len(t, request.HistoryLines, 1)
+	assert.JSONEq(t, `{"_step": 1, "x": "abc"}`, request.HistoryLines[0])
+}
@@ -45,6 +45,7 @@ func (u *StatsUpdate) Apply(ctx UpdateContext) error {
 	}
 
 	line, err := simplejsonext.Marshal(row)
+	maxLineBytes := ctx.maxLineBytes()
 	switch {
 	case err != nil:
 		// This is a non-blocking failure, so we don't return an error.
@@ -53,12 +54,13 @@ func (u *StatsUpdate) Apply(ctx UpdateContext) error {
 				"filestream: failed to marshal system metrics: %v",
 				err,
 			))
-	case len(line) > maxFileLineBytes:
+	case len(line) > maxLineBytes:
 		// This is a non-blocking failure as well.
+		ctx.Metrics.recordDroppedLines(1)
 		ctx.Logger.CaptureWarn(
 			"filestream: system metrics line too long, skipping",
 			"len", len(line),
-			"max", maxFileLineBytes,
+			"max", maxLineBytes,
 		)
 	default:
 		ctx.MakeRequest(&FileStreamRequest{
@@ -48,12 +48,14 @@ func (u *SummaryUpdate) Apply(ctx UpdateContext) error {
 		)
 	}
 
-	if len(line) > maxFileLineBytes {
+	maxLineBytes := ctx.maxLineBytes()
+	if len(line) > maxLineBytes {
 		// Failing to upload the summary is non-blocking.
+		ctx.Metrics.recordDroppedLines(1)
 		ctx.Logger.CaptureWarn(
 			"filestream: run summary line too long, skipping",
 			"len", len(line),
-			"max", maxFileLineBytes,
+			"max", maxLineBytes,
 		)
 		ctx.Printer.
 			AtMostEvery(time.Minute).
@@ -61,7 +63,7 @@ func (u *SummaryUpdate) Apply(ctx UpdateContext) error {
 				"Skipped uploading summary data that exceeded"+
 					" size limit (%d > %d).",
 				len(line),
-				maxFileLineBytes)
+				maxLineBytes)
 	} else {
 		ctx.MakeRequest(&FileStreamRequest{
 			LatestSummary: string(line),
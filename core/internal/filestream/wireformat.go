@@ -0,0 +1,89 @@
+package filestream
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// CapabilitiesHeader is a response header in which the server lists
+	// optional filestream features it supports, separated by commas.
+	CapabilitiesHeader = "X-Wandb-Filestream-Capabilities"
+
+	// ProtobufCapability indicates that the server accepts request bodies
+	// encoded as a [service.FileStreamPayload].
+	ProtobufCapability = "protobuf"
+
+	// ProtobufContentType is the content type of protobuf request bodies.
+	ProtobufContentType = "application/x-protobuf"
+)
+
+// wireFormat is the encoding used for filestream request bodies.
+type wireFormat int
+
+const (
+	// wireFormatJSON is the default encoding, supported by all servers.
+	wireFormatJSON wireFormat = iota
+
+	// wireFormatProto is the binary encoding, used once the server
+	// advertises the [ProtobufCapability].
+	wireFormatProto
+)
+
+// wireFormatFromHeader returns the encoding to use for requests following
+// a response with the given headers.
+//
+// The capability is checked on every response so that we fall back to JSON
+// if the server stops advertising it, for example after a rollback.
+func wireFormatFromHeader(header http.Header) wireFormat {
+	for _, value := range header.Values(CapabilitiesHeader) {
+		for _, capability := range strings.Split(value, ",") {
+			if strings.TrimSpace(capability) == ProtobufCapability {
+				return wireFormatProto
+			}
+		}
+	}
+
+	return wireFormatJSON
+}
+
+// ToProto converts the request to its binary wire format.
+func (r *FileStreamRequestJSON) ToProto() *service.FileStreamPayload {
+	payload := &service.FileStreamPayload{
+		Uploaded:   r.Uploaded,
+		Preempting: r.Preempting,
+		Complete:   r.Complete,
+		Exitcode:   r.ExitCode,
+		Checksum:   r.Checksum,
+	}
+
+	if len(r.Files) > 0 {
+		payload.Files = make(map[string]*service.FileStreamChunk, len(r.Files))
+		for name, chunk := range r.Files {
+			payload.Files[name] = &service.FileStreamChunk{
+				Offset:  int64(chunk.Offset),
+				Content: chunk.Content,
+			}
+		}
+	}
+
+	return payload
+}
+
+// marshal encodes the request in the given format.
+//
+// It returns the request body and its content type.
+func (r *FileStreamRequestJSON) marshal(format wireFormat) ([]byte, string, error) {
+	switch format {
+	case wireFormatProto:
+		data, err := proto.Marshal(r.ToProto())
+		return data, ProtobufContentType, err
+	default:
+		data, err := json.Marshal(r)
+		return data, "application/json", err
+	}
+}
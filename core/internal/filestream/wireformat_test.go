@@ -0,0 +1,140 @@
+package filestream_test
+
+import (
+	"cmp"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/api"
+	. "github.com/wandb/wandb/core/internal/filestream"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/proto"
+)
+
+// recordingClient is an api.Client that records requests and
+// advertises the given filestream capabilities.
+type recordingClient struct {
+	sync.Mutex
+	capabilities string
+	requests     []*api.Request
+
+	// body is the response body, or "{}" if empty.
+	body string
+
+	// sent, if not nil, receives a value after each request.
+	sent chan struct{}
+}
+
+func (c *recordingClient) Send(req *api.Request) (*http.Response, error) {
+	c.Lock()
+	defer c.Unlock()
+	c.requests = append(c.requests, req)
+	if c.sent != nil {
+		c.sent <- struct{}{}
+	}
+
+	header := http.Header{}
+	if c.capabilities != "" {
+		header.Set(CapabilitiesHeader, c.capabilities)
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Status:     "200 OK",
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(cmp.Or(c.body, "{}"))),
+	}, nil
+}
+
+func (c *recordingClient) Do(*http.Request) (*http.Response, error) {
+	panic("unexpected call to Do")
+}
+
+func TestToProto(t *testing.T) {
+	boolTrue := true
+	exitCode := int32(2)
+	var req FileStreamRequestJSON
+	require.NoError(t, json.Unmarshal(
+		[]byte(`{"files": {"wandb-history.jsonl":
+			{"offset": 5, "content": ["{\"x\": 1}"]}},
+			"uploaded": ["model.pt"]}`),
+		&req,
+	))
+	req.Complete = &boolTrue
+	req.ExitCode = &exitCode
+
+	payload := req.ToProto()
+
+	assert.True(t, proto.Equal(
+		&service.FileStreamPayload{
+			Files: map[string]*service.FileStreamChunk{
+				HistoryFileName: {Offset: 5, Content: []string{`{"x": 1}`}},
+			},
+			Uploaded: []string{"model.pt"},
+			Complete: &boolTrue,
+			Exitcode: &exitCode,
+		},
+		payload,
+	))
+}
+
+func TestSend_SwitchesToProtobufWhenAdvertised(t *testing.T) {
+	client := &recordingClient{
+		capabilities: "other, " + ProtobufCapability,
+		sent:         make(chan struct{}, 10),
+	}
+	fs := NewFileStream(FileStreamParams{
+		Settings:          settings.From(&service.Settings{}),
+		Logger:            observability.NewNoOpLogger(),
+		Printer:           observability.NewPrinter(),
+		ApiClient:         client,
+		TransmitRateLimit: rate.NewLimiter(rate.Inf, 1),
+	})
+
+	fs.Start("entity", "project", "run", nil)
+	fs.StreamUpdate(&PreemptingUpdate{})
+	<-client.sent // wait for the first response before finishing
+	fs.FinishWithExit(0)
+
+	require.Len(t, client.requests, 2)
+	assert.Equal(t,
+		"application/json",
+		client.requests[0].Headers["Content-Type"])
+	assert.Equal(t,
+		ProtobufContentType,
+		client.requests[1].Headers["Content-Type"])
+
+	var payload service.FileStreamPayload
+	require.NoError(t, proto.Unmarshal(client.requests[1].Body, &payload))
+	assert.True(t, payload.GetComplete())
+}
+
+func TestSend_StaysJSONWithoutCapability(t *testing.T) {
+	client := &recordingClient{}
+	fs := NewFileStream(FileStreamParams{
+		Settings:          settings.From(&service.Settings{}),
+		Logger:            observability.NewNoOpLogger(),
+		Printer:           observability.NewPrinter(),
+		ApiClient:         client,
+		TransmitRateLimit: rate.NewLimiter(rate.Inf, 1),
+	})
+
+	fs.Start("entity", "project", "run", nil)
+	fs.StreamUpdate(&PreemptingUpdate{})
+	fs.FinishWithExit(0)
+
+	require.NotEmpty(t, client.requests)
+	for _, req := range client.requests {
+		assert.Equal(t, "application/json", req.Headers["Content-Type"])
+		assert.True(t, json.Valid(req.Body))
+	}
+}
@@ -3,6 +3,7 @@ package filestreamtest
 import (
 	"slices"
 	"sync"
+	"time"
 
 	"github.com/wandb/wandb/core/internal/filestream"
 	"github.com/wandb/wandb/core/internal/settings"
@@ -62,6 +63,18 @@ func (fs *FakeFileStream) Start(
 
 func (fs *FakeFileStream) FinishWithExit(int32) {}
 func (fs *FakeFileStream) FinishWithoutExit()   {}
+func (fs *FakeFileStream) RunShouldStop() bool  { return false }
+
+func (fs *FakeFileStream) Metrics() filestream.MetricsSnapshot {
+	return filestream.MetricsSnapshot{}
+}
+
+func (fs *FakeFileStream) SetTransmitInterval(time.Duration) {}
+
+// AckHistory immediately reports that all history was accepted.
+func (fs *FakeFileStream) AckHistory(callback func(accepted bool)) {
+	callback(true)
+}
 
 func (fs *FakeFileStream) StreamUpdate(update filestream.Update) {
 	fs.Lock()
@@ -0,0 +1,63 @@
+package filetransfer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// ErrDigestMismatch indicates that a downloaded file's contents don't
+// match its expected digest.
+var ErrDigestMismatch = errors.New("digest mismatch")
+
+// DigestMismatchError describes a downloaded file whose contents don't
+// match its expected digest.
+type DigestMismatchError struct {
+	// Path is the local path of the downloaded file, or its URL if it
+	// was streamed.
+	Path string
+
+	// Expected is the expected base64-encoded MD5 digest.
+	Expected string
+
+	// Actual is the base64-encoded MD5 digest of the file on disk.
+	Actual string
+}
+
+func (e *DigestMismatchError) Error() string {
+	return fmt.Sprintf(
+		"file transfer: download: %s has digest %s, expected %s",
+		e.Path, e.Actual, e.Expected,
+	)
+}
+
+func (e *DigestMismatchError) Is(target error) bool {
+	return target == ErrDigestMismatch
+}
+
+// verifyDownloadDigest checks a downloaded file against the task's
+// expected digest, if any.
+//
+// Reference digests aren't MD5s and are checked by the reference's
+// file transfer instead.
+func verifyDownloadDigest(task *Task) error {
+	if task.Digest == "" || task.Reference {
+		return nil
+	}
+
+	actual, err := utils.ComputeFileB64MD5(task.Path)
+	if err != nil {
+		return fmt.Errorf(
+			"file transfer: download: failed to compute digest: %v", err)
+	}
+
+	if actual != task.Digest {
+		return &DigestMismatchError{
+			Path:     task.Path,
+			Expected: task.Digest,
+			Actual:   actual,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package filetransfer
+
+import (
+	"encoding/json"
+	"os"
+	"slices"
+	"sync"
+)
+
+// downloadStateSuffix is appended to a download's path to get the path
+// of its persisted progress.
+const downloadStateSuffix = ".wandb-download"
+
+// downloadState is the persisted progress of a ranged download.
+//
+// It lets a download interrupted by a crash resume from the byte ranges
+// already on disk instead of starting over. The state is only reused if
+// the remote file's size and ETag are unchanged.
+//
+// There is no equivalent for uploads. The backend issues a new multipart
+// upload ID on every request, so an interrupted upload cannot be resumed.
+type downloadState struct {
+	mu sync.Mutex
+
+	// path is where the state is persisted.
+	path string
+
+	// Size is the total size of the file being downloaded.
+	Size int64 `json:"size"`
+
+	// ChunkSize is the size of each downloaded byte range.
+	ChunkSize int64 `json:"chunkSize"`
+
+	// ETag identifies the version of the remote file.
+	ETag string `json:"etag"`
+
+	// Completed are the starting offsets of the byte ranges on disk.
+	Completed []int64 `json:"completed"`
+}
+
+func newDownloadState(
+	downloadPath string,
+	size, chunkSize int64,
+	etag string,
+) *downloadState {
+	return &downloadState{
+		path:      downloadPath + downloadStateSuffix,
+		Size:      size,
+		ChunkSize: chunkSize,
+		ETag:      etag,
+	}
+}
+
+// loadDownloadState reads the persisted progress of a download.
+//
+// Returns nil if there is no usable state.
+func loadDownloadState(downloadPath string) *downloadState {
+	path := downloadPath + downloadStateSuffix
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	state := &downloadState{path: path}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil
+	}
+	return state
+}
+
+// matches reports whether the state describes the same remote file
+// downloaded in the same byte ranges.
+func (s *downloadState) matches(size, chunkSize int64, etag string) bool {
+	return etag != "" &&
+		s.ETag == etag &&
+		s.Size == size &&
+		s.ChunkSize == chunkSize
+}
+
+// isComplete reports whether the range starting at `start` is on disk.
+func (s *downloadState) isComplete(start int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Contains(s.Completed, start)
+}
+
+// markComplete records that the range starting at `start` is on disk
+// and persists the state.
+func (s *downloadState) markComplete(start int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Completed = append(s.Completed, start)
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	// Write atomically so that a crash never leaves a corrupt state.
+	tmpPath := s.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, s.path)
+}
+
+// remove deletes the persisted state.
+func (s *downloadState) remove() error {
+	err := os.Remove(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,146 @@
+package filetransfer
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+)
+
+// StreamDownloader is implemented by file transfers that can download a
+// file without writing it to disk.
+type StreamDownloader interface {
+	// DownloadStream opens the task's remote file for reading.
+	//
+	// The task's Path is ignored. The caller must close the reader.
+	//
+	// Contents are checked against the task's digest as they are read:
+	// the read that reaches the end of the file fails with
+	// ErrDigestMismatch if they don't match, so callers must read to
+	// the end before trusting the bytes.
+	DownloadStream(task *Task) (io.ReadCloser, error)
+}
+
+// DownloadStream opens the task's remote file for reading using the file
+// transfer for its URL.
+func (ft *FileTransfers) DownloadStream(task *Task) (io.ReadCloser, error) {
+	streamer, ok := ft.GetFileTransferForTask(task).(StreamDownloader)
+	if !ok {
+		return nil, fmt.Errorf("file transfer: cannot stream task URL %v", task.Url)
+	}
+	return streamer.DownloadStream(task)
+}
+
+// downloadStream reports the progress of a streamed download and checks
+// its contents once it has been read.
+type downloadStream struct {
+	body io.ReadCloser
+
+	// size is the expected number of bytes, or -1 if unknown
+	size int64
+
+	// read is the number of bytes read so far
+	read int64
+
+	// callback is the task's progress callback, if any
+	callback func(processed, total int)
+
+	// hash receives the bytes read, if set
+	hash hash.Hash
+
+	// verify checks the contents at the end of the stream, if set
+	verify func(s *downloadStream) error
+
+	// err is the result of verify, returned by every read after the end
+	err error
+}
+
+func newDownloadStream(task *Task, body io.ReadCloser, size int64) *downloadStream {
+	return &downloadStream{
+		body:     body,
+		size:     size,
+		callback: task.ProgressCallback,
+	}
+}
+
+// withMD5 checks that the stream's base64-encoded MD5 is the task's
+// digest.
+func (s *downloadStream) withMD5(task *Task) *downloadStream {
+	if task.Digest == "" {
+		return s
+	}
+	s.hash = md5.New()
+	s.verify = func(s *downloadStream) error {
+		actual := base64.StdEncoding.EncodeToString(s.hash.Sum(nil))
+		return digestMismatch(task.Url, task.Digest, actual)
+	}
+	return s
+}
+
+// withSHA256 checks that the stream's contents have the given
+// "sha256:<hex>" digest.
+func (s *downloadStream) withSHA256(name string, digest string) *downloadStream {
+	s.hash = sha256.New()
+	s.verify = func(s *downloadStream) error {
+		actual := "sha256:" + hex.EncodeToString(s.hash.Sum(nil))
+		return digestMismatch(name, digest, actual)
+	}
+	return s
+}
+
+// withLength checks that the stream has exactly its expected size.
+func (s *downloadStream) withLength(name string) *downloadStream {
+	s.verify = func(s *downloadStream) error {
+		if s.size >= 0 && s.read != s.size {
+			return fmt.Errorf(
+				"file transfer: read %d bytes of %s, expected %d",
+				s.read, name, s.size,
+			)
+		}
+		return nil
+	}
+	return s
+}
+
+func (s *downloadStream) Read(p []byte) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+
+	n, err := s.body.Read(p)
+	if n > 0 {
+		s.read += int64(n)
+		if s.hash != nil {
+			_, _ = s.hash.Write(p[:n])
+		}
+		if s.callback != nil {
+			s.callback(int(s.read), int(s.size))
+		}
+	}
+
+	if err == io.EOF && s.verify != nil {
+		if s.err = s.verify(s); s.err != nil {
+			return n, s.err
+		}
+	}
+	return n, err
+}
+
+func (s *downloadStream) Close() error {
+	return s.body.Close()
+}
+
+// digestMismatch returns a DigestMismatchError if the digests differ.
+func digestMismatch(name string, expected string, actual string) error {
+	if actual == expected {
+		return nil
+	}
+	return &DigestMismatchError{
+		Path:     name,
+		Expected: expected,
+		Actual:   actual,
+	}
+}
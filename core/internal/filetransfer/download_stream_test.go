@@ -0,0 +1,148 @@
+package filetransfer_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+func TestDefaultFileTransfer_DownloadStream(t *testing.T) {
+	content := []byte("streamed content")
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/missing" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(content)
+		}))
+	defer server.Close()
+	ft := filetransfer.NewDefaultFileTransfer(
+		retryablehttp.NewClient(),
+		observability.NewNoOpLogger(),
+		filetransfer.NewFileTransferStats(),
+	)
+
+	t.Run("matching digest", func(t *testing.T) {
+		var progress int
+		task := &filetransfer.Task{
+			Type:   filetransfer.DownloadTask,
+			Url:    server.URL,
+			Digest: utils.ComputeB64MD5(content),
+		}
+		task.SetProgressCallback(func(processed, _ int) { progress = processed })
+
+		stream, err := ft.DownloadStream(task)
+		require.NoError(t, err)
+		defer stream.Close()
+		data, err := io.ReadAll(stream)
+
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+		assert.Equal(t, len(content), progress)
+	})
+
+	t.Run("mismatched digest", func(t *testing.T) {
+		task := &filetransfer.Task{
+			Type:   filetransfer.DownloadTask,
+			Url:    server.URL,
+			Digest: utils.ComputeB64MD5([]byte("other")),
+		}
+
+		stream, err := ft.DownloadStream(task)
+		require.NoError(t, err)
+		defer stream.Close()
+		_, err = io.ReadAll(stream)
+
+		assert.ErrorIs(t, err, filetransfer.ErrDigestMismatch)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		task := &filetransfer.Task{
+			Type: filetransfer.DownloadTask,
+			Url:  server.URL + "/missing",
+		}
+
+		_, err := ft.DownloadStream(task)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestLocalFileTransfer_DownloadStream(t *testing.T) {
+	source := t.TempDir()
+	writeLocalFiles(t, source, map[string]string{"data.csv": "a,b,c"})
+	ft := filetransfer.NewLocalFileTransfer(observability.NewNoOpLogger())
+
+	stream, err := ft.DownloadStream(&filetransfer.Task{
+		Type:      filetransfer.DownloadTask,
+		Url:       fileURL(filepath.Join(source, "data.csv")),
+		Digest:    utils.ComputeB64MD5([]byte("a,b,c")),
+		Reference: true,
+	})
+	require.NoError(t, err)
+	defer stream.Close()
+	data, err := io.ReadAll(stream)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "a,b,c", string(data))
+
+	_, err = ft.DownloadStream(&filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Url:  fileURL(source),
+	})
+	assert.ErrorContains(t, err, "is a directory")
+}
+
+func TestOCIFileTransfer_DownloadStreamDigestMismatch(t *testing.T) {
+	var tokenRequests atomic.Int32
+	digest := ociDigest("weights")
+	server := fakeRegistry(t, map[string]string{digest: "tampered"}, &tokenRequests)
+	defer server.Close()
+	ft := filetransfer.NewOCIFileTransfer(
+		ociClient(server), observability.NewNoOpLogger(), "", "")
+
+	stream, err := ft.DownloadStream(&filetransfer.Task{
+		Type:      filetransfer.DownloadTask,
+		Url:       ociURL(server, digest),
+		Reference: true,
+	})
+	require.NoError(t, err)
+	defer stream.Close()
+	_, err = io.ReadAll(stream)
+
+	assert.ErrorIs(t, err, filetransfer.ErrDigestMismatch)
+}
+
+func TestFileTransferManager_DownloadStream(t *testing.T) {
+	content := []byte("streamed content")
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(content)
+		}))
+	defer server.Close()
+	metrics := filetransfer.NewTransferMetrics()
+	fm := newTestFileTransferManager(filetransfer.WithTransferMetrics(metrics))
+
+	stream, err := fm.DownloadStream(&filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Url:  server.URL,
+	})
+	require.NoError(t, err)
+	defer stream.Close()
+	data, err := io.ReadAll(stream)
+
+	assert.NoError(t, err)
+	assert.Equal(t, content, data)
+	assert.EqualValues(t, len(content), metrics.Snapshot().DownloadedBytes)
+}
@@ -1,18 +1,29 @@
 package filetransfer
 
 import (
+	"compress/gzip"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"math"
 	"net/http"
 	"os"
 	"path"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/wandb/wandb/core/pkg/observability"
+	"golang.org/x/sync/errgroup"
 )
 
+// DefaultDownloadChunkSize is the size of each byte range in a ranged
+// download, if not otherwise specified.
+const DefaultDownloadChunkSize = 64 << 20 // 64 MiB
+
 // DefaultFileTransfer uploads or downloads files to/from the server
 type DefaultFileTransfer struct {
 	// client is the HTTP client for the file transfer
@@ -23,6 +34,43 @@ type DefaultFileTransfer struct {
 
 	// fileTransferStats is used to track upload/download progress
 	fileTransferStats FileTransferStats
+
+	// downloadConcurrency is the number of byte ranges downloaded at once
+	//
+	// Files are downloaded in a single request if this is at most 1.
+	downloadConcurrency int
+
+	// downloadChunkSize is the size of each downloaded byte range
+	downloadChunkSize int64
+
+	// compressGlobs are patterns for names of files to gzip before upload
+	compressGlobs []string
+}
+
+type DefaultFileTransferOption func(ft *DefaultFileTransfer)
+
+// WithRangedDownloads enables downloading files larger than `chunkSize`
+// as byte ranges, `concurrency` at a time.
+//
+// A non-positive chunk size selects DefaultDownloadChunkSize.
+func WithRangedDownloads(concurrency int, chunkSize int64) DefaultFileTransferOption {
+	return func(ft *DefaultFileTransfer) {
+		ft.downloadConcurrency = concurrency
+		if chunkSize > 0 {
+			ft.downloadChunkSize = chunkSize
+		}
+	}
+}
+
+// WithUploadCompression gzips uploaded files whose names match any of
+// the given glob patterns, and sends them with "Content-Encoding: gzip".
+//
+// Only whole files uploaded without a Content-MD5 header are compressed,
+// since the checksum is of the uncompressed contents.
+func WithUploadCompression(globs []string) DefaultFileTransferOption {
+	return func(ft *DefaultFileTransfer) {
+		ft.compressGlobs = globs
+	}
 }
 
 // NewDefaultFileTransfer creates a new fileTransfer
@@ -30,11 +78,16 @@ func NewDefaultFileTransfer(
 	client *retryablehttp.Client,
 	logger *observability.CoreLogger,
 	fileTransferStats FileTransferStats,
+	opts ...DefaultFileTransferOption,
 ) *DefaultFileTransfer {
 	fileTransfer := &DefaultFileTransfer{
 		logger:            logger,
 		client:            client,
 		fileTransferStats: fileTransferStats,
+		downloadChunkSize: DefaultDownloadChunkSize,
+	}
+	for _, opt := range opts {
+		opt(fileTransfer)
 	}
 	return fileTransfer
 }
@@ -44,7 +97,7 @@ func (ft *DefaultFileTransfer) Upload(task *Task) error {
 	ft.logger.Debug("default file transfer: uploading file", "path", task.Path, "url", task.Url)
 
 	// open the file for reading and defer closing it
-	file, err := os.Open(task.Path)
+	file, err := os.Open(task.uploadPath())
 	if err != nil {
 		return err
 	}
@@ -94,6 +147,7 @@ func (ft *DefaultFileTransfer) Upload(task *Task) error {
 	// To have it understand 0 as 0, the body must be set to nil or
 	// the NoBody sentinel.
 	var requestBody any
+	var contentEncoding string
 	if task.Size == 0 {
 		requestBody = http.NoBody
 	} else {
@@ -101,10 +155,26 @@ func (ft *DefaultFileTransfer) Upload(task *Task) error {
 			return fmt.Errorf("file transfer: file too large (%d bytes)", task.Size)
 		}
 
+		body := io.NewSectionReader(file, task.Offset, task.Size)
+		if task.Size == stat.Size() && ft.shouldCompress(task) {
+			compressed, size, err := ft.compress(body)
+			if err != nil {
+				return err
+			}
+			defer ft.removeCompressed(compressed)
+
+			body = io.NewSectionReader(compressed, 0, size)
+			contentEncoding = "gzip"
+		}
+
 		requestBody = NewProgressReader(
-			io.NewSectionReader(file, task.Offset, task.Size),
-			int(task.Size),
+			body,
+			int(body.Size()),
 			func(processed int, total int) {
+				// Report progress in terms of the uncompressed file.
+				processed = int(int64(processed) * task.Size / int64(total))
+				total = int(task.Size)
+
 				if task.ProgressCallback != nil {
 					task.ProgressCallback(processed, total)
 				}
@@ -131,6 +201,9 @@ func (ft *DefaultFileTransfer) Upload(task *Task) error {
 		}
 		req.Header.Set(parts[0], parts[1])
 	}
+	if contentEncoding != "" {
+		req.Header.Set("Content-Encoding", contentEncoding)
+	}
 	if task.Context != nil {
 		req = req.WithContext(task.Context)
 	}
@@ -139,13 +212,71 @@ func (ft *DefaultFileTransfer) Upload(task *Task) error {
 		return err
 	}
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return fmt.Errorf("file transfer: upload: failed to upload: %s", resp.Status)
+		return fmt.Errorf("file transfer: upload: failed to upload: %w", newStatusError(resp))
 	}
 	task.Response = resp
 
 	return nil
 }
 
+// shouldCompress returns whether to gzip the file uploaded by the task.
+func (ft *DefaultFileTransfer) shouldCompress(task *Task) bool {
+	for _, header := range task.Headers {
+		name, _, _ := strings.Cut(header, ":")
+		if strings.EqualFold(strings.TrimSpace(name), "Content-MD5") {
+			return false
+		}
+	}
+
+	name := filepath.Base(task.Path)
+	for _, glob := range ft.compressGlobs {
+		if matched, _ := filepath.Match(glob, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// compress gzips the reader's contents into a temporary file and returns
+// the file and its size.
+//
+// The file must be removed with removeCompressed.
+func (ft *DefaultFileTransfer) compress(src io.Reader) (*os.File, int64, error) {
+	tmpFile, err := os.CreateTemp("", "wandb-upload-*.gz")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	gz := gzip.NewWriter(tmpFile)
+	_, err = io.Copy(gz, src)
+	if err == nil {
+		err = gz.Close()
+	}
+	var size int64
+	if err == nil {
+		size, err = tmpFile.Seek(0, io.SeekEnd)
+	}
+	if err != nil {
+		ft.removeCompressed(tmpFile)
+		return nil, 0, fmt.Errorf("file transfer: upload: failed to compress: %v", err)
+	}
+
+	return tmpFile, size, nil
+}
+
+// removeCompressed closes and deletes a file created by compress.
+func (ft *DefaultFileTransfer) removeCompressed(file *os.File) {
+	_ = file.Close()
+	if err := os.Remove(file.Name()); err != nil {
+		ft.logger.CaptureError(
+			fmt.Errorf(
+				"file transfer: upload: error removing %s: %v",
+				file.Name(),
+				err,
+			))
+	}
+}
+
 // Download downloads a file from the server
 func (ft *DefaultFileTransfer) Download(task *Task) error {
 	ft.logger.Debug("default file transfer: downloading file", "path", task.Path, "url", task.Url)
@@ -163,46 +294,296 @@ func (ft *DefaultFileTransfer) Download(task *Task) error {
 		return err
 	}
 
-	// TODO: redo it to use the progress writer, to track the download progress
-	resp, err := ft.client.Get(task.Url)
+	if ft.downloadConcurrency > 1 {
+		return ft.downloadRanges(task)
+	}
+
+	resp, err := ft.get(task.Context, task, newTaskURL(task), 0, -1)
 	if err != nil {
 		return err
 	}
 	task.Response = resp
 
-	// open the file for writing and defer closing it
-	file, err := os.Create(task.Path)
+	return ft.writeBody(task, resp)
+}
+
+// DownloadStream opens a file on the server for reading.
+//
+// Ranged downloads aren't used, since the file is read in order.
+func (ft *DefaultFileTransfer) DownloadStream(task *Task) (io.ReadCloser, error) {
+	ft.logger.Debug("default file transfer: streaming file", "url", task.Url)
+
+	resp, err := ft.get(task.Context, task, newTaskURL(task), 0, -1)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer func(file *os.File) {
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("file transfer: download: failed to download: %w", newStatusError(resp))
+	}
+	task.Response = resp
+
+	stream := newDownloadStream(task, resp.Body, resp.ContentLength)
+	if task.Reference {
+		return stream, nil
+	}
+	return stream.withMD5(task), nil
+}
+
+// writeBody writes the full body of a download response to the task's path.
+func (ft *DefaultFileTransfer) writeBody(task *Task, resp *http.Response) error {
+	defer func(file io.ReadCloser) {
 		if err := file.Close(); err != nil {
 			ft.logger.CaptureError(
 				fmt.Errorf(
-					"file transfer: download: error closing file %s: %v",
-					task.Path,
+					"file transfer: download: error closing response reader: %v",
 					err,
 				))
 		}
-	}(file)
+	}(resp.Body)
 
-	defer func(file io.ReadCloser) {
-		if err := file.Close(); err != nil {
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("file transfer: download: failed to download: %w", newStatusError(resp))
+	}
+
+	// open the file for writing and defer closing it
+	file, err := os.Create(task.Path)
+	if err != nil {
+		return err
+	}
+	defer ft.closeDownloadedFile(task, file)
+
+	progress := NewProgressWriter(file, int(resp.ContentLength), task.ProgressCallback)
+	_, err = io.Copy(progress, resp.Body)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// downloadRanges downloads the file in byte ranges, several at a time.
+//
+// The first range is requested alone to learn the file's size. If the
+// server ignores the Range header, the file is downloaded in one request.
+//
+// Progress is persisted next to the file so that an interrupted download
+// of an unchanged file resumes with the ranges that are still missing.
+func (ft *DefaultFileTransfer) downloadRanges(task *Task) error {
+	url := newTaskURL(task)
+	resp, err := ft.get(task.Context, task, url, 0, ft.downloadChunkSize-1)
+	if err != nil {
+		return err
+	}
+	task.Response = resp
+
+	if resp.StatusCode != http.StatusPartialContent {
+		return ft.writeBody(task, resp)
+	}
+
+	size, err := parseContentRangeSize(resp.Header.Get("Content-Range"))
+	if err != nil {
+		_ = resp.Body.Close()
+		return fmt.Errorf("file transfer: download: %v", err)
+	}
+
+	// Only resume if we can tell that the remote file hasn't changed.
+	etag := resp.Header.Get("ETag")
+	state := loadDownloadState(task.Path)
+	resuming := state != nil && state.matches(size, ft.downloadChunkSize, etag)
+	if !resuming {
+		state = newDownloadState(task.Path, size, ft.downloadChunkSize, etag)
+	} else {
+		ft.logger.Info(
+			"file transfer: download: resuming",
+			"path", task.Path,
+			"completedRanges", len(state.Completed),
+		)
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE
+	if !resuming {
+		flags |= os.O_TRUNC
+	}
+	file, err := os.OpenFile(task.Path, flags, 0o666)
+	if err != nil {
+		_ = resp.Body.Close()
+		return err
+	}
+	defer ft.closeDownloadedFile(task, file)
+
+	if err := file.Truncate(size); err != nil {
+		_ = resp.Body.Close()
+		return err
+	}
+
+	markComplete := func(start int64) {
+		if etag == "" {
+			return
+		}
+		if err := state.markComplete(start); err != nil {
 			ft.logger.CaptureError(
 				fmt.Errorf(
-					"file transfer: download: error closing response reader: %v",
+					"file transfer: download: error saving progress: %v",
 					err,
-				))
+				),
+				"path", task.Path,
+			)
 		}
-	}(resp.Body)
+	}
+
+	// Progress includes the ranges downloaded before resuming.
+	progress := NewProgressWriter(io.Discard, int(size), task.ProgressCallback)
+	for start := ft.downloadChunkSize; start < size; start += ft.downloadChunkSize {
+		if state.isComplete(start) {
+			progress.written += int(min(ft.downloadChunkSize, size-start))
+		}
+	}
+
+	err = ft.writeRange(file, progress, resp, 0, min(ft.downloadChunkSize, size))
+	if err != nil {
+		return err
+	}
+	markComplete(0)
+
+	// Stop downloading the other ranges as soon as one fails.
+	ctx := task.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(ft.downloadConcurrency)
+	for start := ft.downloadChunkSize; start < size; start += ft.downloadChunkSize {
+		if state.isComplete(start) {
+			continue
+		}
+
+		end := min(start+ft.downloadChunkSize, size) - 1
+		g.Go(func() error {
+			resp, err := ft.get(ctx, task, url, start, end)
+			if err != nil {
+				return err
+			}
+			if resp.StatusCode != http.StatusPartialContent {
+				_ = resp.Body.Close()
+				return fmt.Errorf(
+					"file transfer: download: failed to download bytes %d-%d: %s",
+					start, end, resp.Status,
+				)
+			}
+			if err := ft.writeRange(file, progress, resp, start, end-start+1); err != nil {
+				return err
+			}
+			markComplete(start)
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return err
+	}
 
-	_, err = io.Copy(file, resp.Body)
+	return state.remove()
+}
+
+// get requests the bytes from `start` to `end` inclusive, or the whole
+// file if `end` is negative.
+//
+// If the URL has expired and the task can refresh it, the request is
+// made again with a fresh URL.
+//
+// The request is canceled when ctx is done, if ctx is not nil.
+func (ft *DefaultFileTransfer) get(
+	ctx context.Context,
+	task *Task,
+	url *taskURL,
+	start, end int64,
+) (*http.Response, error) {
+	expiredURL := url.get()
+	resp, err := ft.getURL(ctx, expiredURL, start, end)
+	if err != nil ||
+		resp.StatusCode != http.StatusForbidden ||
+		task.RefreshURL == nil {
+		return resp, err
+	}
+	_ = resp.Body.Close()
+
+	freshURL, err := url.refresh(expiredURL)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"file transfer: download: failed to refresh URL: %v", err)
+	}
+	return ft.getURL(ctx, freshURL, start, end)
+}
+
+// getURL requests the bytes from `start` to `end` inclusive, or the whole
+// file if `end` is negative.
+func (ft *DefaultFileTransfer) getURL(
+	ctx context.Context,
+	url string,
+	start, end int64,
+) (*http.Response, error) {
+	req, err := retryablehttp.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if end >= 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", start, end))
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+	return ft.client.Do(req)
+}
+
+// writeRange writes `length` bytes of the response body into the file
+// starting at `offset`, and closes the body.
+//
+// The bytes are also written to `progress` as they are read.
+func (ft *DefaultFileTransfer) writeRange(
+	file *os.File,
+	progress io.Writer,
+	resp *http.Response,
+	offset, length int64,
+) error {
+	defer func() { _ = resp.Body.Close() }()
+
+	n, err := io.Copy(
+		io.NewOffsetWriter(file, offset),
+		io.TeeReader(io.LimitReader(resp.Body, length), progress),
+	)
 	if err != nil {
 		return err
 	}
+	if n != length {
+		return fmt.Errorf(
+			"file transfer: download: expected %d bytes at offset %d, got %d",
+			length, offset, n,
+		)
+	}
 	return nil
 }
 
+func (ft *DefaultFileTransfer) closeDownloadedFile(task *Task, file *os.File) {
+	if err := file.Close(); err != nil {
+		ft.logger.CaptureError(
+			fmt.Errorf(
+				"file transfer: download: error closing file %s: %v",
+				task.Path,
+				err,
+			))
+	}
+}
+
+// parseContentRangeSize returns the complete size from a Content-Range
+// header like "bytes 0-1023/4096".
+func parseContentRangeSize(contentRange string) (int64, error) {
+	_, size, found := strings.Cut(contentRange, "/")
+	if !found || size == "*" {
+		return 0, errors.New("missing size in Content-Range header")
+	}
+	return strconv.ParseInt(size, 10, 64)
+}
+
 type ProgressReader struct {
 	io.ReadSeeker
 	len      int
@@ -238,3 +619,43 @@ func (pr *ProgressReader) Read(p []byte) (int, error) {
 func (pr *ProgressReader) Len() int {
 	return int(pr.len)
 }
+
+// ProgressWriter reports the number of bytes written through it.
+//
+// It is safe to write to concurrently.
+type ProgressWriter struct {
+	io.Writer
+
+	mu       sync.Mutex
+	len      int
+	written  int
+	callback func(processed, total int)
+}
+
+// NewProgressWriter wraps a writer to report progress after each write.
+//
+// The size is the total number of bytes expected, or -1 if unknown.
+func NewProgressWriter(
+	writer io.Writer,
+	size int,
+	callback func(processed, total int),
+) *ProgressWriter {
+	return &ProgressWriter{
+		Writer:   writer,
+		len:      size,
+		callback: callback,
+	}
+}
+
+func (pw *ProgressWriter) Write(p []byte) (int, error) {
+	n, err := pw.Writer.Write(p)
+
+	pw.mu.Lock()
+	defer pw.mu.Unlock()
+
+	pw.written += n
+	if pw.callback != nil {
+		pw.callback(pw.written, pw.len)
+	}
+	return n, err
+}
@@ -1,17 +1,23 @@
 package filetransfer_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/pkg/observability"
 )
@@ -304,3 +310,303 @@ func impatientClient() *retryablehttp.Client {
 	client.RetryWaitMin = 1 * time.Millisecond
 	return client
 }
+
+func TestDefaultFileTransfer_DownloadRanges(t *testing.T) {
+	contentExpected := []byte(strings.Repeat("0123456789", 10))
+	var numRequests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		numRequests.Add(1)
+		assert.NotEmpty(t, r.Header.Get("Range"))
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(contentExpected))
+	}))
+	defer server.Close()
+
+	ft := filetransfer.NewDefaultFileTransfer(
+		impatientClient(),
+		observability.NewNoOpLogger(),
+		filetransfer.NewFileTransferStats(),
+		filetransfer.WithRangedDownloads(4, 16),
+	)
+	task := &filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: filepath.Join(t.TempDir(), "file"),
+		Url:  server.URL,
+	}
+	var processedBytes, totalBytes int
+	task.SetProgressCallback(func(processed, total int) {
+		processedBytes, totalBytes = processed, total
+	})
+
+	err := ft.Download(task)
+	assert.NoError(t, err)
+
+	content, err := os.ReadFile(task.Path)
+	assert.NoError(t, err)
+	assert.Equal(t, contentExpected, content)
+	assert.EqualValues(t, 7, numRequests.Load())
+	assert.Equal(t, len(contentExpected), processedBytes)
+	assert.Equal(t, len(contentExpected), totalBytes)
+}
+
+func TestDefaultFileTransfer_DownloadRangesUnsupported(t *testing.T) {
+	contentExpected := []byte(strings.Repeat("0123456789", 10))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write(contentExpected)
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	ft := filetransfer.NewDefaultFileTransfer(
+		impatientClient(),
+		observability.NewNoOpLogger(),
+		filetransfer.NewFileTransferStats(),
+		filetransfer.WithRangedDownloads(4, 16),
+	)
+	task := &filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: filepath.Join(t.TempDir(), "file"),
+		Url:  server.URL,
+	}
+
+	err := ft.Download(task)
+	assert.NoError(t, err)
+
+	content, err := os.ReadFile(task.Path)
+	assert.NoError(t, err)
+	assert.Equal(t, contentExpected, content)
+}
+
+func TestDefaultFileTransfer_DownloadRangesStopsOnError(t *testing.T) {
+	contentExpected := []byte(strings.Repeat("0123456789", 10))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Range") {
+		case "bytes=16-31":
+			// Hang until the client gives up on the request.
+			<-r.Context().Done()
+		case "bytes=32-47":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(contentExpected))
+		}
+	}))
+	defer server.Close()
+
+	ft := filetransfer.NewDefaultFileTransfer(
+		impatientClient(),
+		observability.NewNoOpLogger(),
+		filetransfer.NewFileTransferStats(),
+		filetransfer.WithRangedDownloads(2, 16),
+	)
+	task := &filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: filepath.Join(t.TempDir(), "file"),
+		Url:  server.URL,
+	}
+
+	err := ft.Download(task)
+
+	assert.ErrorContains(t, err, "404")
+}
+
+func TestDefaultFileTransfer_DownloadRangesResumes(t *testing.T) {
+	contentExpected := []byte(strings.Repeat("0123456789", 10))
+	var failRange atomic.Bool
+	var numRequests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		numRequests.Add(1)
+		if failRange.Load() && r.Header.Get("Range") == "bytes=48-63" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(contentExpected))
+	}))
+	defer server.Close()
+
+	ft := filetransfer.NewDefaultFileTransfer(
+		impatientClient(),
+		observability.NewNoOpLogger(),
+		filetransfer.NewFileTransferStats(),
+		filetransfer.WithRangedDownloads(2, 16),
+	)
+	task := &filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: filepath.Join(t.TempDir(), "file"),
+		Url:  server.URL,
+	}
+
+	failRange.Store(true)
+	assert.Error(t, ft.Download(task))
+	assert.FileExists(t, task.Path+".wandb-download")
+
+	failRange.Store(false)
+	numRequests.Store(0)
+	assert.NoError(t, ft.Download(task))
+
+	content, err := os.ReadFile(task.Path)
+	assert.NoError(t, err)
+	assert.Equal(t, contentExpected, content)
+	assert.NoFileExists(t, task.Path+".wandb-download")
+	// Ranges completed before the failure are not requested again.
+	assert.Less(t, numRequests.Load(), int32(7))
+}
+
+func TestDefaultFileTransfer_DownloadRefreshesExpiredURL(t *testing.T) {
+	contentExpected := []byte(strings.Repeat("0123456789", 10))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("sig") != "fresh" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(contentExpected))
+	}))
+	defer server.Close()
+
+	for _, concurrency := range []int{1, 4} {
+		t.Run(fmt.Sprintf("concurrency=%d", concurrency), func(t *testing.T) {
+			ft := filetransfer.NewDefaultFileTransfer(
+				impatientClient(),
+				observability.NewNoOpLogger(),
+				filetransfer.NewFileTransferStats(),
+				filetransfer.WithRangedDownloads(concurrency, 16),
+			)
+			var numRefreshes atomic.Int32
+			task := &filetransfer.Task{
+				Type: filetransfer.DownloadTask,
+				Path: filepath.Join(t.TempDir(), "file"),
+				Url:  server.URL + "?sig=expired",
+				RefreshURL: func() (string, error) {
+					numRefreshes.Add(1)
+					return server.URL + "?sig=fresh", nil
+				},
+			}
+
+			err := ft.Download(task)
+			assert.NoError(t, err)
+
+			content, err := os.ReadFile(task.Path)
+			assert.NoError(t, err)
+			assert.Equal(t, contentExpected, content)
+			assert.EqualValues(t, 1, numRefreshes.Load())
+		})
+	}
+}
+
+func TestDefaultFileTransfer_DownloadForbiddenWithoutRefresh(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+	ft := filetransfer.NewDefaultFileTransfer(
+		impatientClient(),
+		observability.NewNoOpLogger(),
+		filetransfer.NewFileTransferStats(),
+	)
+	task := &filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: filepath.Join(t.TempDir(), "file"),
+		Url:  server.URL,
+	}
+
+	err := ft.Download(task)
+
+	assert.ErrorContains(t, err, "403")
+}
+
+func TestDefaultFileTransfer_DownloadErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("not found"))
+	}))
+	defer server.Close()
+	ft := filetransfer.NewDefaultFileTransfer(
+		impatientClient(),
+		observability.NewNoOpLogger(),
+		filetransfer.NewFileTransferStats(),
+	)
+	task := &filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: filepath.Join(t.TempDir(), "file"),
+		Url:  server.URL,
+	}
+
+	err := ft.Download(task)
+
+	assert.ErrorContains(t, err, "404")
+	assert.NoFileExists(t, task.Path)
+}
+
+func TestDefaultFileTransfer_UploadCompressed(t *testing.T) {
+	contentExpected := []byte(strings.Repeat("a,b,c\n", 1000))
+	var contentEncoding string
+	var uploaded []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentEncoding = r.Header.Get("Content-Encoding")
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		uploaded = body
+	}))
+	defer server.Close()
+
+	ft := filetransfer.NewDefaultFileTransfer(
+		impatientClient(),
+		observability.NewNoOpLogger(),
+		filetransfer.NewFileTransferStats(),
+		filetransfer.WithUploadCompression([]string{"*.csv"}),
+	)
+	dir := t.TempDir()
+	for _, name := range []string{"data.csv", "data.bin"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), contentExpected, 0o644))
+	}
+
+	t.Run("matching file", func(t *testing.T) {
+		var processedBytes, totalBytes int
+		task := &filetransfer.Task{
+			Type: filetransfer.UploadTask,
+			Path: filepath.Join(dir, "data.csv"),
+			Url:  server.URL,
+		}
+		task.SetProgressCallback(func(processed, total int) {
+			processedBytes, totalBytes = processed, total
+		})
+
+		require.NoError(t, ft.Upload(task))
+
+		assert.Equal(t, "gzip", contentEncoding)
+		assert.Less(t, len(uploaded), len(contentExpected))
+		reader, err := gzip.NewReader(bytes.NewReader(uploaded))
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, contentExpected, content)
+		assert.Equal(t, len(contentExpected), processedBytes)
+		assert.Equal(t, len(contentExpected), totalBytes)
+	})
+
+	t.Run("with Content-MD5", func(t *testing.T) {
+		task := &filetransfer.Task{
+			Type:    filetransfer.UploadTask,
+			Path:    filepath.Join(dir, "data.csv"),
+			Url:     server.URL,
+			Headers: []string{"Content-Md5:abc"},
+		}
+
+		require.NoError(t, ft.Upload(task))
+
+		assert.Empty(t, contentEncoding)
+		assert.Equal(t, contentExpected, uploaded)
+	})
+
+	t.Run("other file", func(t *testing.T) {
+		task := &filetransfer.Task{
+			Type: filetransfer.UploadTask,
+			Path: filepath.Join(dir, "data.bin"),
+			Url:  server.URL,
+		}
+
+		require.NoError(t, ft.Upload(task))
+
+		assert.Empty(t, contentEncoding)
+		assert.Equal(t, contentExpected, uploaded)
+	})
+}
@@ -0,0 +1,514 @@
+package filetransfer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// DefaultWebHDFSPort is the NameNode's HTTP port used for hdfs:// URLs.
+//
+// hdfs:// URLs usually name the NameNode's RPC port, which can't be used
+// for WebHDFS requests.
+const DefaultWebHDFSPort = "9870"
+
+// errHDFSNotFound indicates that a path doesn't exist in HDFS.
+var errHDFSNotFound = errors.New("not found")
+
+// HDFSFileTransfer downloads files from HDFS through its WebHDFS REST API.
+//
+// It accepts hdfs://, webhdfs:// and swebhdfs:// URLs. For webhdfs:// and
+// swebhdfs://, the port is the NameNode's HTTP(S) port, as in Hadoop.
+type HDFSFileTransfer struct {
+	// client is the HTTP client for WebHDFS requests
+	client *retryablehttp.Client
+
+	// logger is the logger for the file transfer
+	logger *observability.CoreLogger
+
+	// userName is sent as the "user.name" parameter, if set
+	userName string
+
+	// symlinkPolicy is how links in downloaded directories are handled
+	symlinkPolicy SymlinkPolicy
+
+	// filter selects files in directories for tasks without a filter
+	filter NameFilter
+}
+
+type HDFSFileTransferOption func(ft *HDFSFileTransfer)
+
+// WithSymlinkPolicy sets how links in downloaded directories are handled.
+func WithSymlinkPolicy(policy SymlinkPolicy) HDFSFileTransferOption {
+	return func(ft *HDFSFileTransfer) {
+		ft.symlinkPolicy = policy
+	}
+}
+
+// WithDefaultNameFilter sets the filter for files in directories that's
+// used by tasks without their own.
+func WithDefaultNameFilter(filter NameFilter) HDFSFileTransferOption {
+	return func(ft *HDFSFileTransfer) {
+		ft.filter = filter
+	}
+}
+
+// NewHDFSFileTransfer creates a new HDFSFileTransfer.
+//
+// The user name for requests to clusters without Kerberos is read from
+// the HADOOP_USER_NAME environment variable.
+func NewHDFSFileTransfer(
+	client *retryablehttp.Client,
+	logger *observability.CoreLogger,
+	opts ...HDFSFileTransferOption,
+) *HDFSFileTransfer {
+	ft := &HDFSFileTransfer{
+		client:        client,
+		logger:        logger,
+		userName:      os.Getenv("HADOOP_USER_NAME"),
+		symlinkPolicy: SymlinkSkip,
+	}
+	for _, opt := range opts {
+		opt(ft)
+	}
+	return ft
+}
+
+// Upload is not supported for HDFS.
+func (ft *HDFSFileTransfer) Upload(task *Task) error {
+	return errors.New("file transfer: hdfs: upload is not supported")
+}
+
+// Download downloads a file or a directory from HDFS.
+//
+// If the URL names a directory, every file under it is downloaded to the
+// corresponding path under the task's path. Links are handled according
+// to the symlink policy, and other special files are skipped. Files and
+// links that don't match the name filter are skipped too.
+func (ft *HDFSFileTransfer) Download(task *Task) error {
+	ft.logger.Debug("hdfs file transfer: downloading", "path", task.Path, "url", task.Url)
+
+	ref, err := url.Parse(task.Url)
+	if err != nil {
+		return fmt.Errorf("file transfer: hdfs: invalid URL: %v", err)
+	}
+
+	status, err := ft.getFileStatus(task, ref, ref.Path)
+	if err != nil {
+		return err
+	}
+
+	if status.Type == "DIRECTORY" {
+		return ft.downloadDir(task, ref, ref.Path, task.Path)
+	}
+	return ft.downloadFile(task, ref, ref.Path, task.Path, status.Length)
+}
+
+// DownloadStream opens a file for reading.
+//
+// Directories can't be streamed. The file's length is checked once it
+// has been read.
+func (ft *HDFSFileTransfer) DownloadStream(task *Task) (io.ReadCloser, error) {
+	ft.logger.Debug("hdfs file transfer: streaming", "url", task.Url)
+
+	ref, err := url.Parse(task.Url)
+	if err != nil {
+		return nil, fmt.Errorf("file transfer: hdfs: invalid URL: %v", err)
+	}
+
+	status, err := ft.getFileStatus(task, ref, ref.Path)
+	if err != nil {
+		return nil, err
+	}
+	if status.Type == "DIRECTORY" {
+		return nil, fmt.Errorf("file transfer: hdfs: %s is a directory", task.Url)
+	}
+
+	resp, err := ft.get(task, ref, ref.Path, "OPEN")
+	if err != nil {
+		return nil, err
+	}
+
+	stream := newDownloadStream(task, resp.Body, status.Length)
+	return stream.withLength(ref.Path), nil
+}
+
+// Verify checks that a file or directory exists and that its size
+// matches the task's.
+//
+// For directories, the size is the total size of the files inside that
+// match the name filter.
+// HDFS files have no version that can be compared to a digest.
+func (ft *HDFSFileTransfer) Verify(task *Task) (*VerificationReport, error) {
+	ref, err := url.Parse(task.Url)
+	if err != nil {
+		return nil, fmt.Errorf("file transfer: hdfs: invalid URL: %v", err)
+	}
+
+	status, err := ft.getFileStatus(task, ref, ref.Path)
+	if errors.Is(err, errHDFSNotFound) {
+		report := &VerificationReport{Size: -1}
+		report.check(task)
+		return report, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	report := &VerificationReport{Exists: true}
+	if status.Type == "DIRECTORY" {
+		err = ft.measureDir(task, ref, ref.Path, report)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		report.Size = status.Length
+		report.Files = 1
+	}
+
+	report.check(task)
+	return report, nil
+}
+
+// measureDir adds the sizes and number of files under a directory
+// to the report.
+func (ft *HDFSFileTransfer) measureDir(
+	task *Task,
+	ref *url.URL,
+	remoteDir string,
+	report *VerificationReport,
+) error {
+	var listing struct {
+		FileStatuses struct {
+			FileStatus []hdfsFileStatus `json:"FileStatus"`
+		} `json:"FileStatuses"`
+	}
+	if err := ft.getJSON(task, ref, remoteDir, "LISTSTATUS", &listing); err != nil {
+		return err
+	}
+
+	for _, status := range listing.FileStatuses.FileStatus {
+		remotePath := path.Join(remoteDir, status.PathSuffix)
+
+		switch status.Type {
+		case "DIRECTORY":
+			if err := ft.measureDir(task, ref, remotePath, report); err != nil {
+				return err
+			}
+		case "FILE":
+			if !ft.nameFilter(task).Match(relativeHDFSName(ref, remotePath)) {
+				continue
+			}
+			report.Size += status.Length
+			report.Files++
+		}
+	}
+
+	return nil
+}
+
+// hdfsFileStatus is a WebHDFS FileStatus object.
+type hdfsFileStatus struct {
+	PathSuffix string `json:"pathSuffix"`
+	Type       string `json:"type"`
+	Length     int64  `json:"length"`
+	Symlink    string `json:"symlink"`
+}
+
+// downloadDir downloads every file under a directory.
+func (ft *HDFSFileTransfer) downloadDir(
+	task *Task,
+	ref *url.URL,
+	remoteDir string,
+	localDir string,
+) error {
+	return ft.downloadDirUnder(task, ref, remoteDir, localDir, localDir)
+}
+
+// downloadDirUnder downloads every file under a directory that's part of
+// a download into rootDir.
+func (ft *HDFSFileTransfer) downloadDirUnder(
+	task *Task,
+	ref *url.URL,
+	remoteDir string,
+	localDir string,
+	rootDir string,
+) error {
+	var listing struct {
+		FileStatuses struct {
+			FileStatus []hdfsFileStatus `json:"FileStatus"`
+		} `json:"FileStatuses"`
+	}
+	if err := ft.getJSON(task, ref, remoteDir, "LISTSTATUS", &listing); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(localDir, os.ModePerm); err != nil {
+		return err
+	}
+
+	for _, status := range listing.FileStatuses.FileStatus {
+		localPath, err := LocalPathUnder(localDir, status.PathSuffix)
+		if err != nil {
+			return err
+		}
+		remotePath := path.Join(remoteDir, status.PathSuffix)
+
+		if status.Type != "DIRECTORY" &&
+			!ft.nameFilter(task).Match(relativeHDFSName(ref, remotePath)) {
+			continue
+		}
+
+		switch status.Type {
+		case "DIRECTORY":
+			err = ft.downloadDirUnder(task, ref, remotePath, localPath, rootDir)
+		case "FILE":
+			err = ft.downloadFile(task, ref, remotePath, localPath, status.Length)
+		case "SYMLINK":
+			err = ft.downloadSymlink(task, ref, remotePath, localPath, rootDir, status)
+		default:
+			ft.logger.Warn(
+				"hdfs file transfer: skipping special file",
+				"path", remotePath,
+				"type", status.Type,
+			)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// downloadSymlink handles a link according to the symlink policy.
+func (ft *HDFSFileTransfer) downloadSymlink(
+	task *Task,
+	ref *url.URL,
+	remotePath string,
+	localPath string,
+	rootDir string,
+	status hdfsFileStatus,
+) error {
+	switch ft.symlinkPolicy {
+	case SymlinkFollow:
+		// The NameNode resolves links in OPEN requests.
+		return ft.downloadFile(task, ref, remotePath, localPath, -1)
+
+	case SymlinkPreserve:
+		target := status.Symlink
+		if path.IsAbs(target) {
+			// Absolute links refer to HDFS paths, which don't exist locally.
+			target = relativeHDFSLink(remotePath, target)
+		}
+		if target != "" {
+			target = filepath.Clean(filepath.FromSlash(target))
+			if isLinkUnder(rootDir, localPath, target) {
+				_ = os.Remove(localPath)
+				return os.Symlink(target, localPath)
+			}
+		}
+	}
+
+	ft.logger.Warn(
+		"hdfs file transfer: skipping symlink",
+		"path", remotePath,
+		"target", status.Symlink,
+	)
+	return nil
+}
+
+// nameFilter returns the filter for files in the task's directory.
+func (ft *HDFSFileTransfer) nameFilter(task *Task) NameFilter {
+	if task.Filter.IsEmpty() {
+		return ft.filter
+	}
+	return task.Filter
+}
+
+// relativeHDFSName returns a path's name relative to the referenced
+// directory.
+func relativeHDFSName(ref *url.URL, remotePath string) string {
+	return strings.TrimPrefix(remotePath, strings.TrimSuffix(ref.Path, "/")+"/")
+}
+
+// relativeHDFSLink returns an absolute link target relative to the link's
+// directory.
+func relativeHDFSLink(linkPath string, target string) string {
+	relTarget, err := filepath.Rel(
+		filepath.FromSlash(path.Dir(linkPath)),
+		filepath.FromSlash(target),
+	)
+	if err != nil {
+		return ""
+	}
+	return filepath.ToSlash(relTarget)
+}
+
+// downloadFile downloads a single file and checks its length.
+//
+// HDFS checksums are composite CRCs that can't be compared to the MD5
+// digests in artifact manifests, so the length is the only check done here.
+// A negative length skips the check.
+func (ft *HDFSFileTransfer) downloadFile(
+	task *Task,
+	ref *url.URL,
+	remotePath string,
+	localPath string,
+	length int64,
+) error {
+	// The NameNode redirects OPEN requests to a DataNode.
+	resp, err := ft.get(task, ref, remotePath, "OPEN")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := os.MkdirAll(filepath.Dir(localPath), os.ModePerm); err != nil {
+		return err
+	}
+	file, err := os.Create(localPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			ft.logger.CaptureError(
+				fmt.Errorf(
+					"file transfer: hdfs: error closing file %s: %v",
+					localPath,
+					err,
+				))
+		}
+	}()
+
+	n, err := io.Copy(file, resp.Body)
+	if err != nil {
+		return err
+	}
+	if length >= 0 && n != length {
+		return fmt.Errorf(
+			"file transfer: hdfs: downloaded %d bytes of %s, expected %d",
+			n, remotePath, length,
+		)
+	}
+	return nil
+}
+
+// getFileStatus returns the status of a file or directory.
+func (ft *HDFSFileTransfer) getFileStatus(
+	task *Task,
+	ref *url.URL,
+	remotePath string,
+) (*hdfsFileStatus, error) {
+	var status struct {
+		FileStatus hdfsFileStatus `json:"FileStatus"`
+	}
+	if err := ft.getJSON(task, ref, remotePath, "GETFILESTATUS", &status); err != nil {
+		return nil, err
+	}
+	return &status.FileStatus, nil
+}
+
+// getJSON makes a WebHDFS request and decodes its JSON response.
+func (ft *HDFSFileTransfer) getJSON(
+	task *Task,
+	ref *url.URL,
+	remotePath string,
+	op string,
+	v any,
+) error {
+	resp, err := ft.get(task, ref, remotePath, op)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("file transfer: hdfs: invalid %s response: %v", op, err)
+	}
+	return nil
+}
+
+// get makes a WebHDFS GET request and checks its status.
+func (ft *HDFSFileTransfer) get(
+	task *Task,
+	ref *url.URL,
+	remotePath string,
+	op string,
+) (*http.Response, error) {
+	endpoint, err := ft.webHDFSURL(ref, remotePath, op)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := retryablehttp.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if task.Context != nil {
+		req = req.WithContext(task.Context)
+	}
+
+	resp, err := ft.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf(
+			"file transfer: hdfs: %s %s failed: %w: %w",
+			op, remotePath, newStatusError(resp), errHDFSNotFound,
+		)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf(
+			"file transfer: hdfs: %s %s failed: %s",
+			op, remotePath, resp.Status,
+		)
+	}
+	return resp, nil
+}
+
+// webHDFSURL returns the WebHDFS REST endpoint for an operation on a path.
+func (ft *HDFSFileTransfer) webHDFSURL(
+	ref *url.URL,
+	remotePath string,
+	op string,
+) (string, error) {
+	endpoint := &url.URL{
+		Scheme: "http",
+		Host:   ref.Host,
+		Path:   "/webhdfs/v1/" + strings.TrimPrefix(remotePath, "/"),
+	}
+
+	switch ref.Scheme {
+	case "hdfs":
+		endpoint.Host = ref.Hostname() + ":" + DefaultWebHDFSPort
+	case "webhdfs":
+	case "swebhdfs":
+		endpoint.Scheme = "https"
+	default:
+		return "", fmt.Errorf("file transfer: hdfs: unsupported scheme %q", ref.Scheme)
+	}
+
+	query := url.Values{}
+	query.Set("op", op)
+	if ft.userName != "" {
+		query.Set("user.name", ft.userName)
+	}
+	endpoint.RawQuery = query.Encode()
+
+	return endpoint.String(), nil
+}
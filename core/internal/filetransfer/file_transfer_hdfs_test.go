@@ -0,0 +1,393 @@
+package filetransfer_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// fakeWebHDFS serves a WebHDFS API for the given files and directories.
+func fakeWebHDFS(t *testing.T, files map[string]string) *httptest.Server {
+	isDir := func(p string) bool {
+		for name := range files {
+			if strings.HasPrefix(name, p+"/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, found := strings.CutPrefix(r.URL.Path, "/webhdfs/v1")
+		require.True(t, found)
+		content, isFile := files[p]
+
+		switch r.URL.Query().Get("op") {
+		case "GETFILESTATUS":
+			switch {
+			case isFile:
+				fmt.Fprintf(w, `{"FileStatus":{"type":"FILE","length":%d}}`, len(content))
+			case isDir(p):
+				fmt.Fprint(w, `{"FileStatus":{"type":"DIRECTORY","length":0}}`)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+
+		case "LISTSTATUS":
+			var statuses []string
+			seen := map[string]bool{}
+			for name, content := range files {
+				rest, ok := strings.CutPrefix(name, p+"/")
+				if !ok {
+					continue
+				}
+				child, _, nested := strings.Cut(rest, "/")
+				if seen[child] {
+					continue
+				}
+				seen[child] = true
+				if nested {
+					statuses = append(statuses,
+						fmt.Sprintf(`{"pathSuffix":%q,"type":"DIRECTORY","length":0}`, child))
+				} else {
+					statuses = append(statuses,
+						fmt.Sprintf(`{"pathSuffix":%q,"type":"FILE","length":%d}`, child, len(content)))
+				}
+			}
+			fmt.Fprintf(w, `{"FileStatuses":{"FileStatus":[%s]}}`, strings.Join(statuses, ","))
+
+		case "OPEN":
+			if !isFile {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(content))
+
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+}
+
+func webHDFSURL(server *httptest.Server, p string) string {
+	return "webhdfs://" + strings.TrimPrefix(server.URL, "http://") + p
+}
+
+func TestHDFSFileTransfer_DownloadFile(t *testing.T) {
+	server := fakeWebHDFS(t, map[string]string{"/data/file.txt": "file contents"})
+	defer server.Close()
+	ft := filetransfer.NewHDFSFileTransfer(impatientClient(), observability.NewNoOpLogger())
+	task := &filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: filepath.Join(t.TempDir(), "file.txt"),
+		Url:  webHDFSURL(server, "/data/file.txt"),
+	}
+
+	err := ft.Download(task)
+
+	require.NoError(t, err)
+	content, err := os.ReadFile(task.Path)
+	require.NoError(t, err)
+	assert.Equal(t, "file contents", string(content))
+}
+
+func TestHDFSFileTransfer_DownloadDirectory(t *testing.T) {
+	server := fakeWebHDFS(t, map[string]string{
+		"/data/a.txt":        "a",
+		"/data/sub/b.txt":    "bb",
+		"/data/sub/deep/c":   "ccc",
+		"/other/ignored.txt": "ignored",
+	})
+	defer server.Close()
+	ft := filetransfer.NewHDFSFileTransfer(impatientClient(), observability.NewNoOpLogger())
+	dir := t.TempDir()
+	task := &filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: dir,
+		Url:  webHDFSURL(server, "/data"),
+	}
+
+	err := ft.Download(task)
+
+	require.NoError(t, err)
+	for name, expected := range map[string]string{
+		"a.txt":      "a",
+		"sub/b.txt":  "bb",
+		"sub/deep/c": "ccc",
+	} {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(content))
+	}
+	assert.NoFileExists(t, filepath.Join(dir, "ignored.txt"))
+}
+
+func TestHDFSFileTransfer_DownloadDirectoryFiltered(t *testing.T) {
+	server := fakeWebHDFS(t, map[string]string{
+		"/data/shard-0/a.bin":   "a",
+		"/data/shard-0/b.tmp":   "b",
+		"/data/shard-1/c.bin":   "c",
+		"/data/shard-2/d.bin":   "d",
+		"/data/other/index.txt": "index",
+	})
+	defer server.Close()
+	ft := filetransfer.NewHDFSFileTransfer(
+		impatientClient(),
+		observability.NewNoOpLogger(),
+		filetransfer.WithDefaultNameFilter(filetransfer.NameFilter{
+			Exclude: []string{"*.tmp"},
+		}),
+	)
+	dir := t.TempDir()
+	task := &filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: dir,
+		Url:  webHDFSURL(server, "/data"),
+		Filter: filetransfer.NameFilter{
+			Include: []string{"shard-[01]/*"},
+		},
+	}
+
+	err := ft.Download(task)
+
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dir, "shard-0", "a.bin"))
+	assert.FileExists(t, filepath.Join(dir, "shard-0", "b.tmp"))
+	assert.FileExists(t, filepath.Join(dir, "shard-1", "c.bin"))
+	assert.NoFileExists(t, filepath.Join(dir, "shard-2", "d.bin"))
+	assert.NoFileExists(t, filepath.Join(dir, "other", "index.txt"))
+}
+
+func TestHDFSFileTransfer_DownloadDirectoryDefaultFilter(t *testing.T) {
+	server := fakeWebHDFS(t, map[string]string{
+		"/data/a.bin": "a",
+		"/data/b.tmp": "b",
+	})
+	defer server.Close()
+	ft := filetransfer.NewHDFSFileTransfer(
+		impatientClient(),
+		observability.NewNoOpLogger(),
+		filetransfer.WithDefaultNameFilter(filetransfer.NameFilter{
+			Exclude: []string{"*.tmp"},
+		}),
+	)
+	dir := t.TempDir()
+	task := &filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: dir,
+		Url:  webHDFSURL(server, "/data"),
+	}
+
+	err := ft.Download(task)
+
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dir, "a.bin"))
+	assert.NoFileExists(t, filepath.Join(dir, "b.tmp"))
+}
+
+func TestHDFSFileTransfer_DownloadNotFound(t *testing.T) {
+	server := fakeWebHDFS(t, map[string]string{})
+	defer server.Close()
+	ft := filetransfer.NewHDFSFileTransfer(impatientClient(), observability.NewNoOpLogger())
+	task := &filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: filepath.Join(t.TempDir(), "file.txt"),
+		Url:  webHDFSURL(server, "/missing"),
+	}
+
+	err := ft.Download(task)
+
+	assert.ErrorContains(t, err, "404")
+}
+
+func TestHDFSFileTransfer_VerifyDirectory(t *testing.T) {
+	server := fakeWebHDFS(t, map[string]string{
+		"/data/a.txt":      "a",
+		"/data/sub/b.txt":  "bb",
+		"/data/sub/deep/c": "ccc",
+	})
+	defer server.Close()
+	ft := filetransfer.NewHDFSFileTransfer(impatientClient(), observability.NewNoOpLogger())
+	task := &filetransfer.Task{
+		Type: filetransfer.VerifyTask,
+		Path: filepath.Join(t.TempDir(), "data"),
+		Url:  webHDFSURL(server, "/data"),
+		Size: 6,
+	}
+
+	report, err := ft.Verify(task)
+
+	require.NoError(t, err)
+	assert.True(t, report.OK())
+	assert.EqualValues(t, 6, report.Size)
+	assert.Equal(t, 3, report.Files)
+	assert.NoDirExists(t, task.Path)
+}
+
+func TestHDFSFileTransfer_VerifyNotFound(t *testing.T) {
+	server := fakeWebHDFS(t, map[string]string{})
+	defer server.Close()
+	ft := filetransfer.NewHDFSFileTransfer(impatientClient(), observability.NewNoOpLogger())
+	task := &filetransfer.Task{
+		Type: filetransfer.VerifyTask,
+		Url:  webHDFSURL(server, "/missing"),
+	}
+
+	report, err := ft.Verify(task)
+
+	require.NoError(t, err)
+	assert.False(t, report.Exists)
+	assert.False(t, report.OK())
+}
+
+// fakeWebHDFSListing serves a directory "/data" with the given LISTSTATUS
+// entries, whose files all contain "contents".
+func fakeWebHDFSListing(statuses string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("op") {
+		case "GETFILESTATUS":
+			fmt.Fprint(w, `{"FileStatus":{"type":"DIRECTORY","length":0}}`)
+		case "LISTSTATUS":
+			fmt.Fprintf(w, `{"FileStatuses":{"FileStatus":[%s]}}`, statuses)
+		case "OPEN":
+			_, _ = w.Write([]byte("contents"))
+		}
+	}))
+}
+
+func TestHDFSFileTransfer_DownloadDirectorySymlinks(t *testing.T) {
+	server := fakeWebHDFSListing(`
+		{"pathSuffix":"file.txt","type":"FILE","length":8},
+		{"pathSuffix":"relative","type":"SYMLINK","symlink":"file.txt"},
+		{"pathSuffix":"absolute","type":"SYMLINK","symlink":"/data/file.txt"},
+		{"pathSuffix":"outside","type":"SYMLINK","symlink":"/etc/passwd"}`)
+	defer server.Close()
+
+	testCases := []struct {
+		policy         filetransfer.SymlinkPolicy
+		expectedLinks  []string
+		expectedFiles  []string
+		expectedAbsent []string
+	}{
+		{
+			policy:         filetransfer.SymlinkSkip,
+			expectedAbsent: []string{"relative", "absolute", "outside"},
+		},
+		{
+			policy:         filetransfer.SymlinkPreserve,
+			expectedLinks:  []string{"relative", "absolute"},
+			expectedAbsent: []string{"outside"},
+		},
+		{
+			policy:        filetransfer.SymlinkFollow,
+			expectedFiles: []string{"relative", "absolute", "outside"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.policy), func(t *testing.T) {
+			ft := filetransfer.NewHDFSFileTransfer(
+				impatientClient(),
+				observability.NewNoOpLogger(),
+				filetransfer.WithSymlinkPolicy(tc.policy),
+			)
+			dir := t.TempDir()
+
+			err := ft.Download(&filetransfer.Task{
+				Type: filetransfer.DownloadTask,
+				Path: dir,
+				Url:  webHDFSURL(server, "/data"),
+			})
+			require.NoError(t, err)
+
+			for _, name := range tc.expectedLinks {
+				info, err := os.Lstat(filepath.Join(dir, name))
+				require.NoError(t, err)
+				assert.Equal(t, os.ModeSymlink, info.Mode().Type())
+				content, err := os.ReadFile(filepath.Join(dir, name))
+				require.NoError(t, err)
+				assert.Equal(t, "contents", string(content))
+			}
+			for _, name := range tc.expectedFiles {
+				info, err := os.Lstat(filepath.Join(dir, name))
+				require.NoError(t, err)
+				assert.True(t, info.Mode().IsRegular())
+			}
+			for _, name := range tc.expectedAbsent {
+				_, err := os.Lstat(filepath.Join(dir, name))
+				assert.ErrorIs(t, err, os.ErrNotExist)
+			}
+		})
+	}
+}
+
+func TestHDFSFileTransfer_DownloadDirectoryRejectsTraversal(t *testing.T) {
+	server := fakeWebHDFSListing(`{"pathSuffix":"../escaped.txt","type":"FILE","length":8}`)
+	defer server.Close()
+	ft := filetransfer.NewHDFSFileTransfer(impatientClient(), observability.NewNoOpLogger())
+	dir := filepath.Join(t.TempDir(), "download")
+
+	err := ft.Download(&filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: dir,
+		Url:  webHDFSURL(server, "/data"),
+	})
+
+	assert.ErrorContains(t, err, "unsafe object name")
+	assert.NoFileExists(t, filepath.Join(filepath.Dir(dir), "escaped.txt"))
+}
+
+func TestFileTransfers_GetFileTransferForTask(t *testing.T) {
+	defaultFT := &filetransfer.DefaultFileTransfer{}
+	hdfsFT := &filetransfer.HDFSFileTransfer{}
+	fts := &filetransfer.FileTransfers{Default: defaultFT, HDFS: hdfsFT}
+
+	assert.Same(t, defaultFT, fts.GetFileTransferForTask(&filetransfer.Task{Url: "https://example.com/file"}))
+	assert.Same(t, hdfsFT, fts.GetFileTransferForTask(&filetransfer.Task{Url: "hdfs://namenode/data"}))
+	assert.Same(t, hdfsFT, fts.GetFileTransferForTask(&filetransfer.Task{Url: "webhdfs://namenode:9870/data"}))
+}
+
+func TestHDFSFileTransfer_DownloadSymlinkResolvesLocalLinks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Query().Get("op") == "GETFILESTATUS":
+			fmt.Fprint(w, `{"FileStatus":{"type":"DIRECTORY","length":0}}`)
+		case strings.HasSuffix(r.URL.Path, "/data/a"):
+			fmt.Fprint(w, `{"FileStatuses":{"FileStatus":[
+				{"pathSuffix":"up","type":"SYMLINK","symlink":".."}]}}`)
+		default:
+			fmt.Fprint(w, `{"FileStatuses":{"FileStatus":[
+				{"pathSuffix":"a","type":"DIRECTORY","length":0}]}}`)
+		}
+	}))
+	defer server.Close()
+	ft := filetransfer.NewHDFSFileTransfer(
+		impatientClient(),
+		observability.NewNoOpLogger(),
+		filetransfer.WithSymlinkPolicy(filetransfer.SymlinkPreserve),
+	)
+	dir := filepath.Join(t.TempDir(), "download")
+	require.NoError(t, os.MkdirAll(dir, os.ModePerm))
+	// "a" is already a link to the download directory, so "a/.." is
+	// lexically inside it but actually its parent.
+	require.NoError(t, os.Symlink(".", filepath.Join(dir, "a")))
+
+	err := ft.Download(&filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: dir,
+		Url:  webHDFSURL(server, "/data"),
+	})
+
+	require.NoError(t, err)
+	_, err = os.Lstat(filepath.Join(dir, "up"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,222 @@
+package filetransfer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// HTTPReferenceFileTransfer downloads files referenced by http(s):// URLs.
+//
+// Unlike W&B storage URLs, references usually point at services that need
+// credentials, so configured headers and a bearer token are sent with
+// every request. Redirects are followed, but the credentials are only
+// sent to the host of the reference.
+type HTTPReferenceFileTransfer struct {
+	// client is the HTTP client for the file transfer
+	client *retryablehttp.Client
+
+	// logger is the logger for the file transfer
+	logger *observability.CoreLogger
+
+	// headers are additional headers to send with each request
+	headers map[string]string
+
+	// bearerToken is sent in the Authorization header, if set
+	bearerToken string
+}
+
+// NewHTTPReferenceFileTransfer creates a new HTTPReferenceFileTransfer.
+func NewHTTPReferenceFileTransfer(
+	client *retryablehttp.Client,
+	logger *observability.CoreLogger,
+	headers map[string]string,
+	bearerToken string,
+) *HTTPReferenceFileTransfer {
+	ft := &HTTPReferenceFileTransfer{
+		logger:      logger,
+		headers:     headers,
+		bearerToken: bearerToken,
+	}
+
+	// Copy the client so that the redirect policy doesn't apply to the
+	// other file transfers that share it.
+	httpClient := *client.HTTPClient
+	httpClient.CheckRedirect = ft.checkRedirect
+	ft.client = &retryablehttp.Client{
+		HTTPClient:      &httpClient,
+		Logger:          client.Logger,
+		RetryWaitMin:    client.RetryWaitMin,
+		RetryWaitMax:    client.RetryWaitMax,
+		RetryMax:        client.RetryMax,
+		RequestLogHook:  client.RequestLogHook,
+		ResponseLogHook: client.ResponseLogHook,
+		CheckRetry:      client.CheckRetry,
+		Backoff:         client.Backoff,
+		ErrorHandler:    client.ErrorHandler,
+		PrepareRetry:    client.PrepareRetry,
+	}
+
+	return ft
+}
+
+// maxRedirects is the number of redirects followed for a request.
+const maxRedirects = 10
+
+// checkRedirect removes the configured credentials from requests
+// redirected to a different host.
+//
+// The HTTP client only strips the Authorization header itself, and not
+// across ports of the same host.
+func (ft *HTTPReferenceFileTransfer) checkRedirect(
+	req *http.Request,
+	via []*http.Request,
+) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf(
+			"file transfer: http reference: stopped after %d redirects",
+			maxRedirects,
+		)
+	}
+
+	if req.URL.Host != via[0].URL.Host {
+		for key := range ft.headers {
+			req.Header.Del(key)
+		}
+		req.Header.Del("Authorization")
+	}
+	return nil
+}
+
+// Upload is not supported for HTTP references.
+func (ft *HTTPReferenceFileTransfer) Upload(task *Task) error {
+	return errors.New("file transfer: http reference: upload is not supported")
+}
+
+// Download downloads a referenced file.
+//
+// If the task has a digest, it must match the response's ETag, or its
+// Last-Modified header if the server doesn't send an ETag. Otherwise the
+// file has changed since the reference was recorded and the download
+// fails with ErrDigestMismatch.
+func (ft *HTTPReferenceFileTransfer) Download(task *Task) error {
+	ft.logger.Debug("http reference file transfer: downloading", "path", task.Path, "url", task.Url)
+
+	resp, err := ft.do(task, http.MethodGet)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	task.Response = resp
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf(
+			"file transfer: http reference: failed to download: %w",
+			newStatusError(resp),
+		)
+	}
+
+	if task.Digest != "" {
+		if actual := responseVersion(resp); actual != task.Digest {
+			return &DigestMismatchError{
+				Path:     task.Path,
+				Expected: task.Digest,
+				Actual:   actual,
+			}
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(task.Path), os.ModePerm); err != nil {
+		return err
+	}
+	file, err := os.Create(task.Path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			ft.logger.CaptureError(
+				fmt.Errorf(
+					"file transfer: http reference: error closing file %s: %v",
+					task.Path,
+					err,
+				))
+		}
+	}()
+
+	progress := NewProgressWriter(file, int(resp.ContentLength), task.ProgressCallback)
+	_, err = io.Copy(progress, resp.Body)
+	return err
+}
+
+// DownloadStream opens a referenced file for reading.
+//
+// The digest is checked against the response headers before any bytes
+// are read, as in Download.
+func (ft *HTTPReferenceFileTransfer) DownloadStream(task *Task) (io.ReadCloser, error) {
+	ft.logger.Debug("http reference file transfer: streaming", "url", task.Url)
+
+	resp, err := ft.do(task, http.MethodGet)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf(
+			"file transfer: http reference: failed to download: %w",
+			newStatusError(resp),
+		)
+	}
+	if task.Digest != "" {
+		if err := digestMismatch(task.Url, task.Digest, responseVersion(resp)); err != nil {
+			_ = resp.Body.Close()
+			return nil, err
+		}
+	}
+	task.Response = resp
+
+	return newDownloadStream(task, resp.Body, resp.ContentLength), nil
+}
+
+// Verify checks a referenced file using a HEAD request.
+func (ft *HTTPReferenceFileTransfer) Verify(task *Task) (*VerificationReport, error) {
+	resp, err := ft.do(task, http.MethodHead)
+	if err != nil {
+		return nil, err
+	}
+	return verifyResponse(task, resp)
+}
+
+// do makes a request to the task's URL with the configured credentials.
+func (ft *HTTPReferenceFileTransfer) do(task *Task, method string) (*http.Response, error) {
+	req, err := retryablehttp.NewRequest(method, task.Url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range ft.headers {
+		req.Header.Set(key, value)
+	}
+	if ft.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+ft.bearerToken)
+	}
+	if task.Context != nil {
+		req = req.WithContext(task.Context)
+	}
+	return ft.client.Do(req)
+}
+
+// responseVersion returns the response's ETag without quotes or a weak
+// validator prefix, or its Last-Modified header if it has no ETag.
+func responseVersion(resp *http.Response) string {
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		return strings.Trim(strings.TrimPrefix(etag, "W/"), `"`)
+	}
+	return resp.Header.Get("Last-Modified")
+}
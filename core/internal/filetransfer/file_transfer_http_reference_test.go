@@ -0,0 +1,196 @@
+package filetransfer_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+func TestHTTPReferenceFileTransfer_Download(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, "value", r.Header.Get("X-Custom"))
+		w.Header().Set("ETag", `"abc123"`)
+		_, _ = w.Write([]byte("reference contents"))
+	}))
+	defer server.Close()
+	ft := filetransfer.NewHTTPReferenceFileTransfer(
+		impatientClient(),
+		observability.NewNoOpLogger(),
+		map[string]string{"X-Custom": "value"},
+		"token",
+	)
+	task := &filetransfer.Task{
+		Type:      filetransfer.DownloadTask,
+		Path:      filepath.Join(t.TempDir(), "sub", "file.txt"),
+		Url:       server.URL,
+		Digest:    "abc123",
+		Reference: true,
+	}
+
+	err := ft.Download(task)
+
+	require.NoError(t, err)
+	content, err := os.ReadFile(task.Path)
+	require.NoError(t, err)
+	assert.Equal(t, "reference contents", string(content))
+}
+
+func TestHTTPReferenceFileTransfer_RedirectCredentials(t *testing.T) {
+	var targetHeaders, originHeaders http.Header
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		targetHeaders = r.Header.Clone()
+	}))
+	defer target.Close()
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/other-host":
+			http.Redirect(w, r, target.URL, http.StatusFound)
+		case "/same-host":
+			http.Redirect(w, r, "/file", http.StatusFound)
+		default:
+			originHeaders = r.Header.Clone()
+		}
+	}))
+	defer origin.Close()
+	ft := filetransfer.NewHTTPReferenceFileTransfer(
+		impatientClient(),
+		observability.NewNoOpLogger(),
+		map[string]string{"X-Custom": "value"},
+		"token",
+	)
+	download := func(path string) {
+		task := &filetransfer.Task{
+			Type:      filetransfer.DownloadTask,
+			Path:      filepath.Join(t.TempDir(), "file.txt"),
+			Url:       origin.URL + path,
+			Reference: true,
+		}
+		require.NoError(t, ft.Download(task))
+	}
+
+	download("/other-host")
+	download("/same-host")
+
+	assert.Empty(t, targetHeaders.Get("X-Custom"))
+	assert.Empty(t, targetHeaders.Get("Authorization"))
+	assert.Equal(t, "value", originHeaders.Get("X-Custom"))
+	assert.Equal(t, "Bearer token", originHeaders.Get("Authorization"))
+}
+
+func TestHTTPReferenceFileTransfer_FollowsRedirects(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("redirected"))
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusFound))
+	defer server.Close()
+	ft := filetransfer.NewHTTPReferenceFileTransfer(
+		impatientClient(), observability.NewNoOpLogger(), nil, "")
+	task := &filetransfer.Task{
+		Type:      filetransfer.DownloadTask,
+		Path:      filepath.Join(t.TempDir(), "file.txt"),
+		Url:       server.URL,
+		Reference: true,
+	}
+
+	err := ft.Download(task)
+
+	require.NoError(t, err)
+	content, err := os.ReadFile(task.Path)
+	require.NoError(t, err)
+	assert.Equal(t, "redirected", string(content))
+}
+
+func TestHTTPReferenceFileTransfer_DigestMismatch(t *testing.T) {
+	testCases := []struct {
+		name   string
+		header string
+		value  string
+	}{
+		{"ETag", "ETag", `W/"changed"`},
+		{"LastModified", "Last-Modified", "Wed, 21 Oct 2015 07:28:00 GMT"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set(tc.header, tc.value)
+				_, _ = w.Write([]byte("contents"))
+			}))
+			defer server.Close()
+			ft := filetransfer.NewHTTPReferenceFileTransfer(
+				impatientClient(), observability.NewNoOpLogger(), nil, "")
+			task := &filetransfer.Task{
+				Type:      filetransfer.DownloadTask,
+				Path:      filepath.Join(t.TempDir(), "file.txt"),
+				Url:       server.URL,
+				Digest:    "original",
+				Reference: true,
+			}
+
+			err := ft.Download(task)
+
+			assert.ErrorIs(t, err, filetransfer.ErrDigestMismatch)
+			assert.NoFileExists(t, task.Path)
+		})
+	}
+}
+
+func TestHTTPReferenceFileTransfer_Verify(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodHead, r.Method)
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("ETag", `"abc123"`)
+		_, _ = w.Write([]byte("contents"))
+	}))
+	defer server.Close()
+
+	testCases := []struct {
+		name     string
+		path     string
+		size     int64
+		digest   string
+		exists   bool
+		problems int
+	}{
+		{"matches", "/file", 8, "abc123", true, 0},
+		{"unknown size and digest", "/file", 0, "", true, 0},
+		{"size mismatch", "/file", 100, "abc123", true, 1},
+		{"digest mismatch", "/file", 8, "changed", true, 1},
+		{"missing", "/missing", 8, "abc123", false, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ft := filetransfer.NewHTTPReferenceFileTransfer(
+				impatientClient(), observability.NewNoOpLogger(), nil, "")
+			task := &filetransfer.Task{
+				Type:      filetransfer.VerifyTask,
+				Path:      filepath.Join(t.TempDir(), "file.txt"),
+				Url:       server.URL + tc.path,
+				Size:      tc.size,
+				Digest:    tc.digest,
+				Reference: true,
+			}
+
+			report, err := ft.Verify(task)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.exists, report.Exists)
+			assert.Len(t, report.Problems, tc.problems)
+			assert.Equal(t, tc.exists && tc.problems == 0, report.OK())
+			assert.NoFileExists(t, task.Path)
+		})
+	}
+}
@@ -0,0 +1,405 @@
+package filetransfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// LocalReferencePolicy is how file:// references are downloaded.
+type LocalReferencePolicy string
+
+const (
+	// LocalReferenceCopy copies referenced files to the download path.
+	LocalReferenceCopy LocalReferencePolicy = "copy"
+
+	// LocalReferenceLink hard links referenced files to the download path.
+	//
+	// Files on a different filesystem than the download path are copied.
+	LocalReferenceLink LocalReferencePolicy = "link"
+
+	// LocalReferenceVerify checks referenced files in place without
+	// writing anything to the download path.
+	LocalReferenceVerify LocalReferencePolicy = "verify"
+)
+
+// ParseLocalReferencePolicy returns the policy with the given name.
+//
+// An empty name selects LocalReferenceCopy.
+func ParseLocalReferencePolicy(name string) (LocalReferencePolicy, error) {
+	switch policy := LocalReferencePolicy(name); policy {
+	case "":
+		return LocalReferenceCopy, nil
+	case LocalReferenceCopy, LocalReferenceLink, LocalReferenceVerify:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown local reference policy %q", name)
+	}
+}
+
+// LocalFileTransfer downloads file:// references to files and directories
+// on a local or shared filesystem, such as NFS.
+//
+// Links inside referenced directories are followed. Special files are
+// skipped.
+type LocalFileTransfer struct {
+	// logger is the logger for the file transfer
+	logger *observability.CoreLogger
+
+	// policy is how referenced files are downloaded
+	policy LocalReferencePolicy
+
+	// filter selects files in directories for tasks without a filter
+	filter NameFilter
+}
+
+type LocalFileTransferOption func(ft *LocalFileTransfer)
+
+// WithLocalReferencePolicy sets how referenced files are downloaded.
+func WithLocalReferencePolicy(policy LocalReferencePolicy) LocalFileTransferOption {
+	return func(ft *LocalFileTransfer) {
+		ft.policy = policy
+	}
+}
+
+// WithLocalNameFilter sets the filter for files in directories that's
+// used by tasks without their own.
+func WithLocalNameFilter(filter NameFilter) LocalFileTransferOption {
+	return func(ft *LocalFileTransfer) {
+		ft.filter = filter
+	}
+}
+
+// NewLocalFileTransfer creates a new LocalFileTransfer.
+func NewLocalFileTransfer(
+	logger *observability.CoreLogger,
+	opts ...LocalFileTransferOption,
+) *LocalFileTransfer {
+	ft := &LocalFileTransfer{
+		logger: logger,
+		policy: LocalReferenceCopy,
+	}
+	for _, opt := range opts {
+		opt(ft)
+	}
+	return ft
+}
+
+// Upload is not supported for file:// references.
+func (ft *LocalFileTransfer) Upload(task *Task) error {
+	return errors.New("file transfer: local: upload is not supported")
+}
+
+// Download copies or links a referenced file or directory to the task's
+// path, or only verifies it, depending on the policy.
+//
+// A referenced file must match the task's digest, if set, or else the
+// downloaded file is removed. In verify mode, its size is checked too.
+func (ft *LocalFileTransfer) Download(task *Task) error {
+	ft.logger.Debug("local file transfer: downloading", "path", task.Path, "url", task.Url)
+
+	if ft.policy == LocalReferenceVerify {
+		report, err := ft.Verify(task)
+		if err != nil {
+			return err
+		}
+		if !report.OK() {
+			return fmt.Errorf(
+				"file transfer: local: %s: %s",
+				task.Url,
+				strings.Join(report.Problems, "; "),
+			)
+		}
+		return nil
+	}
+
+	source, err := localReferencePath(task.Url)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(source)
+	if err != nil {
+		return fmt.Errorf("file transfer: local: %v", err)
+	}
+
+	if info.IsDir() {
+		return ft.downloadDir(task, source)
+	}
+
+	if err := ft.materialize(task, source, task.Path); err != nil {
+		return err
+	}
+	if err := checkLocalDigest(task, task.Path); err != nil {
+		_ = os.Remove(task.Path)
+		return err
+	}
+	return nil
+}
+
+// DownloadStream opens a referenced file for reading.
+//
+// Directories can't be streamed.
+func (ft *LocalFileTransfer) DownloadStream(task *Task) (io.ReadCloser, error) {
+	source, err := localReferencePath(task.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(source)
+	if err != nil {
+		return nil, fmt.Errorf("file transfer: local: %v", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("file transfer: local: %v", err)
+	}
+	if info.IsDir() {
+		_ = file.Close()
+		return nil, fmt.Errorf("file transfer: local: %s is a directory", task.Url)
+	}
+
+	var body io.ReadCloser = file
+	if task.Context != nil {
+		body = struct {
+			io.Reader
+			io.Closer
+		}{&contextReader{ctx: task.Context, r: file}, file}
+	}
+	return newDownloadStream(task, body, info.Size()).withMD5(task), nil
+}
+
+// Verify checks that a referenced file or directory exists and matches
+// the task's size and digest.
+//
+// For directories, the size is the total size of the files inside that
+// match the name filter, and the digest isn't checked.
+func (ft *LocalFileTransfer) Verify(task *Task) (*VerificationReport, error) {
+	source, err := localReferencePath(task.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(source)
+	if errors.Is(err, fs.ErrNotExist) {
+		report := &VerificationReport{Size: -1}
+		report.check(task)
+		return report, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("file transfer: local: %v", err)
+	}
+
+	report := &VerificationReport{Exists: true}
+	if info.IsDir() {
+		err = ft.walkDir(task, source, func(_ string, _ string, info fs.FileInfo) error {
+			report.Size += info.Size()
+			report.Files++
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		report.Size = info.Size()
+		report.Files = 1
+		if task.Digest != "" {
+			report.Version, err = utils.ComputeFileB64MD5(source)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"file transfer: local: failed to compute digest: %v", err)
+			}
+		}
+	}
+
+	report.check(task)
+	return report, nil
+}
+
+// downloadDir copies or links every file under a directory.
+func (ft *LocalFileTransfer) downloadDir(task *Task, sourceDir string) error {
+	return ft.walkDir(task, sourceDir, func(source string, name string, _ fs.FileInfo) error {
+		localPath, err := LocalPathUnder(task.Path, name)
+		if err != nil {
+			return err
+		}
+		return ft.materialize(task, source, localPath)
+	})
+}
+
+// walkDir calls fn for every regular file under a directory that matches
+// the name filter, following links.
+//
+// The name passed to fn is the file's slash-separated path relative to
+// the directory.
+func (ft *LocalFileTransfer) walkDir(
+	task *Task,
+	dir string,
+	fn func(source string, name string, info fs.FileInfo) error,
+) error {
+	filter := task.Filter
+	if filter.IsEmpty() {
+		filter = ft.filter
+	}
+
+	return filepath.WalkDir(dir, func(source string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if task.Context != nil && task.Context.Err() != nil {
+			return task.Context.Err()
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		info, err := os.Stat(source)
+		if err != nil || !info.Mode().IsRegular() {
+			ft.logger.Warn(
+				"local file transfer: skipping special file",
+				"path", source,
+				"error", err,
+			)
+			return nil
+		}
+
+		relPath, err := filepath.Rel(dir, source)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(relPath)
+		if !filter.Match(name) {
+			return nil
+		}
+
+		return fn(source, name, info)
+	})
+}
+
+// materialize copies or links a file according to the policy.
+func (ft *LocalFileTransfer) materialize(task *Task, source string, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
+		return err
+	}
+
+	if ft.policy == LocalReferenceLink {
+		// Hard links to symlinks would break if the symlink is relative.
+		target, err := filepath.EvalSymlinks(source)
+		if err == nil {
+			_ = os.Remove(dst)
+			err = os.Link(target, dst)
+		}
+		if err == nil {
+			return nil
+		}
+		ft.logger.Debug(
+			"local file transfer: copying file that can't be linked",
+			"path", source,
+			"error", err,
+		)
+	}
+
+	return ft.copyFile(task, source, dst)
+}
+
+// copyFile copies a file, reporting progress.
+func (ft *LocalFileTransfer) copyFile(task *Task, source string, dst string) error {
+	src, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = src.Close() }()
+
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			ft.logger.CaptureError(
+				fmt.Errorf(
+					"file transfer: local: error closing file %s: %v",
+					dst,
+					err,
+				))
+		}
+	}()
+
+	var reader io.Reader = src
+	if task.Context != nil {
+		reader = &contextReader{ctx: task.Context, r: src}
+	}
+
+	progress := NewProgressWriter(file, int(info.Size()), task.ProgressCallback)
+	_, err = io.Copy(progress, reader)
+	return err
+}
+
+// contextReader stops reading once its context is done.
+//
+// Reads from local files can't otherwise be canceled, which matters for
+// large files on slow network filesystems.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
+
+// checkLocalDigest checks a downloaded file against the task's digest,
+// which for file:// references is the file's base64-encoded MD5.
+func checkLocalDigest(task *Task, localPath string) error {
+	if task.Digest == "" {
+		return nil
+	}
+
+	actual, err := utils.ComputeFileB64MD5(localPath)
+	if err != nil {
+		return fmt.Errorf("file transfer: local: failed to compute digest: %v", err)
+	}
+	if actual != task.Digest {
+		return &DigestMismatchError{
+			Path:     localPath,
+			Expected: task.Digest,
+			Actual:   actual,
+		}
+	}
+	return nil
+}
+
+// localReferencePath returns the path named by a file:// URL.
+func localReferencePath(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("file transfer: local: invalid URL: %v", err)
+	}
+	if u.Scheme != "file" {
+		return "", fmt.Errorf("file transfer: local: unsupported scheme %q", u.Scheme)
+	}
+	if u.Host != "" && u.Host != "localhost" {
+		return "", fmt.Errorf("file transfer: local: unsupported host %q", u.Host)
+	}
+	if u.Path == "" {
+		return "", fmt.Errorf("file transfer: local: invalid URL %q", rawURL)
+	}
+	return filepath.FromSlash(u.Path), nil
+}
@@ -0,0 +1,181 @@
+package filetransfer_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+func writeLocalFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+}
+
+func fileURL(path string) string {
+	return "file://" + filepath.ToSlash(path)
+}
+
+func TestLocalFileTransfer_DownloadFile(t *testing.T) {
+	source := t.TempDir()
+	writeLocalFiles(t, source, map[string]string{"data.csv": "a,b,c"})
+
+	testCases := []struct {
+		policy   filetransfer.LocalReferencePolicy
+		linked   bool
+		contents bool
+	}{
+		{filetransfer.LocalReferenceCopy, false, true},
+		{filetransfer.LocalReferenceLink, true, true},
+		{filetransfer.LocalReferenceVerify, false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.policy), func(t *testing.T) {
+			ft := filetransfer.NewLocalFileTransfer(
+				observability.NewNoOpLogger(),
+				filetransfer.WithLocalReferencePolicy(tc.policy),
+			)
+			task := &filetransfer.Task{
+				Type:      filetransfer.DownloadTask,
+				Path:      filepath.Join(t.TempDir(), "sub", "data.csv"),
+				Url:       fileURL(filepath.Join(source, "data.csv")),
+				Size:      5,
+				Digest:    utils.ComputeB64MD5([]byte("a,b,c")),
+				Reference: true,
+			}
+
+			err := ft.Download(task)
+
+			require.NoError(t, err)
+			if !tc.contents {
+				assert.NoFileExists(t, task.Path)
+				return
+			}
+			content, err := os.ReadFile(task.Path)
+			require.NoError(t, err)
+			assert.Equal(t, "a,b,c", string(content))
+
+			sourceInfo, err := os.Stat(filepath.Join(source, "data.csv"))
+			require.NoError(t, err)
+			info, err := os.Stat(task.Path)
+			require.NoError(t, err)
+			assert.Equal(t, tc.linked, os.SameFile(sourceInfo, info))
+		})
+	}
+}
+
+func TestLocalFileTransfer_DownloadDigestMismatch(t *testing.T) {
+	source := t.TempDir()
+	writeLocalFiles(t, source, map[string]string{"data.csv": "changed"})
+
+	for _, policy := range []filetransfer.LocalReferencePolicy{
+		filetransfer.LocalReferenceCopy,
+		filetransfer.LocalReferenceVerify,
+	} {
+		t.Run(string(policy), func(t *testing.T) {
+			ft := filetransfer.NewLocalFileTransfer(
+				observability.NewNoOpLogger(),
+				filetransfer.WithLocalReferencePolicy(policy),
+			)
+			task := &filetransfer.Task{
+				Type:      filetransfer.DownloadTask,
+				Path:      filepath.Join(t.TempDir(), "data.csv"),
+				Url:       fileURL(filepath.Join(source, "data.csv")),
+				Digest:    utils.ComputeB64MD5([]byte("a,b,c")),
+				Reference: true,
+			}
+
+			err := ft.Download(task)
+
+			assert.Error(t, err)
+			assert.NoFileExists(t, task.Path)
+		})
+	}
+}
+
+func TestLocalFileTransfer_DownloadDirectory(t *testing.T) {
+	source := t.TempDir()
+	writeLocalFiles(t, source, map[string]string{
+		"a.bin":     "a",
+		"sub/b.bin": "bb",
+		"sub/c.tmp": "ccc",
+	})
+	require.NoError(t, os.Symlink(
+		filepath.Join(source, "a.bin"),
+		filepath.Join(source, "link.bin"),
+	))
+	ft := filetransfer.NewLocalFileTransfer(
+		observability.NewNoOpLogger(),
+		filetransfer.WithLocalNameFilter(filetransfer.NameFilter{
+			Exclude: []string{"*.tmp"},
+		}),
+	)
+	dir := t.TempDir()
+	task := &filetransfer.Task{
+		Type:      filetransfer.DownloadTask,
+		Path:      dir,
+		Url:       fileURL(source),
+		Reference: true,
+	}
+
+	err := ft.Download(task)
+
+	require.NoError(t, err)
+	for name, expected := range map[string]string{
+		"a.bin":     "a",
+		"sub/b.bin": "bb",
+		"link.bin":  "a",
+	} {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(content))
+	}
+	assert.NoFileExists(t, filepath.Join(dir, "sub", "c.tmp"))
+}
+
+func TestLocalFileTransfer_Verify(t *testing.T) {
+	source := t.TempDir()
+	writeLocalFiles(t, source, map[string]string{
+		"a.bin":     "a",
+		"sub/b.bin": "bb",
+	})
+	ft := filetransfer.NewLocalFileTransfer(observability.NewNoOpLogger())
+
+	report, err := ft.Verify(&filetransfer.Task{
+		Type: filetransfer.VerifyTask,
+		Url:  fileURL(source),
+		Size: 3,
+	})
+	require.NoError(t, err)
+	assert.True(t, report.OK())
+	assert.Equal(t, 2, report.Files)
+
+	report, err = ft.Verify(&filetransfer.Task{
+		Type: filetransfer.VerifyTask,
+		Url:  fileURL(filepath.Join(source, "missing")),
+	})
+	require.NoError(t, err)
+	assert.False(t, report.Exists)
+}
+
+func TestParseLocalReferencePolicy(t *testing.T) {
+	policy, err := filetransfer.ParseLocalReferencePolicy("")
+	require.NoError(t, err)
+	assert.Equal(t, filetransfer.LocalReferenceCopy, policy)
+
+	policy, err = filetransfer.ParseLocalReferencePolicy("link")
+	require.NoError(t, err)
+	assert.Equal(t, filetransfer.LocalReferenceLink, policy)
+
+	_, err = filetransfer.ParseLocalReferencePolicy("move")
+	assert.Error(t, err)
+}
@@ -1,8 +1,13 @@
 package filetransfer
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"sync"
+	"time"
 
 	"github.com/wandb/wandb/core/pkg/observability"
 )
@@ -19,6 +24,13 @@ type FileTransferManager interface {
 	// AddTask schedules a file upload or download operation.
 	AddTask(task *Task)
 
+	// DownloadStream opens a remote file for reading without writing it
+	// to disk.
+	//
+	// Unlike tasks, streams aren't scheduled: the caller reads at its own
+	// pace and must close the reader.
+	DownloadStream(task *Task) (io.ReadCloser, error)
+
 	// Close waits for all tasks to complete.
 	Close()
 }
@@ -31,8 +43,35 @@ type fileTransferManager struct {
 	// fileTransferStats keeps track of upload/download statistics
 	fileTransferStats FileTransferStats
 
-	// semaphore is the semaphore for limiting concurrency
-	semaphore chan struct{}
+	// scheduler limits the number of concurrent transfers
+	scheduler *transferScheduler
+
+	// concurrencyLimit is the maximum number of concurrent transfers
+	concurrencyLimit int
+
+	// perHostConcurrencyLimit is the maximum number of concurrent
+	// transfers to a single host, or 0 for no limit
+	perHostConcurrencyLimit int
+
+	// throttle pauses new transfers while backends are throttling, if set
+	throttle *Throttle
+
+	// metrics counts transferred bytes and tasks
+	metrics *TransferMetrics
+
+	// uploadTimeout and downloadTimeout limit how long tasks without
+	// their own timeout may take, if positive
+	uploadTimeout   time.Duration
+	downloadTimeout time.Duration
+
+	// ledger records uploaded files to skip unchanged ones, if set
+	ledger *uploadLedger
+
+	// snapshotUploads is whether files are copied before they're uploaded
+	snapshotUploads bool
+
+	// snapshotDir is where copies of uploaded files are made
+	snapshotDir string
 
 	// logger is the logger for the file transfer
 	logger *observability.CoreLogger
@@ -61,17 +100,102 @@ func WithFileTransferStats(fileTransferStats FileTransferStats) FileTransferMana
 	}
 }
 
+// WithConcurrencyLimit sets the maximum number of transfers in progress.
+//
+// Non-positive values use DefaultConcurrencyLimit.
+func WithConcurrencyLimit(limit int) FileTransferManagerOption {
+	return func(fm *fileTransferManager) {
+		fm.concurrencyLimit = limit
+	}
+}
+
+// WithPerHostConcurrencyLimit sets the maximum number of transfers in
+// progress to a single host.
+//
+// Non-positive values disable the per-host limit.
+func WithPerHostConcurrencyLimit(limit int) FileTransferManagerOption {
+	return func(fm *fileTransferManager) {
+		fm.perHostConcurrencyLimit = limit
+	}
+}
+
+// WithThrottle pauses new transfers while the throttle is paused.
+//
+// The throttle should observe the responses of the file transfers' HTTP
+// client through Throttle.CheckRetry.
+func WithThrottle(throttle *Throttle) FileTransferManagerOption {
+	return func(fm *fileTransferManager) {
+		fm.throttle = throttle
+	}
+}
+
+// WithTransferMetrics sets the metrics that the manager updates.
+func WithTransferMetrics(metrics *TransferMetrics) FileTransferManagerOption {
+	return func(fm *fileTransferManager) {
+		fm.metrics = metrics
+	}
+}
+
+// WithTaskTimeouts sets how long uploads and downloads may take,
+// including retries, unless a task sets its own timeout.
+//
+// Verify tasks use the download timeout. Non-positive values disable
+// the timeout.
+func WithTaskTimeouts(upload, download time.Duration) FileTransferManagerOption {
+	return func(fm *fileTransferManager) {
+		fm.uploadTimeout = upload
+		fm.downloadTimeout = download
+	}
+}
+
+// WithUploadDeduplication sets whether uploads of files whose contents
+// were already uploaded to the same place are skipped.
+//
+// Only tasks with a Content-MD5 header are deduplicated, since the backend
+// checks that header against what it receives. It's disabled by default.
+func WithUploadDeduplication(enabled bool) FileTransferManagerOption {
+	return func(fm *fileTransferManager) {
+		if enabled {
+			fm.ledger = newUploadLedger()
+		} else {
+			fm.ledger = nil
+		}
+	}
+}
+
+// WithUploadSnapshots copies files into a directory before they're
+// uploaded, so that files that are still being written, like event logs,
+// aren't uploaded in a torn state.
+//
+// The copies are reflinks if the filesystem supports them, which are
+// instant. The directory should be on the same filesystem as the files
+// for that. An empty directory selects the default temporary directory.
+//
+// Only whole files are copied, not parts of multipart uploads.
+func WithUploadSnapshots(dir string) FileTransferManagerOption {
+	return func(fm *fileTransferManager) {
+		fm.snapshotUploads = true
+		fm.snapshotDir = dir
+	}
+}
+
 func NewFileTransferManager(opts ...FileTransferManagerOption) FileTransferManager {
 
 	fm := fileTransferManager{
-		wg:        &sync.WaitGroup{},
-		semaphore: make(chan struct{}, DefaultConcurrencyLimit),
+		wg:               &sync.WaitGroup{},
+		concurrencyLimit: DefaultConcurrencyLimit,
+		metrics:          NewTransferMetrics(),
 	}
 
 	for _, opt := range opts {
 		opt(&fm)
 	}
 
+	fm.scheduler = newTransferScheduler(
+		fm.concurrencyLimit,
+		fm.perHostConcurrencyLimit,
+	)
+
 	return &fm
 }
 
@@ -79,15 +203,26 @@ func (fm *fileTransferManager) AddTask(task *Task) {
 	fm.logger.Debug("fileTransfer: adding upload task", "path", task.Path, "url", task.Url)
 
 	fm.wg.Add(1)
+	fm.metrics.trackProgress(task)
+	fm.trackDownloadStats(task)
+	fm.metrics.queued.Add(1)
+
 	go func() {
 		defer fm.wg.Done()
 
-		// Guard by a semaphore to limit number of concurrent uploads.
-		fm.semaphore <- struct{}{}
+		// Wait for the host before taking a global slot, so that tasks
+		// queued for a busy host don't hold up tasks for other hosts.
+		releaseHost := fm.scheduler.acquireHost(task)
+		fm.scheduler.acquireGlobal()
+		fm.metrics.queued.Add(-1)
+		fm.metrics.inFlight.Add(1)
 		task.Err = fm.transfer(task)
-		<-fm.semaphore
+		fm.metrics.inFlight.Add(-1)
+		releaseHost()
+		fm.scheduler.releaseGlobal()
 
 		if task.Err != nil {
+			fm.metrics.failed.Add(1)
 			fm.logger.CaptureError(
 				fmt.Errorf(
 					"filetransfer: uploader: error uploading: %v",
@@ -103,6 +238,28 @@ func (fm *fileTransferManager) AddTask(task *Task) {
 	}()
 }
 
+// trackDownloadStats reports the progress of a download task to the
+// file transfer stats.
+func (fm *fileTransferManager) trackDownloadStats(task *Task) {
+	if task.Type != DownloadTask {
+		return
+	}
+
+	callback := task.ProgressCallback
+	task.ProgressCallback = func(processed int, total int) {
+		fm.fileTransferStats.UpdateDownloadStats(FileDownloadInfo{
+			Path:            task.Path,
+			DownloadedBytes: int64(processed),
+			// The total is negative if the server didn't send a length.
+			TotalBytes: int64(max(processed, total)),
+		})
+
+		if callback != nil {
+			callback(processed, total)
+		}
+	}
+}
+
 // completeTask runs the completion callback and updates statistics.
 func (fm *fileTransferManager) completeTask(task *Task) {
 	task.CompletionCallback(task)
@@ -117,27 +274,144 @@ func (fm *fileTransferManager) completeTask(task *Task) {
 	}
 }
 
+func (fm *fileTransferManager) DownloadStream(task *Task) (io.ReadCloser, error) {
+	fm.logger.Debug("fileTransfer: streaming download", "url", task.Url)
+
+	if fm.throttle != nil {
+		ctx := task.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		if err := fm.throttle.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	fm.metrics.trackProgress(task)
+	return fm.fileTransfers.DownloadStream(task)
+}
+
 func (fm *fileTransferManager) Close() {
 	fm.logger.Debug("fileTransfer: Close")
 	fm.wg.Wait()
 }
 
-// Uploads or downloads a file.
+// transfer runs a task within its timeout.
 func (fm *fileTransferManager) transfer(task *Task) error {
+	timeout := fm.timeout(task)
+	if timeout <= 0 {
+		return fm.transferNow(task)
+	}
+
+	parent := task.Context
+	ctx := parent
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	task.Context = ctx
+	err := fm.transferNow(task)
+	task.Context = parent
+
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("fileTransfer: timed out after %v: %w", timeout, err)
+	}
+	return err
+}
+
+// Uploads or downloads a file.
+func (fm *fileTransferManager) transferNow(task *Task) error {
 	fileTransfer := fm.fileTransfers.GetFileTransferForTask(task)
 	if fileTransfer == nil {
 		return fmt.Errorf("fileTransfer: no transfer for task URL %v", task.Url)
 	}
 
+	if fm.throttle != nil {
+		ctx := task.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		if err := fm.throttle.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
 	var err error
 	switch task.Type {
 	case UploadTask:
-		err = fileTransfer.Upload(task)
+		err = fm.upload(fileTransfer, task)
 	case DownloadTask:
 		err = fileTransfer.Download(task)
+		if err == nil {
+			err = verifyDownloadDigest(task)
+		}
+	case VerifyTask:
+		verifier, ok := fileTransfer.(Verifier)
+		if !ok {
+			return fmt.Errorf("fileTransfer: cannot verify task URL %v", task.Url)
+		}
+		task.Verification, err = verifier.Verify(task)
 	default:
 		fm.logger.CaptureFatalAndPanic(
 			fmt.Errorf("fileTransfer: unknown task type: %v", task.Type))
 	}
 	return err
 }
+
+// upload uploads a file, or a snapshot of it if enabled, unless the same
+// contents were already uploaded to the same place.
+func (fm *fileTransferManager) upload(fileTransfer FileTransfer, task *Task) error {
+	if fm.snapshotUploads && task.Offset == 0 && task.Size == 0 {
+		snapshotPath, err := snapshotFile(task.Path, fm.snapshotDir)
+		if err != nil {
+			return err
+		}
+		task.snapshotPath = snapshotPath
+		defer func() {
+			_ = os.Remove(snapshotPath)
+			task.snapshotPath = ""
+		}()
+	}
+
+	if fm.ledger == nil {
+		return fileTransfer.Upload(task)
+	}
+
+	destination := uploadDestination(task)
+	if destination == "" {
+		return fileTransfer.Upload(task)
+	}
+
+	digest := uploadDigest(task)
+	if digest == "" {
+		return fileTransfer.Upload(task)
+	}
+
+	if fm.ledger.has(destination, digest) {
+		fm.logger.Debug("fileTransfer: skipping unchanged upload", "path", task.Path)
+		if info, err := os.Stat(task.uploadPath()); err == nil {
+			fm.fileTransferStats.AddDedupedBytes(info.Size())
+		}
+		return nil
+	}
+
+	if err := fileTransfer.Upload(task); err != nil {
+		return err
+	}
+	fm.ledger.record(destination, digest)
+	return nil
+}
+
+// timeout returns how long a task may take, or 0 if it has no limit.
+func (fm *fileTransferManager) timeout(task *Task) time.Duration {
+	switch {
+	case task.Timeout > 0:
+		return task.Timeout
+	case task.Type == UploadTask:
+		return fm.uploadTimeout
+	default:
+		return fm.downloadTimeout
+	}
+}
@@ -0,0 +1,427 @@
+package filetransfer_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+func newTestFileTransferManager(
+	opts ...filetransfer.FileTransferManagerOption,
+) filetransfer.FileTransferManager {
+	logger := observability.NewNoOpLogger()
+	stats := filetransfer.NewFileTransferStats()
+	opts = append(opts,
+		filetransfer.WithLogger(logger),
+		filetransfer.WithFileTransferStats(stats),
+		filetransfer.WithFileTransfers(&filetransfer.FileTransfers{
+			Default: filetransfer.NewDefaultFileTransfer(
+				retryablehttp.NewClient(),
+				logger,
+				stats,
+			),
+		}),
+	)
+	return filetransfer.NewFileTransferManager(opts...)
+}
+
+func TestFileTransferManager_DownloadVerifiesDigest(t *testing.T) {
+	content := []byte("test content for download")
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(content)
+		}))
+	defer server.Close()
+
+	testCases := []struct {
+		name    string
+		digest  string
+		wantErr bool
+	}{
+		{"no digest", "", false},
+		{"matching digest", utils.ComputeB64MD5(content), false},
+		{"mismatched digest", utils.ComputeB64MD5([]byte("other")), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fm := newTestFileTransferManager()
+			task := &filetransfer.Task{
+				Type:   filetransfer.DownloadTask,
+				Path:   filepath.Join(t.TempDir(), "file.txt"),
+				Url:    server.URL,
+				Digest: tc.digest,
+			}
+			task.SetCompletionCallback(func(*filetransfer.Task) {})
+
+			fm.AddTask(task)
+			fm.Close()
+
+			if tc.wantErr {
+				assert.ErrorIs(t, task.Err, filetransfer.ErrDigestMismatch)
+			} else {
+				assert.NoError(t, task.Err)
+			}
+		})
+	}
+}
+
+func TestFileTransferManager_VerifyUnsupported(t *testing.T) {
+	fm := newTestFileTransferManager()
+	task := &filetransfer.Task{
+		Type: filetransfer.VerifyTask,
+		Url:  "https://example.com/file",
+	}
+	task.SetCompletionCallback(func(*filetransfer.Task) {})
+
+	fm.AddTask(task)
+	fm.Close()
+
+	assert.ErrorContains(t, task.Err, "cannot verify")
+	assert.Nil(t, task.Verification)
+}
+
+func TestFileTransferManager_TransferMetrics(t *testing.T) {
+	content := []byte("test content for download")
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/missing" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(content)
+		}))
+	defer server.Close()
+	metrics := filetransfer.NewTransferMetrics()
+	fm := newTestFileTransferManager(filetransfer.WithTransferMetrics(metrics))
+
+	var progress int
+	download := &filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: filepath.Join(t.TempDir(), "file.txt"),
+		Url:  server.URL,
+	}
+	download.SetProgressCallback(func(processed, _ int) { progress = processed })
+	download.SetCompletionCallback(func(*filetransfer.Task) {})
+	missing := &filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: filepath.Join(t.TempDir(), "missing.txt"),
+		Url:  server.URL + "/missing",
+	}
+	missing.SetCompletionCallback(func(*filetransfer.Task) {})
+
+	fm.AddTask(download)
+	fm.AddTask(missing)
+	fm.Close()
+
+	assert.Equal(t, len(content), progress)
+	assert.Equal(t,
+		filetransfer.TransferMetricsSnapshot{
+			DownloadedBytes: int64(len(content)),
+			Failed:          1,
+		},
+		metrics.Snapshot(),
+	)
+}
+
+func TestFileTransferManager_DownloadStats(t *testing.T) {
+	content := []byte("test content for download")
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(content)
+		}))
+	defer server.Close()
+	logger := observability.NewNoOpLogger()
+	stats := filetransfer.NewFileTransferStats()
+	fm := filetransfer.NewFileTransferManager(
+		filetransfer.WithLogger(logger),
+		filetransfer.WithFileTransferStats(stats),
+		filetransfer.WithFileTransfers(&filetransfer.FileTransfers{
+			Default: filetransfer.NewDefaultFileTransfer(
+				retryablehttp.NewClient(), logger, stats),
+		}),
+	)
+
+	dir := t.TempDir()
+	for _, name := range []string{"a.txt", "b.txt"} {
+		task := &filetransfer.Task{
+			Type: filetransfer.DownloadTask,
+			Path: filepath.Join(dir, name),
+			Url:  server.URL,
+		}
+		task.SetCompletionCallback(func(task *filetransfer.Task) {
+			assert.NoError(t, task.Err)
+		})
+		fm.AddTask(task)
+	}
+	fm.Close()
+
+	filesStats := stats.GetFilesStats()
+	assert.EqualValues(t, 2*len(content), filesStats.DownloadedBytes)
+	assert.EqualValues(t, 2*len(content), filesStats.TotalDownloadBytes)
+	assert.Zero(t, filesStats.UploadedBytes)
+}
+
+func TestFileTransferManager_Timeouts(t *testing.T) {
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-unblock:
+			case <-r.Context().Done():
+			}
+		}))
+	defer server.Close()
+	defer close(unblock)
+
+	testCases := []struct {
+		name        string
+		taskType    filetransfer.TaskType
+		taskTimeout time.Duration
+	}{
+		{"download", filetransfer.DownloadTask, 0},
+		{"upload", filetransfer.UploadTask, 0},
+		{"task timeout", filetransfer.DownloadTask, 10 * time.Millisecond},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "file.txt")
+			assert.NoError(t, os.WriteFile(path, []byte("content"), 0o644))
+			fm := newTestFileTransferManager(
+				filetransfer.WithTaskTimeouts(
+					10*time.Millisecond,
+					10*time.Millisecond,
+				),
+			)
+			if tc.taskTimeout > 0 {
+				fm = newTestFileTransferManager()
+			}
+			task := &filetransfer.Task{
+				Type:    tc.taskType,
+				Path:    path,
+				Url:     server.URL,
+				Timeout: tc.taskTimeout,
+			}
+			task.SetCompletionCallback(func(*filetransfer.Task) {})
+
+			fm.AddTask(task)
+			fm.Close()
+
+			assert.ErrorIs(t, task.Err, context.DeadlineExceeded)
+			assert.ErrorContains(t, task.Err, "timed out")
+			assert.Nil(t, task.Context)
+		})
+	}
+}
+
+func TestFileTransferManager_PerHostConcurrencyLimit(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			inFlight++
+			maxInFlight = max(maxInFlight, inFlight)
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}))
+	defer server.Close()
+
+	fm := newTestFileTransferManager(
+		filetransfer.WithConcurrencyLimit(8),
+		filetransfer.WithPerHostConcurrencyLimit(2),
+	)
+	dir := t.TempDir()
+	for i := 0; i < 10; i++ {
+		task := &filetransfer.Task{
+			Type: filetransfer.DownloadTask,
+			Path: filepath.Join(dir, fmt.Sprintf("file%d.txt", i)),
+			Url:  server.URL,
+		}
+		task.SetCompletionCallback(func(task *filetransfer.Task) {
+			assert.NoError(t, task.Err)
+		})
+		fm.AddTask(task)
+	}
+	fm.Close()
+
+	assert.Equal(t, 2, maxInFlight)
+}
+
+func TestFileTransferManager_AddTaskDoesNotBlock(t *testing.T) {
+	release := make(chan struct{})
+	busyServer := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+	defer busyServer.Close()
+	defer close(release)
+	otherServer := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {}))
+	defer otherServer.Close()
+
+	fm := newTestFileTransferManager(
+		filetransfer.WithConcurrencyLimit(2),
+		filetransfer.WithPerHostConcurrencyLimit(1),
+	)
+	dir := t.TempDir()
+	for i := 0; i < 3; i++ {
+		task := &filetransfer.Task{
+			Type: filetransfer.DownloadTask,
+			Path: filepath.Join(dir, fmt.Sprintf("busy%d.txt", i)),
+			Url:  busyServer.URL,
+		}
+		task.SetCompletionCallback(func(*filetransfer.Task) {})
+		fm.AddTask(task)
+	}
+
+	otherDone := make(chan struct{})
+	otherTask := &filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: filepath.Join(dir, "other.txt"),
+		Url:  otherServer.URL,
+	}
+	otherTask.SetCompletionCallback(func(task *filetransfer.Task) {
+		assert.NoError(t, task.Err)
+		close(otherDone)
+	})
+	fm.AddTask(otherTask)
+
+	select {
+	case <-otherDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("task for another host was blocked by a busy host")
+	}
+}
+
+func TestFileTransferManager_UploadDeduplication(t *testing.T) {
+	var mu sync.Mutex
+	var uploads []string
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			uploads = append(uploads, r.URL.Query().Get("signature"))
+		}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "file.txt")
+	upload := func(
+		fm filetransfer.FileTransferManager,
+		signature string,
+		content string,
+		withMD5 bool,
+	) *filetransfer.Task {
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+		task := &filetransfer.Task{
+			Type: filetransfer.UploadTask,
+			Path: path,
+			Url:  server.URL + "/file.txt?signature=" + signature,
+		}
+		if withMD5 {
+			task.Headers = []string{
+				"Content-MD5:" + utils.ComputeB64MD5([]byte(content)),
+			}
+		}
+		task.SetCompletionCallback(func(*filetransfer.Task) {})
+		fm.AddTask(task)
+		fm.Close()
+		return task
+	}
+
+	t.Run("skips unchanged files", func(t *testing.T) {
+		uploads = nil
+		logger := observability.NewNoOpLogger()
+		stats := filetransfer.NewFileTransferStats()
+		fm := filetransfer.NewFileTransferManager(
+			filetransfer.WithLogger(logger),
+			filetransfer.WithFileTransferStats(stats),
+			filetransfer.WithFileTransfers(&filetransfer.FileTransfers{
+				Default: filetransfer.NewDefaultFileTransfer(
+					retryablehttp.NewClient(), logger, stats),
+			}),
+			filetransfer.WithUploadDeduplication(true),
+		)
+
+		upload(fm, "1", "content", true)
+		second := upload(fm, "2", "content", true)
+		upload(fm, "3", "changed", true)
+
+		assert.NoError(t, second.Err)
+		assert.Equal(t, []string{"1", "3"}, uploads)
+		assert.EqualValues(t, len("content"), stats.GetFilesStats().DedupedBytes)
+	})
+
+	t.Run("requires Content-MD5", func(t *testing.T) {
+		uploads = nil
+		fm := newTestFileTransferManager(
+			filetransfer.WithUploadDeduplication(true))
+
+		upload(fm, "1", "content", false)
+		upload(fm, "2", "content", false)
+
+		assert.Equal(t, []string{"1", "2"}, uploads)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		uploads = nil
+		fm := newTestFileTransferManager()
+
+		upload(fm, "1", "content", true)
+		upload(fm, "2", "content", true)
+
+		assert.Equal(t, []string{"1", "2"}, uploads)
+	})
+}
+
+func TestFileTransferManager_UploadSnapshots(t *testing.T) {
+	snapshotDir := t.TempDir()
+	var snapshots []os.DirEntry
+	var uploaded []byte
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			snapshots, _ = os.ReadDir(snapshotDir)
+			uploaded, _ = io.ReadAll(r.Body)
+		}))
+	defer server.Close()
+	fm := newTestFileTransferManager(
+		filetransfer.WithUploadSnapshots(snapshotDir))
+	path := filepath.Join(t.TempDir(), "events.log")
+	assert.NoError(t, os.WriteFile(path, []byte("event 1\n"), 0o644))
+
+	task := &filetransfer.Task{
+		Type: filetransfer.UploadTask,
+		Path: path,
+		Url:  server.URL,
+	}
+	task.SetCompletionCallback(func(*filetransfer.Task) {})
+	fm.AddTask(task)
+	fm.Close()
+
+	assert.NoError(t, task.Err)
+	assert.Equal(t, path, task.Path)
+	assert.Equal(t, "event 1\n", string(uploaded))
+	assert.Len(t, snapshots, 1)
+	remaining, err := os.ReadDir(snapshotDir)
+	assert.NoError(t, err)
+	assert.Empty(t, remaining)
+}
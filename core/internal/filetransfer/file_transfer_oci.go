@@ -0,0 +1,377 @@
+package filetransfer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// OCIFileTransfer downloads blobs from OCI registries, such as Docker Hub
+// or GitHub Container Registry.
+//
+// It accepts oci://registry/repository@sha256:<digest> URLs. Blobs are
+// requested over HTTPS using the registry token authentication flow, and
+// their contents are checked against the digest in the URL.
+type OCIFileTransfer struct {
+	// client is the HTTP client for registry requests
+	client *retryablehttp.Client
+
+	// logger is the logger for the file transfer
+	logger *observability.CoreLogger
+
+	// username and password are used to get tokens, if set
+	username string
+	password string
+
+	// tokensMu protects tokens
+	tokensMu sync.Mutex
+
+	// tokens are bearer tokens by registry and scope
+	tokens map[string]string
+}
+
+// NewOCIFileTransfer creates a new OCIFileTransfer.
+func NewOCIFileTransfer(
+	client *retryablehttp.Client,
+	logger *observability.CoreLogger,
+	username string,
+	password string,
+) *OCIFileTransfer {
+	return &OCIFileTransfer{
+		client:   client,
+		logger:   logger,
+		username: username,
+		password: password,
+		tokens:   make(map[string]string),
+	}
+}
+
+// Upload is not supported for OCI registries.
+func (ft *OCIFileTransfer) Upload(task *Task) error {
+	return errors.New("file transfer: oci: upload is not supported")
+}
+
+// Download downloads a blob.
+//
+// If the blob's contents don't match the digest in the URL, the
+// downloaded file is removed and the download fails with
+// ErrDigestMismatch.
+func (ft *OCIFileTransfer) Download(task *Task) error {
+	ft.logger.Debug("oci file transfer: downloading", "path", task.Path, "url", task.Url)
+
+	ref, err := parseOCIReference(task.Url)
+	if err != nil {
+		return err
+	}
+
+	resp, err := ft.do(task, http.MethodGet, ref)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	task.Response = resp
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("file transfer: oci: failed to download: %w", newStatusError(resp))
+	}
+
+	if err := os.MkdirAll(filepath.Dir(task.Path), os.ModePerm); err != nil {
+		return err
+	}
+	file, err := os.Create(task.Path)
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.New()
+	progress := NewProgressWriter(file, int(resp.ContentLength), task.ProgressCallback)
+	_, err = io.Copy(io.MultiWriter(progress, hash), resp.Body)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	actual := "sha256:" + hex.EncodeToString(hash.Sum(nil))
+	if actual != ref.digest {
+		_ = os.Remove(task.Path)
+		return &DigestMismatchError{
+			Path:     task.Path,
+			Expected: ref.digest,
+			Actual:   actual,
+		}
+	}
+
+	return nil
+}
+
+// DownloadStream opens a blob for reading.
+//
+// The blob's contents are checked against the digest in the URL.
+func (ft *OCIFileTransfer) DownloadStream(task *Task) (io.ReadCloser, error) {
+	ft.logger.Debug("oci file transfer: streaming", "url", task.Url)
+
+	ref, err := parseOCIReference(task.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ft.do(task, http.MethodGet, ref)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("file transfer: oci: failed to download: %w", newStatusError(resp))
+	}
+	task.Response = resp
+
+	stream := newDownloadStream(task, resp.Body, resp.ContentLength)
+	return stream.withSHA256(task.Url, ref.digest), nil
+}
+
+// Verify checks a blob using a HEAD request.
+//
+// The registry's Docker-Content-Digest header, if sent, must match the
+// digest in the URL.
+func (ft *OCIFileTransfer) Verify(task *Task) (*VerificationReport, error) {
+	ref, err := parseOCIReference(task.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ft.do(task, http.MethodHead, ref)
+	if err != nil {
+		return nil, err
+	}
+	report, err := verifyResponse(task, resp)
+	if err != nil || !report.Exists {
+		return report, err
+	}
+
+	if version := resp.Header.Get("Docker-Content-Digest"); version != "" {
+		report.Version = version
+		if version != ref.digest {
+			report.Problems = append(report.Problems,
+				fmt.Sprintf("digest is %s, expected %s", version, ref.digest))
+		}
+	}
+	return report, nil
+}
+
+// do requests a blob, authenticating if the registry asks for it.
+func (ft *OCIFileTransfer) do(
+	task *Task,
+	method string,
+	ref *ociReference,
+) (*http.Response, error) {
+	scope := fmt.Sprintf("repository:%s:pull", ref.repository)
+
+	resp, err := ft.doWithToken(task, method, ref, ft.token(ref.registry, scope))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	_ = resp.Body.Close()
+
+	scheme, params := parseAuthChallenge(resp.Header.Get("WWW-Authenticate"))
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if ft.username == "" && ft.password == "" {
+			return nil, fmt.Errorf(
+				"file transfer: oci: %s requires credentials", ref.registry)
+		}
+		return ft.doWithToken(task, method, ref, "")
+
+	case "bearer":
+		if params["scope"] != "" {
+			scope = params["scope"]
+		}
+		token, err := ft.fetchToken(task, params["realm"], params["service"], scope)
+		if err != nil {
+			return nil, err
+		}
+		ft.setToken(ref.registry, scope, token)
+		return ft.doWithToken(task, method, ref, token)
+
+	default:
+		return nil, fmt.Errorf(
+			"file transfer: oci: unsupported authentication scheme %q", scheme)
+	}
+}
+
+// doWithToken requests a blob with a bearer token, or with basic
+// authentication if the token is empty and credentials are set.
+func (ft *OCIFileTransfer) doWithToken(
+	task *Task,
+	method string,
+	ref *ociReference,
+	token string,
+) (*http.Response, error) {
+	req, err := retryablehttp.NewRequest(method, ref.blobURL(), nil)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case token != "":
+		req.Header.Set("Authorization", "Bearer "+token)
+	case ft.username != "" || ft.password != "":
+		req.SetBasicAuth(ft.username, ft.password)
+	}
+	if task.Context != nil {
+		req = req.WithContext(task.Context)
+	}
+	return ft.client.Do(req)
+}
+
+// fetchToken gets a bearer token from a registry's token service.
+//
+// The request is anonymous unless credentials are set.
+func (ft *OCIFileTransfer) fetchToken(
+	task *Task,
+	realm string,
+	service string,
+	scope string,
+) (string, error) {
+	realmURL, err := url.Parse(realm)
+	if err != nil || realmURL.Scheme == "" {
+		return "", fmt.Errorf("file transfer: oci: invalid token realm %q", realm)
+	}
+	query := realmURL.Query()
+	if service != "" {
+		query.Set("service", service)
+	}
+	query.Set("scope", scope)
+	realmURL.RawQuery = query.Encode()
+
+	req, err := retryablehttp.NewRequest(http.MethodGet, realmURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if ft.username != "" || ft.password != "" {
+		req.SetBasicAuth(ft.username, ft.password)
+	}
+	if task.Context != nil {
+		req = req.WithContext(task.Context)
+	}
+
+	resp, err := ft.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", fmt.Errorf("file transfer: oci: failed to get token: %s", resp.Status)
+	}
+
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("file transfer: oci: invalid token response: %v", err)
+	}
+	if body.Token != "" {
+		return body.Token, nil
+	}
+	if body.AccessToken != "" {
+		return body.AccessToken, nil
+	}
+	return "", errors.New("file transfer: oci: token response has no token")
+}
+
+// token returns the cached token for a registry and scope, if any.
+func (ft *OCIFileTransfer) token(registry string, scope string) string {
+	ft.tokensMu.Lock()
+	defer ft.tokensMu.Unlock()
+	return ft.tokens[registry+" "+scope]
+}
+
+// setToken caches a token for a registry and scope.
+func (ft *OCIFileTransfer) setToken(registry string, scope string, token string) {
+	ft.tokensMu.Lock()
+	defer ft.tokensMu.Unlock()
+	ft.tokens[registry+" "+scope] = token
+}
+
+// ociReference is a parsed oci:// URL.
+type ociReference struct {
+	registry   string
+	repository string
+	digest     string
+}
+
+var ociDigestRegexp = regexp.MustCompile(`^sha256:[0-9a-f]{64}$`)
+
+// parseOCIReference parses an oci://registry/repository@digest URL.
+func parseOCIReference(rawURL string) (*ociReference, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("file transfer: oci: invalid URL: %v", err)
+	}
+	if u.Scheme != "oci" || u.Host == "" {
+		return nil, fmt.Errorf("file transfer: oci: invalid URL %q", rawURL)
+	}
+
+	repository, digest, ok := strings.Cut(strings.Trim(u.Path, "/"), "@")
+	if !ok || repository == "" {
+		return nil, fmt.Errorf(
+			"file transfer: oci: URL %q must include a digest", rawURL)
+	}
+	if !ociDigestRegexp.MatchString(digest) {
+		return nil, fmt.Errorf("file transfer: oci: invalid digest %q", digest)
+	}
+
+	return &ociReference{
+		registry:   u.Host,
+		repository: repository,
+		digest:     digest,
+	}, nil
+}
+
+// blobURL returns the registry API endpoint for the blob.
+func (ref *ociReference) blobURL() string {
+	return fmt.Sprintf(
+		"https://%s/v2/%s/blobs/%s",
+		ref.registry, ref.repository, ref.digest,
+	)
+}
+
+// parseAuthChallenge parses a WWW-Authenticate header such as
+// `Bearer realm="https://auth.example.com/token",service="registry"`.
+func parseAuthChallenge(header string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+	params := make(map[string]string)
+
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(rest, "=")
+		key = strings.ToLower(strings.Trim(key, ", "))
+
+		rest = strings.TrimSpace(rest)
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+
+		if key != "" {
+			params[key] = value
+		}
+	}
+
+	return scheme, params
+}
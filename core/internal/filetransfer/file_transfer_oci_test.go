@@ -0,0 +1,160 @@
+package filetransfer_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+func ociDigest(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// fakeRegistry serves blobs in the repository "org/model" that require a
+// bearer token from its token endpoint.
+func fakeRegistry(
+	t *testing.T,
+	blobs map[string]string,
+	tokenRequests *atomic.Int32,
+) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			tokenRequests.Add(1)
+			assert.Equal(t, "registry.test", r.URL.Query().Get("service"))
+			assert.Equal(t, "repository:org/model:pull", r.URL.Query().Get("scope"))
+			_, _ = w.Write([]byte(`{"token": "secret"}`))
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.Header().Set("WWW-Authenticate",
+				`Bearer realm="`+server.URL+`/token",service="registry.test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		digest, ok := strings.CutPrefix(r.URL.Path, "/v2/org/model/blobs/")
+		content, found := blobs[digest]
+		if !ok || !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Docker-Content-Digest", digest)
+		_, _ = w.Write([]byte(content))
+	}))
+	return server
+}
+
+func ociClient(server *httptest.Server) *retryablehttp.Client {
+	client := impatientClient()
+	client.HTTPClient = server.Client()
+	return client
+}
+
+func ociURL(server *httptest.Server, digest string) string {
+	return "oci://" + strings.TrimPrefix(server.URL, "https://") + "/org/model@" + digest
+}
+
+func TestOCIFileTransfer_Download(t *testing.T) {
+	var tokenRequests atomic.Int32
+	digest := ociDigest("weights")
+	server := fakeRegistry(t, map[string]string{digest: "weights"}, &tokenRequests)
+	defer server.Close()
+	ft := filetransfer.NewOCIFileTransfer(
+		ociClient(server), observability.NewNoOpLogger(), "", "")
+
+	for i := 0; i < 2; i++ {
+		task := &filetransfer.Task{
+			Type:      filetransfer.DownloadTask,
+			Path:      filepath.Join(t.TempDir(), "weights.bin"),
+			Url:       ociURL(server, digest),
+			Reference: true,
+		}
+
+		err := ft.Download(task)
+
+		require.NoError(t, err)
+		content, err := os.ReadFile(task.Path)
+		require.NoError(t, err)
+		assert.Equal(t, "weights", string(content))
+	}
+	assert.EqualValues(t, 1, tokenRequests.Load())
+}
+
+func TestOCIFileTransfer_DownloadDigestMismatch(t *testing.T) {
+	var tokenRequests atomic.Int32
+	digest := ociDigest("weights")
+	server := fakeRegistry(t, map[string]string{digest: "tampered"}, &tokenRequests)
+	defer server.Close()
+	ft := filetransfer.NewOCIFileTransfer(
+		ociClient(server), observability.NewNoOpLogger(), "", "")
+	task := &filetransfer.Task{
+		Type:      filetransfer.DownloadTask,
+		Path:      filepath.Join(t.TempDir(), "weights.bin"),
+		Url:       ociURL(server, digest),
+		Reference: true,
+	}
+
+	err := ft.Download(task)
+
+	assert.ErrorIs(t, err, filetransfer.ErrDigestMismatch)
+	assert.NoFileExists(t, task.Path)
+}
+
+func TestOCIFileTransfer_Verify(t *testing.T) {
+	var tokenRequests atomic.Int32
+	digest := ociDigest("weights")
+	server := fakeRegistry(t, map[string]string{digest: "weights"}, &tokenRequests)
+	defer server.Close()
+	ft := filetransfer.NewOCIFileTransfer(
+		ociClient(server), observability.NewNoOpLogger(), "", "")
+
+	report, err := ft.Verify(&filetransfer.Task{
+		Type: filetransfer.VerifyTask,
+		Url:  ociURL(server, digest),
+		Size: 7,
+	})
+	require.NoError(t, err)
+	assert.True(t, report.OK())
+	assert.Equal(t, digest, report.Version)
+
+	report, err = ft.Verify(&filetransfer.Task{
+		Type: filetransfer.VerifyTask,
+		Url:  ociURL(server, ociDigest("missing")),
+	})
+	require.NoError(t, err)
+	assert.False(t, report.Exists)
+}
+
+func TestOCIFileTransfer_InvalidURL(t *testing.T) {
+	ft := filetransfer.NewOCIFileTransfer(
+		impatientClient(), observability.NewNoOpLogger(), "", "")
+
+	for _, url := range []string{
+		"oci://ghcr.io/org/model:latest",
+		"oci://ghcr.io/org/model@sha256:abc",
+		"oci://ghcr.io/@" + ociDigest("weights"),
+	} {
+		err := ft.Download(&filetransfer.Task{
+			Type: filetransfer.DownloadTask,
+			Path: filepath.Join(t.TempDir(), "file"),
+			Url:  url,
+		})
+
+		assert.ErrorContains(t, err, "file transfer: oci:", url)
+	}
+}
@@ -1,11 +1,17 @@
 package filetransfer
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/wandb/wandb/core/internal/clients"
 )
 
 const (
@@ -17,12 +23,152 @@ const (
 )
 
 // FileTransferRetryPolicy is the retry policy to be used for file operations.
+//
+// Transient failures such as connection errors, timeouts, throttling and
+// server errors are retried. Throttling includes backend-specific errors
+// such as S3's SlowDown and GCS's rateLimitExceeded. Errors that won't go away by retrying, like
+// most 4xx responses or TLS verification failures, fail immediately.
 func FileTransferRetryPolicy(
 	ctx context.Context,
 	resp *http.Response,
 	err error,
 ) (bool, error) {
-	// TODO(WB-18702): Add explicit cases for (non-)retryable errors.
+	// Respect context cancellation and deadlines.
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
 
-	return retryablehttp.ErrorPropagatedRetryPolicy(ctx, resp, err)
+	// Use retryablehttp's classification of connection errors, which
+	// retries everything except known permanent failures.
+	if err != nil {
+		return retryablehttp.ErrorPropagatedRetryPolicy(ctx, resp, err)
+	}
+
+	if isThrottled(resp) {
+		return true, fmt.Errorf("throttled: HTTP status %w", newThrottledError(resp))
+	}
+
+	switch resp.StatusCode {
+	case http.StatusRequestTimeout: // retry on 408 request timeout
+		return true, fmt.Errorf("unexpected HTTP status %w", newStatusError(resp))
+	case http.StatusTooEarly: // retry on 425 too early
+		return true, fmt.Errorf("unexpected HTTP status %w", newStatusError(resp))
+	case http.StatusTooManyRequests: // retry on 429 too many requests
+		return true, fmt.Errorf("unexpected HTTP status %w", newStatusError(resp))
+	case http.StatusNotImplemented: // don't retry on 501 not implemented
+		return false, nil
+	}
+
+	// Retry server errors and invalid HTTP codes.
+	if resp.StatusCode == 0 || resp.StatusCode >= 500 {
+		return true, fmt.Errorf("unexpected HTTP status %w", newStatusError(resp))
+	}
+
+	// Don't retry any other client errors.
+	return false, nil
+}
+
+// FileTransferBackoff returns how long to wait before retrying a request.
+//
+// Throttled requests wait as long as the response's Retry-After header
+// asks for, if it has one, but never longer than max. Otherwise, the wait
+// grows exponentially.
+func FileTransferBackoff(
+	min, max time.Duration,
+	attemptNum int,
+	resp *http.Response,
+) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp, time.Now()); ok {
+			if wait > max {
+				return max
+			}
+			return wait
+		}
+	}
+	return clients.ExponentialBackoffWithJitter(min, max, attemptNum, resp)
+}
+
+// throttleErrorCodes are error codes that storage backends use to ask
+// clients to slow down.
+var throttleErrorCodes = []string{
+	"SlowDown",                 // S3
+	"RequestLimitExceeded",     // S3
+	"rateLimitExceeded",        // GCS
+	"userRateLimitExceeded",    // GCS
+	"ServerBusy",               // Azure
+	"OperationTimedOut",        // Azure
+	"TooManyRequestsException", // Generic AWS APIs
+}
+
+// maxThrottleBodySize is how much of an error response is searched for
+// throttle error codes.
+const maxThrottleBodySize = 4096
+
+// isThrottled reports whether a response asks the client to slow down.
+//
+// Backends report throttling with 429, 503 or, for GCS, 403 responses.
+// For 403 and 503 responses, the start of the body is searched for a
+// throttle error code, and the body is restored afterward.
+func isThrottled(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusForbidden, http.StatusServiceUnavailable:
+	default:
+		return false
+	}
+
+	if resp.StatusCode == http.StatusServiceUnavailable &&
+		resp.Header.Get("Retry-After") != "" {
+		return true
+	}
+
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return false
+	}
+	head, err := io.ReadAll(io.LimitReader(resp.Body, maxThrottleBodySize))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), resp.Body), resp.Body}
+	if err != nil {
+		return false
+	}
+
+	for _, code := range throttleErrorCodes {
+		if bytes.Contains(head, []byte(code)) {
+			return true
+		}
+	}
+	return false
+}
+
+// retryAfter returns the wait requested by a response's Retry-After
+// header, which is either a number of seconds or an HTTP date.
+//
+// Only 429 and 503 responses are considered.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests &&
+		resp.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	value := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return clients.SecondsToDuration(seconds), true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+
+	return 0, false
 }
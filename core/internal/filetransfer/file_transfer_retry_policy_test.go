@@ -0,0 +1,185 @@
+package filetransfer_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+)
+
+func TestFileTransferRetryPolicy(t *testing.T) {
+	testCases := []struct {
+		name        string
+		statusCode  int
+		shouldRetry bool
+	}{
+		{"OK", http.StatusOK, false},
+		{"BadRequest", http.StatusBadRequest, false},
+		{"Forbidden", http.StatusForbidden, false},
+		{"NotFound", http.StatusNotFound, false},
+		{"RequestTimeout", http.StatusRequestTimeout, true},
+		{"TooManyRequests", http.StatusTooManyRequests, true},
+		{"InternalServerError", http.StatusInternalServerError, true},
+		{"NotImplemented", http.StatusNotImplemented, false},
+		{"ServiceUnavailable", http.StatusServiceUnavailable, true},
+		{"InvalidStatus", 999, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := httptest.NewRecorder().Result()
+			resp.StatusCode = tc.statusCode
+
+			retry, _ := filetransfer.FileTransferRetryPolicy(
+				context.Background(), resp, nil)
+
+			assert.Equal(t, tc.shouldRetry, retry)
+		})
+	}
+}
+
+func TestFileTransferRetryPolicy_ConnectionErrors(t *testing.T) {
+	retry, _ := filetransfer.FileTransferRetryPolicy(
+		context.Background(),
+		nil,
+		&url.Error{Op: "Get", URL: "https://example.com", Err: errors.New("connection reset by peer")},
+	)
+	assert.True(t, retry)
+
+	retry, _ = filetransfer.FileTransferRetryPolicy(
+		context.Background(),
+		nil,
+		&url.Error{Op: "Get", URL: "ftp://example.com", Err: errors.New("unsupported protocol scheme")},
+	)
+	assert.False(t, retry)
+}
+
+func TestFileTransferRetryPolicy_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	retry, err := filetransfer.FileTransferRetryPolicy(
+		ctx, httptest.NewRecorder().Result(), nil)
+
+	assert.False(t, retry)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestFileTransferRetryPolicy_ThrottleErrors(t *testing.T) {
+	testCases := []struct {
+		name        string
+		statusCode  int
+		body        string
+		shouldRetry bool
+	}{
+		{"S3 SlowDown", http.StatusServiceUnavailable,
+			"<Error><Code>SlowDown</Code></Error>", true},
+		{"GCS rateLimitExceeded", http.StatusForbidden,
+			`{"error": {"errors": [{"reason": "rateLimitExceeded"}]}}`, true},
+		{"Forbidden", http.StatusForbidden,
+			`{"error": {"errors": [{"reason": "forbidden"}]}}`, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			recorder.WriteHeader(tc.statusCode)
+			_, _ = recorder.WriteString(tc.body)
+			resp := recorder.Result()
+
+			retry, _ := filetransfer.FileTransferRetryPolicy(
+				context.Background(), resp, nil)
+
+			assert.Equal(t, tc.shouldRetry, retry)
+			body, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.body, string(body))
+		})
+	}
+}
+
+func TestFileTransferBackoff_RetryAfter(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statusCode int
+		retryAfter string
+		minWait    time.Duration
+		maxWait    time.Duration
+	}{
+		{"seconds", http.StatusTooManyRequests, "30",
+			30 * time.Second, 30 * time.Second},
+		{"date", http.StatusServiceUnavailable,
+			time.Now().Add(time.Minute).UTC().Format(http.TimeFormat),
+			55 * time.Second, 61 * time.Second},
+		{"clamped to max", http.StatusTooManyRequests, "86400",
+			2 * time.Minute, 2 * time.Minute},
+		{"ignored on other errors", http.StatusInternalServerError, "30",
+			time.Second, 2 * time.Second},
+		{"invalid", http.StatusTooManyRequests, "soon",
+			time.Second, 2 * time.Second},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := httptest.NewRecorder().Result()
+			resp.StatusCode = tc.statusCode
+			resp.Header.Set("Retry-After", tc.retryAfter)
+
+			wait := filetransfer.FileTransferBackoff(
+				time.Second, 2*time.Minute, 0, resp)
+
+			assert.GreaterOrEqual(t, wait, tc.minWait)
+			assert.LessOrEqual(t, wait, tc.maxWait)
+		})
+	}
+}
+
+func TestThrottle_PausesAfterThrottledResponse(t *testing.T) {
+	throttle := filetransfer.NewThrottle()
+	checkRetry := throttle.CheckRetry(filetransfer.FileTransferRetryPolicy)
+
+	resp := httptest.NewRecorder().Result()
+	resp.StatusCode = http.StatusTooManyRequests
+	resp.Header.Set("Retry-After", "0.1")
+	start := time.Now()
+	retry, _ := checkRetry(context.Background(), resp, nil)
+
+	assert.True(t, retry)
+	assert.NoError(t, throttle.Wait(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestThrottle_WaitCanceled(t *testing.T) {
+	throttle := filetransfer.NewThrottle()
+	checkRetry := throttle.CheckRetry(filetransfer.FileTransferRetryPolicy)
+	resp := httptest.NewRecorder().Result()
+	resp.StatusCode = http.StatusServiceUnavailable
+	resp.Body = io.NopCloser(strings.NewReader("SlowDown"))
+	_, _ = checkRetry(context.Background(), resp, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.ErrorIs(t, throttle.Wait(ctx), context.Canceled)
+}
+
+func TestThrottle_NoPauseWithoutThrottling(t *testing.T) {
+	throttle := filetransfer.NewThrottle()
+	checkRetry := throttle.CheckRetry(filetransfer.FileTransferRetryPolicy)
+	resp := httptest.NewRecorder().Result()
+	resp.StatusCode = http.StatusInternalServerError
+	_, _ = checkRetry(context.Background(), resp, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.NoError(t, throttle.Wait(ctx))
+}
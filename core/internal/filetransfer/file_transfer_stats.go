@@ -9,7 +9,7 @@ import (
 
 // FileTransferStats reports file upload/download progress and totals.
 type FileTransferStats interface {
-	// GetFilesStats returns byte counts for uploads.
+	// GetFilesStats returns byte counts for uploads and downloads.
 	GetFilesStats() *service.FilePusherStats
 
 	// GetFileCounts returns a breakdown of the kinds of files uploaded.
@@ -23,6 +23,13 @@ type FileTransferStats interface {
 
 	// UpdateUploadStats updates the upload stats for a file.
 	UpdateUploadStats(newInfo FileUploadInfo)
+
+	// UpdateDownloadStats updates the download stats for a file.
+	UpdateDownloadStats(newInfo FileDownloadInfo)
+
+	// AddDedupedBytes counts bytes that didn't need to be uploaded
+	// because the backend already had them.
+	AddDedupedBytes(bytes int64)
 }
 
 type fileTransferStats struct {
@@ -30,12 +37,16 @@ type fileTransferStats struct {
 
 	done *atomic.Bool
 
-	statsByPath map[string]FileUploadInfo
+	statsByPath         map[string]FileUploadInfo
+	downloadStatsByPath map[string]FileDownloadInfo
 
 	uploadedBytes *atomic.Int64
 	totalBytes    *atomic.Int64
 	dedupedBytes  *atomic.Int64
 
+	downloadedBytes    *atomic.Int64
+	totalDownloadBytes *atomic.Int64
+
 	wandbCount    *atomic.Int32
 	mediaCount    *atomic.Int32
 	artifactCount *atomic.Int32
@@ -46,12 +57,16 @@ func NewFileTransferStats() FileTransferStats {
 	return &fileTransferStats{
 		done: &atomic.Bool{},
 
-		statsByPath: make(map[string]FileUploadInfo),
+		statsByPath:         make(map[string]FileUploadInfo),
+		downloadStatsByPath: make(map[string]FileDownloadInfo),
 
 		uploadedBytes: &atomic.Int64{},
 		totalBytes:    &atomic.Int64{},
 		dedupedBytes:  &atomic.Int64{},
 
+		downloadedBytes:    &atomic.Int64{},
+		totalDownloadBytes: &atomic.Int64{},
+
 		wandbCount:    &atomic.Int32{},
 		mediaCount:    &atomic.Int32{},
 		artifactCount: &atomic.Int32{},
@@ -66,6 +81,9 @@ func (fts *fileTransferStats) GetFilesStats() *service.FilePusherStats {
 		UploadedBytes: fts.uploadedBytes.Load(),
 		TotalBytes:    fts.totalBytes.Load(),
 		DedupedBytes:  fts.dedupedBytes.Load(),
+
+		DownloadedBytes:    fts.downloadedBytes.Load(),
+		TotalDownloadBytes: fts.totalDownloadBytes.Load(),
 	}
 }
 
@@ -113,6 +131,36 @@ func (fts *fileTransferStats) UpdateUploadStats(newInfo FileUploadInfo) {
 	fts.addStats(newInfo, 1)
 }
 
+// FileDownloadInfo is information about an in-progress file download.
+type FileDownloadInfo struct {
+	// The local path the file is being downloaded to.
+	Path string
+
+	// The number of bytes downloaded so far.
+	DownloadedBytes int64
+
+	// The total number of bytes being downloaded.
+	TotalBytes int64
+}
+
+func (fts *fileTransferStats) UpdateDownloadStats(newInfo FileDownloadInfo) {
+	fts.Lock()
+	defer fts.Unlock()
+
+	if oldInfo, ok := fts.downloadStatsByPath[newInfo.Path]; ok {
+		fts.downloadedBytes.Add(-oldInfo.DownloadedBytes)
+		fts.totalDownloadBytes.Add(-oldInfo.TotalBytes)
+	}
+
+	fts.downloadStatsByPath[newInfo.Path] = newInfo
+	fts.downloadedBytes.Add(newInfo.DownloadedBytes)
+	fts.totalDownloadBytes.Add(newInfo.TotalBytes)
+}
+
+func (fts *fileTransferStats) AddDedupedBytes(bytes int64) {
+	fts.dedupedBytes.Add(bytes)
+}
+
 func (fts *fileTransferStats) addStats(info FileUploadInfo, mult int64) {
 	fts.uploadedBytes.Add(info.UploadedBytes * mult)
 	fts.totalBytes.Add(info.TotalBytes * mult)
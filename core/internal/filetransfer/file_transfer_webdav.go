@@ -0,0 +1,277 @@
+package filetransfer
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// WebDAVFileTransfer uploads and downloads files on a WebDAV server, such
+// as Nextcloud or ownCloud.
+//
+// It accepts webdav:// and webdavs:// URLs, which are requested over HTTP
+// and HTTPS respectively.
+type WebDAVFileTransfer struct {
+	// client is the HTTP client for the file transfer
+	client *retryablehttp.Client
+
+	// logger is the logger for the file transfer
+	logger *observability.CoreLogger
+
+	// username and password are sent using basic authentication, if set
+	username string
+	password string
+}
+
+// NewWebDAVFileTransfer creates a new WebDAVFileTransfer.
+func NewWebDAVFileTransfer(
+	client *retryablehttp.Client,
+	logger *observability.CoreLogger,
+	username string,
+	password string,
+) *WebDAVFileTransfer {
+	return &WebDAVFileTransfer{
+		client:   client,
+		logger:   logger,
+		username: username,
+		password: password,
+	}
+}
+
+// Upload uploads a file, creating any missing parent collections.
+func (ft *WebDAVFileTransfer) Upload(task *Task) error {
+	ft.logger.Debug("webdav file transfer: uploading", "path", task.Path, "url", task.Url)
+
+	target, err := webDAVURL(task.Url)
+	if err != nil {
+		return err
+	}
+	if err := ft.makeCollections(task, target); err != nil {
+		return err
+	}
+
+	file, err := os.Open(task.uploadPath())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			ft.logger.CaptureError(
+				fmt.Errorf(
+					"file transfer: webdav: error closing file %s: %v",
+					task.Path,
+					err,
+				))
+		}
+	}()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if stat.Size() > math.MaxInt {
+		return fmt.Errorf("file transfer: file too large (%d bytes)", stat.Size())
+	}
+
+	var body any = http.NoBody
+	if stat.Size() > 0 {
+		body = NewProgressReader(
+			io.NewSectionReader(file, 0, stat.Size()),
+			int(stat.Size()),
+			task.ProgressCallback,
+		)
+	}
+
+	resp, err := ft.do(task, http.MethodPut, target.String(), body)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("file transfer: webdav: failed to upload: %w", newStatusError(resp))
+	}
+	task.Response = resp
+
+	return nil
+}
+
+// Download downloads a file.
+//
+// For references with a digest, the response's ETag must match it.
+func (ft *WebDAVFileTransfer) Download(task *Task) error {
+	ft.logger.Debug("webdav file transfer: downloading", "path", task.Path, "url", task.Url)
+
+	source, err := webDAVURL(task.Url)
+	if err != nil {
+		return err
+	}
+
+	resp, err := ft.do(task, http.MethodGet, source.String(), nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	task.Response = resp
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("file transfer: webdav: failed to download: %w", newStatusError(resp))
+	}
+
+	if task.Reference && task.Digest != "" {
+		if actual := responseVersion(resp); actual != task.Digest {
+			return &DigestMismatchError{
+				Path:     task.Path,
+				Expected: task.Digest,
+				Actual:   actual,
+			}
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(task.Path), os.ModePerm); err != nil {
+		return err
+	}
+	file, err := os.Create(task.Path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			ft.logger.CaptureError(
+				fmt.Errorf(
+					"file transfer: webdav: error closing file %s: %v",
+					task.Path,
+					err,
+				))
+		}
+	}()
+
+	progress := NewProgressWriter(file, int(resp.ContentLength), task.ProgressCallback)
+	_, err = io.Copy(progress, resp.Body)
+	return err
+}
+
+// DownloadStream opens a file for reading.
+//
+// For references with a digest, the response's ETag must match it.
+func (ft *WebDAVFileTransfer) DownloadStream(task *Task) (io.ReadCloser, error) {
+	ft.logger.Debug("webdav file transfer: streaming", "url", task.Url)
+
+	source, err := webDAVURL(task.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ft.do(task, http.MethodGet, source.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("file transfer: webdav: failed to download: %w", newStatusError(resp))
+	}
+	if task.Reference && task.Digest != "" {
+		if err := digestMismatch(task.Url, task.Digest, responseVersion(resp)); err != nil {
+			_ = resp.Body.Close()
+			return nil, err
+		}
+	}
+	task.Response = resp
+
+	return newDownloadStream(task, resp.Body, resp.ContentLength), nil
+}
+
+// Verify checks a file using a HEAD request.
+func (ft *WebDAVFileTransfer) Verify(task *Task) (*VerificationReport, error) {
+	source, err := webDAVURL(task.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ft.do(task, http.MethodHead, source.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return verifyResponse(task, resp)
+}
+
+// makeCollections creates the collections containing the target URL,
+// starting from the root.
+//
+// WebDAV servers reject uploads into collections that don't exist.
+func (ft *WebDAVFileTransfer) makeCollections(task *Task, target *url.URL) error {
+	dir := path.Dir(target.Path)
+	if dir == "/" || dir == "." {
+		return nil
+	}
+
+	collection := *target
+	collection.Path = ""
+	for _, part := range strings.Split(strings.Trim(dir, "/"), "/") {
+		collection.Path += "/" + part
+
+		resp, err := ft.do(task, "MKCOL", collection.String()+"/", nil)
+		if err != nil {
+			return err
+		}
+		_ = resp.Body.Close()
+
+		// 405 Method Not Allowed means the collection already exists.
+		if resp.StatusCode != http.StatusCreated &&
+			resp.StatusCode != http.StatusMethodNotAllowed {
+			return fmt.Errorf(
+				"file transfer: webdav: failed to create %s: %s",
+				collection.Path,
+				resp.Status,
+			)
+		}
+	}
+
+	return nil
+}
+
+// do makes an authenticated request.
+func (ft *WebDAVFileTransfer) do(
+	task *Task,
+	method string,
+	rawURL string,
+	body any,
+) (*http.Response, error) {
+	req, err := retryablehttp.NewRequest(method, rawURL, body)
+	if err != nil {
+		return nil, err
+	}
+	if ft.username != "" || ft.password != "" {
+		req.SetBasicAuth(ft.username, ft.password)
+	}
+	if task.Context != nil {
+		req = req.WithContext(task.Context)
+	}
+	return ft.client.Do(req)
+}
+
+// webDAVURL converts a webdav:// or webdavs:// URL to an HTTP(S) URL.
+func webDAVURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("file transfer: webdav: invalid URL: %v", err)
+	}
+
+	switch u.Scheme {
+	case "webdav":
+		u.Scheme = "http"
+	case "webdavs":
+		u.Scheme = "https"
+	default:
+		return nil, fmt.Errorf("file transfer: webdav: unsupported scheme %q", u.Scheme)
+	}
+	return u, nil
+}
@@ -0,0 +1,147 @@
+package filetransfer_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// fakeWebDAV is a minimal WebDAV server that keeps files in memory.
+type fakeWebDAV struct {
+	sync.Mutex
+	collections map[string]bool
+	files       map[string][]byte
+}
+
+func newFakeWebDAV(t *testing.T) (*fakeWebDAV, *httptest.Server) {
+	dav := &fakeWebDAV{
+		collections: map[string]bool{"/": true},
+		files:       map[string][]byte{},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok || user != "user" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		dav.Lock()
+		defer dav.Unlock()
+
+		p := strings.TrimSuffix(r.URL.Path, "/")
+		switch r.Method {
+		case "MKCOL":
+			switch {
+			case dav.collections[p]:
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			case !dav.collections[path.Dir(p)]:
+				w.WriteHeader(http.StatusConflict)
+			default:
+				dav.collections[p] = true
+				w.WriteHeader(http.StatusCreated)
+			}
+		case http.MethodPut:
+			if !dav.collections[path.Dir(p)] {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			dav.files[p] = body
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodGet:
+			content, ok := dav.files[p]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write(content)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+
+	return dav, server
+}
+
+func webDAVURL(server *httptest.Server, p string) string {
+	return "webdav://" + strings.TrimPrefix(server.URL, "http://") + p
+}
+
+func TestWebDAVFileTransfer_UploadAndDownload(t *testing.T) {
+	dav, server := newFakeWebDAV(t)
+	defer server.Close()
+	ft := filetransfer.NewWebDAVFileTransfer(
+		impatientClient(), observability.NewNoOpLogger(), "user", "secret")
+
+	dir := t.TempDir()
+	uploadPath := filepath.Join(dir, "upload.txt")
+	require.NoError(t, os.WriteFile(uploadPath, []byte("dataset"), 0o644))
+
+	err := ft.Upload(&filetransfer.Task{
+		Type: filetransfer.UploadTask,
+		Path: uploadPath,
+		Url:  webDAVURL(server, "/remote.php/dav/data/file.txt"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "dataset", string(dav.files["/remote.php/dav/data/file.txt"]))
+
+	downloadTask := &filetransfer.Task{
+		Type:      filetransfer.DownloadTask,
+		Path:      filepath.Join(dir, "download.txt"),
+		Url:       webDAVURL(server, "/remote.php/dav/data/file.txt"),
+		Digest:    "v1",
+		Reference: true,
+	}
+	err = ft.Download(downloadTask)
+	require.NoError(t, err)
+	content, err := os.ReadFile(downloadTask.Path)
+	require.NoError(t, err)
+	assert.Equal(t, "dataset", string(content))
+}
+
+func TestWebDAVFileTransfer_DownloadChanged(t *testing.T) {
+	dav, server := newFakeWebDAV(t)
+	defer server.Close()
+	dav.files["/file.txt"] = []byte("contents")
+	ft := filetransfer.NewWebDAVFileTransfer(
+		impatientClient(), observability.NewNoOpLogger(), "user", "secret")
+
+	err := ft.Download(&filetransfer.Task{
+		Type:      filetransfer.DownloadTask,
+		Path:      filepath.Join(t.TempDir(), "file.txt"),
+		Url:       webDAVURL(server, "/file.txt"),
+		Digest:    "v0",
+		Reference: true,
+	})
+
+	assert.ErrorIs(t, err, filetransfer.ErrDigestMismatch)
+}
+
+func TestWebDAVFileTransfer_Unauthorized(t *testing.T) {
+	_, server := newFakeWebDAV(t)
+	defer server.Close()
+	ft := filetransfer.NewWebDAVFileTransfer(
+		impatientClient(), observability.NewNoOpLogger(), "user", "wrong")
+
+	err := ft.Download(&filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: filepath.Join(t.TempDir(), "file.txt"),
+		Url:  webDAVURL(server, "/file.txt"),
+	})
+
+	assert.ErrorContains(t, err, "401")
+}
@@ -1,7 +1,11 @@
 package filetransfer
 
 import (
+	"net/url"
+	"strings"
+
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/wandb/wandb/core/internal/settings"
 	"github.com/wandb/wandb/core/pkg/observability"
 )
 
@@ -14,6 +18,21 @@ type FileTransfer interface {
 type FileTransfers struct {
 	// Default makes an HTTP request to the destination URL with the file contents.
 	Default FileTransfer
+
+	// HDFS downloads hdfs://, webhdfs:// and swebhdfs:// references.
+	HDFS FileTransfer
+
+	// HTTPReference downloads http:// and https:// references.
+	HTTPReference FileTransfer
+
+	// WebDAV uploads and downloads webdav:// and webdavs:// URLs.
+	WebDAV FileTransfer
+
+	// OCI downloads oci:// references to registry blobs.
+	OCI FileTransfer
+
+	// Local downloads file:// references.
+	Local FileTransfer
 }
 
 // NewFileTransfers creates a new fileTransfers
@@ -21,18 +40,108 @@ func NewFileTransfers(
 	client *retryablehttp.Client,
 	logger *observability.CoreLogger,
 	fileTransferStats FileTransferStats,
+	settings *settings.Settings,
 ) *FileTransfers {
-	defaultFileTransfer := &DefaultFileTransfer{
-		logger:            logger,
-		client:            client,
-		fileTransferStats: fileTransferStats,
+	defaultFileTransfer := NewDefaultFileTransfer(
+		client,
+		logger,
+		fileTransferStats,
+		WithRangedDownloads(
+			int(settings.GetFileTransferDownloadConcurrency()),
+			settings.GetFileTransferDownloadChunkSize(),
+		),
+		WithUploadCompression(settings.GetFileTransferCompressGlobs()),
+	)
+	symlinkPolicy, err := ParseSymlinkPolicy(settings.GetFileTransferSymlinkPolicy())
+	if err != nil {
+		logger.Warn("file transfers: using default symlink policy", "error", err)
+		symlinkPolicy = SymlinkSkip
+	}
+	localReferencePolicy, err := ParseLocalReferencePolicy(
+		settings.GetFileTransferLocalReferencePolicy())
+	if err != nil {
+		logger.Warn("file transfers: using default local reference policy", "error", err)
+		localReferencePolicy = LocalReferenceCopy
+	}
+	referenceFilter := NameFilter{
+		Include: settings.GetFileTransferReferenceIncludeGlobs(),
+		Exclude: settings.GetFileTransferReferenceExcludeGlobs(),
 	}
+
 	return &FileTransfers{
 		Default: defaultFileTransfer,
+		HDFS: NewHDFSFileTransfer(
+			client,
+			logger,
+			WithSymlinkPolicy(symlinkPolicy),
+			WithDefaultNameFilter(referenceFilter),
+		),
+		HTTPReference: NewHTTPReferenceFileTransfer(
+			client,
+			logger,
+			settings.GetHTTPReferenceHeaders(),
+			settings.GetHTTPReferenceBearerToken(),
+		),
+		WebDAV: NewWebDAVFileTransfer(
+			client,
+			logger,
+			settings.GetWebDAVUsername(),
+			settings.GetWebDAVPassword(),
+		),
+		OCI: NewOCIFileTransfer(
+			client,
+			logger,
+			settings.GetOCIUsername(),
+			settings.GetOCIPassword(),
+		),
+		Local: NewLocalFileTransfer(
+			logger,
+			WithLocalReferencePolicy(localReferencePolicy),
+			WithLocalNameFilter(referenceFilter),
+		),
 	}
 }
 
 // Returns the appropriate fileTransfer depending on task
 func (ft *FileTransfers) GetFileTransferForTask(task *Task) FileTransfer {
-	return ft.Default
+	scheme, _, _ := strings.Cut(task.Url, "://")
+
+	switch strings.ToLower(scheme) {
+	case "hdfs", "webhdfs", "swebhdfs":
+		return ft.HDFS
+	case "http", "https":
+		if task.Reference {
+			return ft.HTTPReference
+		}
+		return ft.Default
+	case "webdav", "webdavs":
+		return ft.WebDAV
+	case "oci":
+		return ft.OCI
+	case "file":
+		return ft.Local
+	default:
+		return ft.Default
+	}
+}
+
+// SupportsReference returns whether a reference URL can be downloaded by
+// one of the file transfers.
+//
+// Azure Blob Storage URLs need the storage account's credentials, so
+// they are left to the user process like s3:// and gs:// references.
+func SupportsReference(ref string) bool {
+	u, err := url.Parse(ref)
+	if err != nil {
+		return false
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "hdfs", "webhdfs", "swebhdfs", "webdav", "webdavs", "oci", "file":
+		return true
+	case "http", "https":
+		return !strings.HasSuffix(u.Hostname(), ".blob.core.windows.net")
+	default:
+		return false
+	}
 }
@@ -0,0 +1,54 @@
+package filetransfer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+)
+
+func TestFileTransfers_GetFileTransferForReference(t *testing.T) {
+	defaultFT := &filetransfer.DefaultFileTransfer{}
+	httpFT := &filetransfer.HTTPReferenceFileTransfer{}
+	webDAVFT := &filetransfer.WebDAVFileTransfer{}
+	ociFT := &filetransfer.OCIFileTransfer{}
+	localFT := &filetransfer.LocalFileTransfer{}
+	fts := &filetransfer.FileTransfers{
+		Default:       defaultFT,
+		HTTPReference: httpFT,
+		WebDAV:        webDAVFT,
+		OCI:           ociFT,
+		Local:         localFT,
+	}
+
+	assert.Same(t, defaultFT, fts.GetFileTransferForTask(&filetransfer.Task{Url: "https://example.com/file"}))
+	assert.Same(t, httpFT, fts.GetFileTransferForTask(&filetransfer.Task{Url: "https://example.com/file", Reference: true}))
+	assert.Same(t, webDAVFT, fts.GetFileTransferForTask(&filetransfer.Task{Url: "webdavs://cloud.example.com/remote.php/dav/file"}))
+	assert.Same(t, ociFT, fts.GetFileTransferForTask(&filetransfer.Task{Url: "oci://ghcr.io/org/model@sha256:abc", Reference: true}))
+	assert.Same(t, localFT, fts.GetFileTransferForTask(&filetransfer.Task{Url: "file:///mnt/datasets/train", Reference: true}))
+}
+
+func TestSupportsReference(t *testing.T) {
+	testCases := []struct {
+		ref       string
+		supported bool
+	}{
+		{"hdfs://namenode/data", true},
+		{"webhdfs://namenode:9870/data", true},
+		{"https://data.example.com/file", true},
+		{"http://data.example.com/file", true},
+		{"webdavs://cloud.example.com/remote.php/dav/file", true},
+		{"oci://ghcr.io/org/model@sha256:abc", true},
+		{"file:///mnt/datasets/train", true},
+		{"https://account.blob.core.windows.net/container/file", false},
+		{"s3://bucket/key", false},
+		{"gs://bucket/key", false},
+		{"::not a url", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.ref, func(t *testing.T) {
+			assert.Equal(t, tc.supported, filetransfer.SupportsReference(tc.ref))
+		})
+	}
+}
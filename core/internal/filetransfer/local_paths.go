@@ -0,0 +1,83 @@
+package filetransfer
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/wandb/wandb/core/internal/paths"
+)
+
+// SymlinkPolicy is how symbolic links found while downloading a directory
+// are handled.
+type SymlinkPolicy string
+
+const (
+	// SymlinkSkip skips links and logs a warning.
+	SymlinkSkip SymlinkPolicy = "skip"
+
+	// SymlinkPreserve recreates links locally.
+	//
+	// Only links to relative paths inside the downloaded directory are
+	// recreated; other links are skipped.
+	SymlinkPreserve SymlinkPolicy = "preserve"
+
+	// SymlinkFollow downloads the files that links point to.
+	SymlinkFollow SymlinkPolicy = "follow"
+)
+
+// ParseSymlinkPolicy returns the policy with the given name.
+//
+// An empty name selects SymlinkSkip.
+func ParseSymlinkPolicy(name string) (SymlinkPolicy, error) {
+	switch policy := SymlinkPolicy(name); policy {
+	case "":
+		return SymlinkSkip, nil
+	case SymlinkSkip, SymlinkPreserve, SymlinkFollow:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown symlink policy %q", name)
+	}
+}
+
+// LocalPathUnder returns the local path for a slash-separated object name
+// in a downloaded directory.
+//
+// It returns an error if the name is absolute or contains ".." components
+// that would place it outside the directory.
+func LocalPathUnder(dir string, name string) (string, error) {
+	relPath, err := paths.Relative(filepath.FromSlash(name))
+	if err != nil || !relPath.IsLocal() {
+		return "", fmt.Errorf("file transfer: unsafe object name %q", name)
+	}
+	return filepath.Join(dir, string(*relPath)), nil
+}
+
+// isLinkUnder reports whether a relative link target, resolved from the
+// link's directory, is the directory or inside it.
+//
+// Both directories are resolved with filepath.EvalSymlinks first, so that
+// existing links along the way can't make the target escape the directory.
+func isLinkUnder(dir string, linkPath string, target string) bool {
+	if filepath.IsAbs(target) {
+		return false
+	}
+
+	realDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return false
+	}
+	realLinkDir, err := filepath.EvalSymlinks(filepath.Dir(linkPath))
+	if err != nil {
+		return false
+	}
+
+	return isUnder(realDir, filepath.Join(realLinkDir, target))
+}
+
+// isUnder reports whether a path is the directory or inside it.
+//
+// The check is lexical and doesn't resolve symbolic links.
+func isUnder(dir string, path string) bool {
+	relPath, err := filepath.Rel(dir, path)
+	return err == nil && filepath.IsLocal(relPath)
+}
@@ -0,0 +1,52 @@
+package filetransfer_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+)
+
+func TestLocalPathUnder(t *testing.T) {
+	dir := filepath.Join("downloads", "artifact")
+
+	testCases := []struct {
+		name     string
+		expected string
+		wantErr  bool
+	}{
+		{"file.txt", filepath.Join(dir, "file.txt"), false},
+		{"sub/dir/file.txt", filepath.Join(dir, "sub", "dir", "file.txt"), false},
+		{"sub/../file.txt", filepath.Join(dir, "file.txt"), false},
+		{"../file.txt", "", true},
+		{"sub/../../file.txt", "", true},
+		{"/etc/passwd", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			localPath, err := filetransfer.LocalPathUnder(dir, tc.name)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, localPath)
+			}
+		})
+	}
+}
+
+func TestParseSymlinkPolicy(t *testing.T) {
+	policy, err := filetransfer.ParseSymlinkPolicy("")
+	assert.NoError(t, err)
+	assert.Equal(t, filetransfer.SymlinkSkip, policy)
+
+	policy, err = filetransfer.ParseSymlinkPolicy("follow")
+	assert.NoError(t, err)
+	assert.Equal(t, filetransfer.SymlinkFollow, policy)
+
+	_, err = filetransfer.ParseSymlinkPolicy("mangle")
+	assert.Error(t, err)
+}
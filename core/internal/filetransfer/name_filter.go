@@ -0,0 +1,51 @@
+package filetransfer
+
+import (
+	"path"
+	"strings"
+)
+
+// NameFilter selects files by name when a reference expands to many files.
+type NameFilter struct {
+	// Include are glob patterns for files to include.
+	//
+	// If empty, all files are included.
+	Include []string
+
+	// Exclude are glob patterns for files to skip, even if included.
+	Exclude []string
+}
+
+// IsEmpty reports whether the filter includes every file.
+func (f NameFilter) IsEmpty() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0
+}
+
+// Match reports whether the filter selects a file.
+//
+// The name is the file's slash-separated path relative to the reference.
+// Patterns without a "/" are matched against the last element of the
+// name, and others against the whole name.
+func (f NameFilter) Match(name string) bool {
+	if matchesAnyGlob(f.Exclude, name) {
+		return false
+	}
+	return len(f.Include) == 0 || matchesAnyGlob(f.Include, name)
+}
+
+// matchesAnyGlob reports whether any of the patterns matches the name.
+//
+// Malformed patterns match nothing.
+func matchesAnyGlob(globs []string, name string) bool {
+	base := path.Base(name)
+	for _, glob := range globs {
+		target := name
+		if !strings.Contains(glob, "/") {
+			target = base
+		}
+		if matched, _ := path.Match(glob, target); matched {
+			return true
+		}
+	}
+	return false
+}
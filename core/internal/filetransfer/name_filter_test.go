@@ -0,0 +1,47 @@
+package filetransfer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+)
+
+func TestNameFilter_Match(t *testing.T) {
+	testCases := []struct {
+		name     string
+		filter   filetransfer.NameFilter
+		file     string
+		expected bool
+	}{
+		{"empty filter", filetransfer.NameFilter{}, "sub/a.bin", true},
+		{"include base name", filetransfer.NameFilter{
+			Include: []string{"*.bin"},
+		}, "sub/a.bin", true},
+		{"not included", filetransfer.NameFilter{
+			Include: []string{"*.bin"},
+		}, "sub/a.txt", false},
+		{"include path", filetransfer.NameFilter{
+			Include: []string{"shard-*/*.bin"},
+		}, "shard-1/a.bin", true},
+		{"path pattern needs whole name", filetransfer.NameFilter{
+			Include: []string{"shard-*/*.bin"},
+		}, "data/shard-1/a.bin", false},
+		{"excluded", filetransfer.NameFilter{
+			Exclude: []string{"*.tmp"},
+		}, "a.tmp", false},
+		{"exclude takes precedence", filetransfer.NameFilter{
+			Include: []string{"shard-*"},
+			Exclude: []string{"shard-2"},
+		}, "shard-2", false},
+		{"malformed pattern", filetransfer.NameFilter{
+			Include: []string{"["},
+		}, "a.bin", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.filter.Match(tc.file))
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package filetransfer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// snapshotFile copies a file into a directory and returns the copy's
+// path.
+//
+// Where the filesystem supports it, the copy is a reflink that shares
+// storage with the original until either changes. Otherwise, the file's
+// contents are copied.
+func snapshotFile(path string, dir string) (string, error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = src.Close() }()
+
+	if dir != "" {
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return "", err
+		}
+	}
+	dst, err := os.CreateTemp(dir, "snapshot-*-"+filepath.Base(path))
+	if err != nil {
+		return "", err
+	}
+
+	if err = cloneFile(dst, src); err != nil {
+		_, err = io.Copy(dst, src)
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(dst.Name())
+		return "", fmt.Errorf("file transfer: failed to snapshot %s: %v", path, err)
+	}
+
+	return dst.Name(), nil
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+
+package filetransfer
+
+import (
+	"errors"
+	"os"
+)
+
+// cloneFile reflinks files, which is only supported on Linux.
+func cloneFile(dst *os.File, src *os.File) error {
+	return errors.ErrUnsupported
+}
@@ -0,0 +1,41 @@
+package filetransfer
+
+import "sync"
+
+// taskURL is a task's URL, which may be refreshed while the task's byte
+// ranges are downloaded concurrently.
+type taskURL struct {
+	mu   sync.Mutex
+	task *Task
+}
+
+func newTaskURL(task *Task) *taskURL {
+	return &taskURL{task: task}
+}
+
+// get returns the task's current URL.
+func (u *taskURL) get() string {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.task.Url
+}
+
+// refresh replaces an expired URL using the task's RefreshURL callback.
+//
+// If the URL was already refreshed by another request, the new URL is
+// returned without refreshing it again.
+func (u *taskURL) refresh(expiredURL string) (string, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.task.Url != expiredURL {
+		return u.task.Url, nil
+	}
+
+	freshURL, err := u.task.RefreshURL()
+	if err != nil {
+		return "", err
+	}
+	u.task.Url = freshURL
+	return freshURL, nil
+}
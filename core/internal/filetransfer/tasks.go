@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 type TaskType int
@@ -11,6 +12,10 @@ type TaskType int
 const (
 	UploadTask TaskType = iota
 	DownloadTask
+
+	// VerifyTask checks that a remote file exists and matches its expected
+	// size and digest, without downloading it.
+	VerifyTask
 )
 
 // Task is a task to upload/download a file
@@ -18,7 +23,7 @@ type Task struct {
 	// FileKind is the category of file being uploaded or downloaded
 	FileKind RunFileKind
 
-	// Type is the type of task (upload or download)
+	// Type is the type of task (upload, download or verify)
 	Type TaskType
 
 	// Path is the local path to the file
@@ -37,11 +42,36 @@ type Task struct {
 	//
 	// If this is zero, then all bytes starting at `Offset` are uploaded; if non-zero,
 	// then that many bytes starting from `Offset` are uploaded.
+	//
+	// For verify tasks, this is the expected size of the remote file,
+	// or zero if it isn't known.
 	Size int64
 
 	// Offset is the beginning of the file segment to upload
 	Offset int64
 
+	// Digest is the expected base64-encoded MD5 of a downloaded file
+	//
+	// If set, the file is verified after it is downloaded and the task
+	// fails with ErrDigestMismatch if its contents are different.
+	//
+	// For references, this is instead the digest recorded by the
+	// reference's storage backend, such as an HTTP ETag.
+	Digest string
+
+	// Reference is whether Url is an external reference rather than
+	// a W&B storage URL
+	Reference bool
+
+	// Filter selects the files to download or verify when Url is a
+	// reference that expands to many files, such as a directory.
+	//
+	// If empty, the file transfer's default filter is used.
+	Filter NameFilter
+
+	// Verification is the result of a successful verify task.
+	Verification *VerificationReport
+
 	// Response is the http.Response from a successful upload or download request.
 	//
 	// This is nil for failed requests, or requests that have not completed.
@@ -56,8 +86,34 @@ type Task struct {
 	// ProgressCallback is a callback to execute on progress updates
 	ProgressCallback func(int, int)
 
+	// RefreshURL, if set, returns a new URL for a download whose URL
+	// has expired.
+	//
+	// Downloads refresh the URL when the server responds with 403 Forbidden,
+	// as it does for expired presigned URLs, and continue from the byte
+	// ranges that have already been downloaded.
+	RefreshURL func() (string, error)
+
 	// This can be used to cancel the file upload or download if it is no longer needed.
 	Context context.Context
+
+	// Timeout, if positive, limits how long the task may take, including
+	// retries and time spent waiting out throttling.
+	//
+	// If zero, the file transfer manager's timeout for the task's type
+	// is used.
+	Timeout time.Duration
+
+	// snapshotPath is a copy of the file to upload instead of Path, if set.
+	snapshotPath string
+}
+
+// uploadPath returns the path of the file to upload.
+func (ut *Task) uploadPath() string {
+	if ut.snapshotPath != "" {
+		return ut.snapshotPath
+	}
+	return ut.Path
 }
 
 func (ut *Task) SetProgressCallback(callback func(int, int)) {
@@ -0,0 +1,88 @@
+package filetransfer
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// DefaultThrottlePause is how long new transfers are paused after a
+// throttled response without a Retry-After header.
+const DefaultThrottlePause = DefaultRetryWaitMin
+
+// MaxThrottlePause is the longest that new transfers are paused after a
+// single throttled response, whatever its Retry-After header asks for.
+const MaxThrottlePause = DefaultRetryWaitMax
+
+// Throttle pauses new transfers while a storage backend is throttling.
+//
+// Retries only slow down the request that was throttled. When a backend
+// asks clients to slow down, other transfers would be throttled too, so
+// starting them right away only adds load.
+type Throttle struct {
+	// mu protects until.
+	mu sync.Mutex
+
+	// until is when new transfers may start again.
+	until time.Time
+
+	// now returns the current time.
+	now func() time.Time
+}
+
+// NewThrottle creates a Throttle that doesn't pause transfers until it
+// sees a throttled response.
+func NewThrottle() *Throttle {
+	return &Throttle{now: time.Now}
+}
+
+// CheckRetry wraps a retry policy to pause new transfers whenever a
+// response is throttled.
+func (t *Throttle) CheckRetry(policy retryablehttp.CheckRetry) retryablehttp.CheckRetry {
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		if resp != nil && isThrottled(resp) {
+			t.observe(resp)
+		}
+		return policy(ctx, resp, err)
+	}
+}
+
+// observe extends the pause after a throttled response.
+func (t *Throttle) observe(resp *http.Response) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	pause, ok := retryAfter(resp, now)
+	if !ok {
+		pause = DefaultThrottlePause
+	}
+	pause = min(pause, MaxThrottlePause)
+	if until := now.Add(pause); until.After(t.until) {
+		t.until = until
+	}
+}
+
+// Wait blocks until new transfers may start or the context is done.
+func (t *Throttle) Wait(ctx context.Context) error {
+	for {
+		t.mu.Lock()
+		wait := t.until.Sub(t.now())
+		t.mu.Unlock()
+
+		if wait <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
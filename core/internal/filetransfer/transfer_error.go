@@ -0,0 +1,122 @@
+package filetransfer
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"net"
+	"net/http"
+	"net/url"
+)
+
+// StatusError is an unsuccessful HTTP response to a transfer request.
+type StatusError struct {
+	// StatusCode is the response's status code.
+	StatusCode int
+
+	// Status is the response's status line, such as "404 Not Found".
+	Status string
+
+	// Throttled is whether the storage backend asked to slow down.
+	Throttled bool
+}
+
+func newStatusError(resp *http.Response) *StatusError {
+	return &StatusError{StatusCode: resp.StatusCode, Status: resp.Status}
+}
+
+func newThrottledError(resp *http.Response) *StatusError {
+	err := newStatusError(resp)
+	err.Throttled = true
+	return err
+}
+
+func (e *StatusError) Error() string {
+	return e.Status
+}
+
+// ErrorKind is a broad category of transfer failure.
+type ErrorKind string
+
+const (
+	// ErrorKindNetwork is a connection failure.
+	ErrorKindNetwork ErrorKind = "network"
+
+	// ErrorKindTimeout is a transfer that ran out of time.
+	ErrorKindTimeout ErrorKind = "timeout"
+
+	// ErrorKindCanceled is a transfer that was no longer needed.
+	ErrorKindCanceled ErrorKind = "canceled"
+
+	// ErrorKindThrottled is a storage backend asking to slow down.
+	ErrorKindThrottled ErrorKind = "throttled"
+
+	// ErrorKindServer is a server error response.
+	ErrorKindServer ErrorKind = "server"
+
+	// ErrorKindNotFound is a remote file that doesn't exist.
+	ErrorKindNotFound ErrorKind = "not_found"
+
+	// ErrorKindPermission is a request that wasn't authorized.
+	ErrorKindPermission ErrorKind = "permission"
+
+	// ErrorKindDigestMismatch is a file whose contents weren't as expected.
+	ErrorKindDigestMismatch ErrorKind = "digest_mismatch"
+
+	// ErrorKindLocal is a failure to read or write a local file.
+	ErrorKindLocal ErrorKind = "local"
+
+	// ErrorKindOther is any other failure.
+	ErrorKindOther ErrorKind = "other"
+)
+
+// ClassifyError returns the kind of a transfer error and whether trying
+// the transfer again might succeed.
+//
+// Retryable errors are those that go away on their own, such as
+// throttling, and 403 Forbidden, which is also how storage backends
+// reject expired presigned URLs.
+func ClassifyError(err error) (ErrorKind, bool) {
+	var statusErr *StatusError
+	var pathErr *fs.PathError
+	var netErr net.Error
+	var urlErr *url.Error
+
+	switch {
+	case errors.Is(err, ErrDigestMismatch):
+		return ErrorKindDigestMismatch, false
+	case errors.Is(err, context.Canceled):
+		return ErrorKindCanceled, false
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorKindTimeout, true
+	case errors.As(err, &statusErr):
+		return classifyStatus(statusErr)
+	case errors.As(err, &pathErr):
+		return ErrorKindLocal, false
+	case errors.As(err, &netErr), errors.As(err, &urlErr):
+		return ErrorKindNetwork, true
+	default:
+		return ErrorKindOther, false
+	}
+}
+
+// classifyStatus returns the kind of an error response and whether it's
+// retryable.
+func classifyStatus(err *StatusError) (ErrorKind, bool) {
+	switch {
+	case err.Throttled || err.StatusCode == http.StatusTooManyRequests:
+		return ErrorKindThrottled, true
+	case err.StatusCode == http.StatusUnauthorized:
+		return ErrorKindPermission, false
+	case err.StatusCode == http.StatusForbidden:
+		return ErrorKindPermission, true
+	case err.StatusCode == http.StatusNotFound:
+		return ErrorKindNotFound, false
+	case err.StatusCode == http.StatusRequestTimeout:
+		return ErrorKindTimeout, true
+	case err.StatusCode >= 500:
+		return ErrorKindServer, true
+	default:
+		return ErrorKindOther, false
+	}
+}
@@ -0,0 +1,87 @@
+package filetransfer_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+func TestClassifyError(t *testing.T) {
+	_, pathErr := os.Open(filepath.Join(t.TempDir(), "missing"))
+
+	testCases := []struct {
+		name      string
+		err       error
+		kind      filetransfer.ErrorKind
+		retryable bool
+	}{
+		{"digest mismatch", &filetransfer.DigestMismatchError{},
+			filetransfer.ErrorKindDigestMismatch, false},
+		{"canceled", fmt.Errorf("upload: %w", context.Canceled),
+			filetransfer.ErrorKindCanceled, false},
+		{"deadline", fmt.Errorf("upload: %w", context.DeadlineExceeded),
+			filetransfer.ErrorKindTimeout, true},
+		{"throttled", &filetransfer.StatusError{StatusCode: 403, Throttled: true},
+			filetransfer.ErrorKindThrottled, true},
+		{"too many requests", &filetransfer.StatusError{StatusCode: 429},
+			filetransfer.ErrorKindThrottled, true},
+		{"unauthorized", &filetransfer.StatusError{StatusCode: 401},
+			filetransfer.ErrorKindPermission, false},
+		{"forbidden", &filetransfer.StatusError{StatusCode: 403},
+			filetransfer.ErrorKindPermission, true},
+		{"not found", &filetransfer.StatusError{StatusCode: 404},
+			filetransfer.ErrorKindNotFound, false},
+		{"server error", &filetransfer.StatusError{StatusCode: 503},
+			filetransfer.ErrorKindServer, true},
+		{"bad request", &filetransfer.StatusError{StatusCode: 400},
+			filetransfer.ErrorKindOther, false},
+		{"local file", pathErr, filetransfer.ErrorKindLocal, false},
+		{"connection", &url.Error{Op: "Put", URL: "https://example.com", Err: errors.New("reset")},
+			filetransfer.ErrorKindNetwork, true},
+		{"other", errors.New("oops"), filetransfer.ErrorKindOther, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kind, retryable := filetransfer.ClassifyError(tc.err)
+
+			assert.Equal(t, tc.kind, kind)
+			assert.Equal(t, tc.retryable, retryable)
+		})
+	}
+}
+
+func TestDefaultFileTransfer_UploadStatusError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	ft := filetransfer.NewDefaultFileTransfer(
+		impatientClient(),
+		observability.NewNoOpLogger(),
+		filetransfer.NewFileTransferStats(),
+	)
+	path := filepath.Join(t.TempDir(), "file.txt")
+	assert.NoError(t, os.WriteFile(path, []byte("content"), 0o644))
+
+	err := ft.Upload(&filetransfer.Task{
+		Type: filetransfer.UploadTask,
+		Path: path,
+		Url:  server.URL,
+	})
+
+	var statusErr *filetransfer.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusNotFound, statusErr.StatusCode)
+	assert.EqualError(t, err, "file transfer: upload: failed to upload: 404 Not Found")
+}
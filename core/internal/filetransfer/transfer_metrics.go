@@ -0,0 +1,85 @@
+package filetransfer
+
+import (
+	"sync/atomic"
+)
+
+// TransferMetrics counts the work done by a file transfer manager.
+//
+// Unlike FileTransferStats, which tracks the progress of run files for
+// the user, these describe the manager itself: how much it's moving and
+// how much work is waiting.
+type TransferMetrics struct {
+	uploadedBytes   atomic.Int64
+	downloadedBytes atomic.Int64
+
+	queued   atomic.Int64
+	inFlight atomic.Int64
+	failed   atomic.Int64
+}
+
+// TransferMetricsSnapshot is the state of TransferMetrics at one time.
+type TransferMetricsSnapshot struct {
+	// UploadedBytes is the total number of bytes uploaded.
+	UploadedBytes int64
+
+	// DownloadedBytes is the total number of bytes downloaded.
+	DownloadedBytes int64
+
+	// Queued is the number of tasks waiting to start.
+	Queued int64
+
+	// InFlight is the number of tasks in progress.
+	InFlight int64
+
+	// Failed is the total number of tasks that failed.
+	Failed int64
+}
+
+func NewTransferMetrics() *TransferMetrics {
+	return &TransferMetrics{}
+}
+
+// Snapshot returns the current values of the metrics.
+//
+// The values are read separately, so they may be slightly out of sync.
+func (m *TransferMetrics) Snapshot() TransferMetricsSnapshot {
+	return TransferMetricsSnapshot{
+		UploadedBytes:   m.uploadedBytes.Load(),
+		DownloadedBytes: m.downloadedBytes.Load(),
+		Queued:          m.queued.Load(),
+		InFlight:        m.inFlight.Load(),
+		Failed:          m.failed.Load(),
+	}
+}
+
+// trackProgress wraps the task's progress callback to count the bytes
+// it transfers.
+//
+// Progress that goes backward, as when a request is retried, isn't
+// counted again.
+func (m *TransferMetrics) trackProgress(task *Task) {
+	bytes := &m.uploadedBytes
+	if task.Type != UploadTask {
+		bytes = &m.downloadedBytes
+	}
+
+	callback := task.ProgressCallback
+	var counted atomic.Int64
+	task.ProgressCallback = func(processed int, total int) {
+		for {
+			prev := counted.Load()
+			if int64(processed) <= prev {
+				break
+			}
+			if counted.CompareAndSwap(prev, int64(processed)) {
+				bytes.Add(int64(processed) - prev)
+				break
+			}
+		}
+
+		if callback != nil {
+			callback(processed, total)
+		}
+	}
+}
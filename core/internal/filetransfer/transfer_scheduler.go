@@ -0,0 +1,87 @@
+package filetransfer
+
+import (
+	"net/url"
+	"sync"
+)
+
+// transferScheduler limits the number of transfers in progress.
+//
+// It enforces a cap on the total number of transfers and an optional cap
+// on the number of transfers to any single host, so that a large artifact
+// doesn't open thousands of connections to one bucket.
+type transferScheduler struct {
+	// global has a slot for every transfer allowed to run at once.
+	global chan struct{}
+
+	// perHostLimit is the maximum number of transfers to a single host,
+	// or 0 if there is no limit.
+	perHostLimit int
+
+	// mu protects hosts.
+	mu sync.Mutex
+
+	// hosts has a semaphore for every host that has had a transfer.
+	hosts map[string]chan struct{}
+}
+
+func newTransferScheduler(limit, perHostLimit int) *transferScheduler {
+	if limit <= 0 {
+		limit = DefaultConcurrencyLimit
+	}
+	if perHostLimit < 0 {
+		perHostLimit = 0
+	}
+
+	return &transferScheduler{
+		global:       make(chan struct{}, limit),
+		perHostLimit: perHostLimit,
+		hosts:        make(map[string]chan struct{}),
+	}
+}
+
+// acquireGlobal blocks until the total number of transfers is below
+// the limit and reserves a slot.
+func (s *transferScheduler) acquireGlobal() {
+	s.global <- struct{}{}
+}
+
+// releaseGlobal frees a slot reserved by acquireGlobal.
+func (s *transferScheduler) releaseGlobal() {
+	<-s.global
+}
+
+// acquireHost blocks until the number of transfers to the task's host is
+// below the limit and reserves a slot.
+//
+// Returns a function to release the slot.
+func (s *transferScheduler) acquireHost(task *Task) func() {
+	if s.perHostLimit == 0 {
+		return func() {}
+	}
+
+	semaphore := s.hostSemaphore(taskHost(task))
+	semaphore <- struct{}{}
+	return func() { <-semaphore }
+}
+
+func (s *transferScheduler) hostSemaphore(host string) chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	semaphore, ok := s.hosts[host]
+	if !ok {
+		semaphore = make(chan struct{}, s.perHostLimit)
+		s.hosts[host] = semaphore
+	}
+	return semaphore
+}
+
+// taskHost returns the host a task transfers to or from.
+func taskHost(task *Task) string {
+	u, err := url.Parse(task.Url)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
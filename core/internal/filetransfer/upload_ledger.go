@@ -0,0 +1,74 @@
+package filetransfer
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// uploadLedger remembers what the manager has uploaded so that files
+// that haven't changed aren't uploaded to the same place again.
+//
+// Uploads are keyed by their destination, which is the upload URL
+// without its query string since signed URLs differ between requests
+// for the same object.
+type uploadLedger struct {
+	// mu protects digests.
+	mu sync.Mutex
+
+	// digests are the base64-encoded MD5s of the last successful upload
+	// to each destination
+	digests map[string]string
+}
+
+func newUploadLedger() *uploadLedger {
+	return &uploadLedger{digests: make(map[string]string)}
+}
+
+// uploadDestination returns where a task uploads to, or an empty string
+// if the task uploads only part of a file and can't be deduplicated.
+func uploadDestination(task *Task) string {
+	if task.Offset != 0 || task.Size != 0 {
+		return ""
+	}
+
+	u, err := url.Parse(task.Url)
+	if err != nil {
+		return ""
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
+
+// uploadDigest returns the task's Content-MD5 header, or an empty string
+// if it doesn't set one.
+//
+// The file isn't hashed here: that would read it twice, and the digest
+// could be stale by the time the file is uploaded. The backend rejects
+// uploads that don't match their Content-MD5, so a recorded header always
+// describes what was stored.
+func uploadDigest(task *Task) string {
+	for _, header := range task.Headers {
+		name, value, _ := strings.Cut(header, ":")
+		if strings.EqualFold(strings.TrimSpace(name), "Content-MD5") {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+// has returns whether the digest was the last one uploaded to the
+// destination.
+func (l *uploadLedger) has(destination string, digest string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.digests[destination] == digest
+}
+
+// record notes a successful upload.
+func (l *uploadLedger) record(destination string, digest string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.digests[destination] = digest
+}
@@ -0,0 +1,92 @@
+package filetransfer
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Verifier is implemented by file transfers that can check a remote file
+// without downloading it.
+type Verifier interface {
+	// Verify checks that the task's remote file exists and matches the
+	// task's Size and Digest.
+	//
+	// A file that is missing or doesn't match is reported in the returned
+	// report rather than as an error. Errors are returned if the file's
+	// metadata can't be fetched.
+	Verify(task *Task) (*VerificationReport, error)
+}
+
+// VerificationReport describes a remote file checked by a verify task.
+type VerificationReport struct {
+	// Exists is whether the remote file was found.
+	Exists bool
+
+	// Size is the remote file's size in bytes, or -1 if it's unknown.
+	//
+	// For directories, this is the total size of the files inside.
+	Size int64
+
+	// Version is the remote file's ETag or similar, if it has one.
+	Version string
+
+	// Files is the number of files checked, which is more than one for
+	// directories.
+	Files int
+
+	// Problems describes every way in which the file doesn't match the
+	// task's expectations.
+	Problems []string
+}
+
+// OK reports whether the remote file exists and matches expectations.
+func (r *VerificationReport) OK() bool {
+	return r.Exists && len(r.Problems) == 0
+}
+
+// check compares the report to the task's expected size and digest.
+//
+// The digest is only compared if the remote file has a version.
+func (r *VerificationReport) check(task *Task) {
+	if !r.Exists {
+		r.Problems = append(r.Problems, "file does not exist")
+		return
+	}
+
+	if task.Size > 0 && r.Size >= 0 && r.Size != task.Size {
+		r.Problems = append(r.Problems,
+			fmt.Sprintf("size is %d bytes, expected %d", r.Size, task.Size))
+	}
+
+	if task.Digest != "" && r.Version != "" && r.Version != task.Digest {
+		r.Problems = append(r.Problems,
+			fmt.Sprintf("version is %s, expected %s", r.Version, task.Digest))
+	}
+}
+
+// verifyResponse creates a report from the response to a HEAD request.
+func verifyResponse(task *Task, resp *http.Response) (*VerificationReport, error) {
+	_ = resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound ||
+		resp.StatusCode == http.StatusGone {
+		report := &VerificationReport{Size: -1}
+		report.check(task)
+		return report, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf(
+			"file transfer: verify: failed to get metadata: %s",
+			resp.Status,
+		)
+	}
+
+	report := &VerificationReport{
+		Exists:  true,
+		Size:    resp.ContentLength,
+		Version: responseVersion(resp),
+		Files:   1,
+	}
+	report.check(task)
+	return report, nil
+}
@@ -2,6 +2,9 @@
 package filetransfertest
 
 import (
+	"bytes"
+	"fmt"
+	"io"
 	"slices"
 	"sync"
 
@@ -15,6 +18,9 @@ type FakeFileTransferManager struct {
 
 	// Whether new tasks should be completed immediately.
 	ShouldCompleteImmediately bool
+
+	// Contents returned by `DownloadStream` by URL.
+	StreamContents map[string][]byte
 }
 
 func NewFakeFileTransferManager() *FakeFileTransferManager {
@@ -64,3 +70,13 @@ func (m *FakeFileTransferManager) AddTask(t *filetransfer.Task) {
 		m.unfinishedTasks[t] = struct{}{}
 	}
 }
+
+func (m *FakeFileTransferManager) DownloadStream(
+	t *filetransfer.Task,
+) (io.ReadCloser, error) {
+	content, ok := m.StreamContents[t.Url]
+	if !ok {
+		return nil, fmt.Errorf("filetransfertest: no stream for %v", t.Url)
+	}
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
@@ -27,6 +27,48 @@ func (v *ArtifactAliasInput) GetArtifactCollectionName() string { return v.Artif
 // GetAlias returns ArtifactAliasInput.Alias, and is useful for accessing the field via an interface.
 func (v *ArtifactAliasInput) GetAlias() string { return v.Alias }
 
+// ArtifactByNameProject includes the requested fields of the GraphQL type Project.
+type ArtifactByNameProject struct {
+	Artifact *ArtifactByNameProjectArtifact `json:"artifact"`
+}
+
+// GetArtifact returns ArtifactByNameProject.Artifact, and is useful for accessing the field via an interface.
+func (v *ArtifactByNameProject) GetArtifact() *ArtifactByNameProjectArtifact { return v.Artifact }
+
+// ArtifactByNameProjectArtifact includes the requested fields of the GraphQL type Artifact.
+type ArtifactByNameProjectArtifact struct {
+	Id               string                                        `json:"id"`
+	VersionIndex     *int                                          `json:"versionIndex"`
+	ArtifactSequence ArtifactByNameProjectArtifactArtifactSequence `json:"artifactSequence"`
+}
+
+// GetId returns ArtifactByNameProjectArtifact.Id, and is useful for accessing the field via an interface.
+func (v *ArtifactByNameProjectArtifact) GetId() string { return v.Id }
+
+// GetVersionIndex returns ArtifactByNameProjectArtifact.VersionIndex, and is useful for accessing the field via an interface.
+func (v *ArtifactByNameProjectArtifact) GetVersionIndex() *int { return v.VersionIndex }
+
+// GetArtifactSequence returns ArtifactByNameProjectArtifact.ArtifactSequence, and is useful for accessing the field via an interface.
+func (v *ArtifactByNameProjectArtifact) GetArtifactSequence() ArtifactByNameProjectArtifactArtifactSequence {
+	return v.ArtifactSequence
+}
+
+// ArtifactByNameProjectArtifactArtifactSequence includes the requested fields of the GraphQL type ArtifactSequence.
+type ArtifactByNameProjectArtifactArtifactSequence struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ArtifactByNameProjectArtifactArtifactSequence.Name, and is useful for accessing the field via an interface.
+func (v *ArtifactByNameProjectArtifactArtifactSequence) GetName() string { return v.Name }
+
+// ArtifactByNameResponse is returned by ArtifactByName on success.
+type ArtifactByNameResponse struct {
+	Project *ArtifactByNameProject `json:"project"`
+}
+
+// GetProject returns ArtifactByNameResponse.Project, and is useful for accessing the field via an interface.
+func (v *ArtifactByNameResponse) GetProject() *ArtifactByNameProject { return v.Project }
+
 // ArtifactFileURLsArtifact includes the requested fields of the GraphQL type Artifact.
 type ArtifactFileURLsArtifact struct {
 	Files ArtifactFileURLsArtifactFilesFileConnection `json:"files"`
@@ -1028,6 +1070,22 @@ func (v *ViewerViewerUserTeamsEntityConnectionEdgesEntityEdgeNodeEntity) GetName
 	return v.Name
 }
 
+// __ArtifactByNameInput is used internally by genqlient
+type __ArtifactByNameInput struct {
+	EntityName  string `json:"entityName"`
+	ProjectName string `json:"projectName"`
+	Name        string `json:"name"`
+}
+
+// GetEntityName returns __ArtifactByNameInput.EntityName, and is useful for accessing the field via an interface.
+func (v *__ArtifactByNameInput) GetEntityName() string { return v.EntityName }
+
+// GetProjectName returns __ArtifactByNameInput.ProjectName, and is useful for accessing the field via an interface.
+func (v *__ArtifactByNameInput) GetProjectName() string { return v.ProjectName }
+
+// GetName returns __ArtifactByNameInput.Name, and is useful for accessing the field via an interface.
+func (v *__ArtifactByNameInput) GetName() string { return v.Name }
+
 // __ArtifactFileURLsInput is used internally by genqlient
 type __ArtifactFileURLsInput struct {
 	Id      string  `json:"id"`
@@ -1454,6 +1512,51 @@ func (v *__UseArtifactInput) GetRunName() string { return v.RunName }
 // GetArtifactID returns __UseArtifactInput.ArtifactID, and is useful for accessing the field via an interface.
 func (v *__UseArtifactInput) GetArtifactID() string { return v.ArtifactID }
 
+// The query or mutation executed by ArtifactByName.
+const ArtifactByName_Operation = `
+query ArtifactByName ($entityName: String!, $projectName: String!, $name: String!) {
+	project(name: $projectName, entityName: $entityName) {
+		artifact(name: $name) {
+			id
+			versionIndex
+			artifactSequence {
+				name
+			}
+		}
+	}
+}
+`
+
+func ArtifactByName(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	entityName string,
+	projectName string,
+	name string,
+) (*ArtifactByNameResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "ArtifactByName",
+		Query:  ArtifactByName_Operation,
+		Variables: &__ArtifactByNameInput{
+			EntityName:  entityName,
+			ProjectName: projectName,
+			Name:        name,
+		},
+	}
+	var err_ error
+
+	var data_ ArtifactByNameResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by ArtifactFileURLs.
 const ArtifactFileURLs_Operation = `
 query ArtifactFileURLs ($id: ID!, $cursor: String, $perPage: Int) {
@@ -0,0 +1,185 @@
+// Package nonfinite handles NaN and +-Infinity in JSON-like values.
+//
+// Standard JSON has no representation for these floats. Values are
+// encoded as an extension of JSON by default, which some consumers
+// reject.
+package nonfinite
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Policy is what to do with non-finite floats.
+type Policy int
+
+const (
+	// Allow keeps non-finite floats, encoding them as extended JSON.
+	Allow Policy = iota
+
+	// Error rejects values that contain non-finite floats.
+	Error
+
+	// Drop removes non-finite floats.
+	//
+	// A non-finite float in a map is removed with its key, and one in
+	// a list is replaced by nil to keep the positions of other elements.
+	Drop
+
+	// Stringify replaces non-finite floats by the strings "NaN",
+	// "Infinity" and "-Infinity".
+	Stringify
+)
+
+// ErrNonFinite is returned by Apply under the Error policy.
+var ErrNonFinite = errors.New("nonfinite: value contains NaN or Infinity")
+
+// ParsePolicy returns the policy named by a setting.
+//
+// The empty string is Allow.
+func ParsePolicy(name string) (Policy, error) {
+	switch name {
+	case "", "allow":
+		return Allow, nil
+	case "error":
+		return Error, nil
+	case "drop":
+		return Drop, nil
+	case "stringify":
+		return Stringify, nil
+	default:
+		return Allow, fmt.Errorf("nonfinite: unknown policy %q", name)
+	}
+}
+
+// Apply returns the value with non-finite floats handled per the policy.
+//
+// Maps and lists are walked recursively. They are copied only if they
+// contain a non-finite float.
+//
+// Returns false if the value itself is non-finite and dropped.
+func Apply(value any, policy Policy) (any, bool, error) {
+	if policy == Allow {
+		return value, true, nil
+	}
+
+	result, err := apply(value, policy)
+	switch {
+	case err != nil:
+		return nil, false, err
+	case !result.isChanged:
+		return value, true, nil
+	default:
+		return result.value, !result.isDropped, nil
+	}
+}
+
+// applyResult is the outcome of handling a value.
+type applyResult struct {
+	// value is the new value, if it is changed and not dropped.
+	value any
+
+	// isChanged is whether the value needed to be changed.
+	isChanged bool
+
+	// isDropped is whether the value should be removed.
+	isDropped bool
+}
+
+func apply(value any, policy Policy) (applyResult, error) {
+	switch x := value.(type) {
+	case float64:
+		return applyFloat(x, policy)
+
+	case float32:
+		return applyFloat(float64(x), policy)
+
+	case map[string]any:
+		var clone map[string]any
+
+		for key, element := range x {
+			result, err := apply(element, policy)
+			if err != nil {
+				return applyResult{}, err
+			}
+			if !result.isChanged {
+				continue
+			}
+
+			if clone == nil {
+				clone = make(map[string]any, len(x))
+				for key, element := range x {
+					clone[key] = element
+				}
+			}
+
+			if result.isDropped {
+				delete(clone, key)
+			} else {
+				clone[key] = result.value
+			}
+		}
+
+		if clone == nil {
+			return applyResult{value: x}, nil
+		}
+		return applyResult{value: clone, isChanged: true}, nil
+
+	case []any:
+		var clone []any
+
+		for i, element := range x {
+			result, err := apply(element, policy)
+			if err != nil {
+				return applyResult{}, err
+			}
+			if !result.isChanged {
+				continue
+			}
+
+			if clone == nil {
+				clone = make([]any, len(x))
+				copy(clone, x)
+			}
+
+			// Dropped elements become nil.
+			clone[i] = result.value
+		}
+
+		if clone == nil {
+			return applyResult{value: x}, nil
+		}
+		return applyResult{value: clone, isChanged: true}, nil
+
+	default:
+		return applyResult{value: value}, nil
+	}
+}
+
+// applyFloat handles a single float.
+func applyFloat(f float64, policy Policy) (applyResult, error) {
+	if !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return applyResult{value: f}, nil
+	}
+
+	switch policy {
+	case Error:
+		return applyResult{}, ErrNonFinite
+	case Drop:
+		return applyResult{isChanged: true, isDropped: true}, nil
+	default:
+		return applyResult{value: toString(f), isChanged: true}, nil
+	}
+}
+
+func toString(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case f > 0:
+		return "Infinity"
+	default:
+		return "-Infinity"
+	}
+}
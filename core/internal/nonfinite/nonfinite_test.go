@@ -0,0 +1,82 @@
+package nonfinite_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/nonfinite"
+)
+
+func testValue() map[string]any {
+	return map[string]any{
+		"ok":   1.5,
+		"nan":  math.NaN(),
+		"list": []any{math.Inf(1), "x"},
+		"sub":  map[string]any{"neg": math.Inf(-1)},
+	}
+}
+
+func TestApply_Allow(t *testing.T) {
+	value := testValue()
+
+	result, keep, err := nonfinite.Apply(value, nonfinite.Allow)
+
+	assert.NoError(t, err)
+	assert.True(t, keep)
+	assert.True(t, math.IsNaN(result.(map[string]any)["nan"].(float64)))
+}
+
+func TestApply_Error(t *testing.T) {
+	_, _, err := nonfinite.Apply(testValue(), nonfinite.Error)
+
+	assert.ErrorIs(t, err, nonfinite.ErrNonFinite)
+}
+
+func TestApply_Drop(t *testing.T) {
+	value := testValue()
+
+	result, keep, err := nonfinite.Apply(value, nonfinite.Drop)
+
+	assert.NoError(t, err)
+	assert.True(t, keep)
+	assert.Equal(t,
+		map[string]any{
+			"ok":   1.5,
+			"list": []any{nil, "x"},
+			"sub":  map[string]any{},
+		},
+		result)
+	assert.Contains(t, value, "nan", "input was modified")
+}
+
+func TestApply_DropLeaf(t *testing.T) {
+	_, keep, err := nonfinite.Apply(math.NaN(), nonfinite.Drop)
+
+	assert.NoError(t, err)
+	assert.False(t, keep)
+}
+
+func TestApply_Stringify(t *testing.T) {
+	result, keep, err := nonfinite.Apply(testValue(), nonfinite.Stringify)
+
+	assert.NoError(t, err)
+	assert.True(t, keep)
+	assert.Equal(t,
+		map[string]any{
+			"ok":   1.5,
+			"nan":  "NaN",
+			"list": []any{"Infinity", "x"},
+			"sub":  map[string]any{"neg": "-Infinity"},
+		},
+		result)
+}
+
+func TestParsePolicy(t *testing.T) {
+	policy, err := nonfinite.ParsePolicy("stringify")
+	assert.NoError(t, err)
+	assert.Equal(t, nonfinite.Stringify, policy)
+
+	_, err = nonfinite.ParsePolicy("bogus")
+	assert.Error(t, err)
+}
@@ -0,0 +1,200 @@
+package pathtree
+
+import (
+	"reflect"
+)
+
+// Kind is the type of a leaf value.
+type Kind int
+
+const (
+	// KindUnsupported is for values that aren't JSON-like, such as
+	// structs and channels.
+	KindUnsupported Kind = iota
+
+	KindNull
+	KindBool
+	KindInt
+	KindFloat
+	KindString
+
+	// KindList is for slices, whose elements may be of any kind.
+	KindList
+
+	// KindMap is for string-keyed maps, which only appear as leaves
+	// inside lists.
+	KindMap
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindNull:
+		return "null"
+	case KindBool:
+		return "bool"
+	case KindInt:
+		return "int"
+	case KindFloat:
+		return "float"
+	case KindString:
+		return "string"
+	case KindList:
+		return "list"
+	case KindMap:
+		return "map"
+	default:
+		return "unsupported"
+	}
+}
+
+// Leaf is a leaf value together with its kind.
+//
+// Scalars are normalized to int64, float64, string and bool, so that
+// consumers don't need to handle every numeric or named type.
+type Leaf struct {
+	kind  Kind
+	value any
+}
+
+// LeafOf classifies a value.
+//
+// Lists and maps are not copied or checked recursively; use AsList and
+// AsMap to inspect their elements.
+func LeafOf(value any) Leaf {
+	switch x := value.(type) {
+	case nil:
+		return Leaf{KindNull, nil}
+	case bool:
+		return Leaf{KindBool, x}
+	case int64:
+		return Leaf{KindInt, x}
+	case float64:
+		return Leaf{KindFloat, x}
+	case string:
+		return Leaf{KindString, x}
+	case []any:
+		return Leaf{KindList, x}
+	case map[string]any:
+		return Leaf{KindMap, x}
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Bool:
+		return Leaf{KindBool, rv.Bool()}
+
+	case reflect.String:
+		return Leaf{KindString, rv.String()}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Leaf{KindInt, rv.Int()}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if rv.Uint() > uint64(1<<63-1) {
+			return Leaf{KindUnsupported, value}
+		}
+		return Leaf{KindInt, int64(rv.Uint())}
+
+	case reflect.Float32, reflect.Float64:
+		return Leaf{KindFloat, rv.Float()}
+
+	case reflect.Slice:
+		return Leaf{KindList, value}
+
+	case reflect.Map:
+		if rv.Type().Key().Kind() == reflect.String {
+			return Leaf{KindMap, value}
+		}
+	}
+
+	return Leaf{KindUnsupported, value}
+}
+
+// Kind returns the leaf's kind.
+func (l Leaf) Kind() Kind {
+	return l.kind
+}
+
+// Any returns the leaf's value, normalized if it is a scalar.
+func (l Leaf) Any() any {
+	return l.value
+}
+
+// AsBool returns the leaf's value if it is a bool.
+func (l Leaf) AsBool() (bool, bool) {
+	x, ok := l.value.(bool)
+	return x, ok
+}
+
+// AsInt returns the leaf's value if it is an integer.
+func (l Leaf) AsInt() (int64, bool) {
+	x, ok := l.value.(int64)
+	return x, ok
+}
+
+// AsFloat returns the leaf's value if it is a number.
+//
+// Integers are converted to float64, which may lose precision.
+func (l Leaf) AsFloat() (float64, bool) {
+	switch x := l.value.(type) {
+	case float64:
+		return x, true
+	case int64:
+		return float64(x), true
+	default:
+		return 0, false
+	}
+}
+
+// AsString returns the leaf's value if it is a string.
+func (l Leaf) AsString() (string, bool) {
+	x, ok := l.value.(string)
+	return x, ok
+}
+
+// AsList returns the elements of the leaf's value if it is a list.
+func (l Leaf) AsList() ([]Leaf, bool) {
+	if l.kind != KindList {
+		return nil, false
+	}
+
+	rv := reflect.ValueOf(l.value)
+	elements := make([]Leaf, rv.Len())
+	for i := range elements {
+		elements[i] = LeafOf(rv.Index(i).Interface())
+	}
+	return elements, true
+}
+
+// AsMap returns the entries of the leaf's value if it is a map.
+func (l Leaf) AsMap() (map[string]Leaf, bool) {
+	if l.kind != KindMap {
+		return nil, false
+	}
+
+	rv := reflect.ValueOf(l.value)
+	entries := make(map[string]Leaf, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		entries[iter.Key().String()] = LeafOf(iter.Value().Interface())
+	}
+	return entries, true
+}
+
+// GetTypedLeaf returns the leaf at the path with its kind.
+//
+// Returns false if there is no leaf at the path.
+func (pt *PathTree) GetTypedLeaf(path TreePath) (Leaf, bool) {
+	value, exists := pt.GetLeaf(path)
+	if !exists {
+		return Leaf{}, false
+	}
+	return LeafOf(value), true
+}
+
+// ForEachTypedLeaf is like ForEachLeaf but classifies each value.
+func (pt *PathTree) ForEachTypedLeaf(fn func(path TreePath, leaf Leaf) bool) {
+	pt.ForEachLeaf(func(path TreePath, value any) bool {
+		return fn(path, LeafOf(value))
+	})
+}
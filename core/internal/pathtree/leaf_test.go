@@ -0,0 +1,68 @@
+package pathtree_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/pathtree"
+)
+
+func TestLeafOf_NormalizesScalars(t *testing.T) {
+	type label string
+
+	testCases := []struct {
+		value any
+		kind  pathtree.Kind
+		want  any
+	}{
+		{nil, pathtree.KindNull, nil},
+		{true, pathtree.KindBool, true},
+		{int32(3), pathtree.KindInt, int64(3)},
+		{uint8(4), pathtree.KindInt, int64(4)},
+		{float32(0.5), pathtree.KindFloat, 0.5},
+		{label("x"), pathtree.KindString, "x"},
+		{[]string{"a"}, pathtree.KindList, []string{"a"}},
+		{map[string]int{"a": 1}, pathtree.KindMap, map[string]int{"a": 1}},
+		{uint64(1 << 63), pathtree.KindUnsupported, uint64(1 << 63)},
+		{struct{}{}, pathtree.KindUnsupported, struct{}{}},
+	}
+
+	for _, tc := range testCases {
+		leaf := pathtree.LeafOf(tc.value)
+
+		assert.Equal(t, tc.kind, leaf.Kind(), "kind of %#v", tc.value)
+		assert.Equal(t, tc.want, leaf.Any(), "value of %#v", tc.value)
+	}
+}
+
+func TestLeaf_Accessors(t *testing.T) {
+	intLeaf := pathtree.LeafOf(7)
+	x, ok := intLeaf.AsFloat()
+	assert.True(t, ok)
+	assert.Equal(t, 7.0, x)
+	_, ok = intLeaf.AsString()
+	assert.False(t, ok)
+
+	elements, ok := pathtree.LeafOf([]any{1, "a"}).AsList()
+	assert.True(t, ok)
+	assert.Equal(t,
+		[]pathtree.Kind{pathtree.KindInt, pathtree.KindString},
+		[]pathtree.Kind{elements[0].Kind(), elements[1].Kind()})
+
+	entries, ok := pathtree.LeafOf(map[string]any{"a": nil}).AsMap()
+	assert.True(t, ok)
+	assert.Equal(t, pathtree.KindNull, entries["a"].Kind())
+}
+
+func TestGetTypedLeaf(t *testing.T) {
+	tree := pathtree.New()
+	tree.Set(pathtree.PathOf("a", "b"), int32(5))
+
+	leaf, exists := tree.GetTypedLeaf(pathtree.PathOf("a", "b"))
+	_, existsAbove := tree.GetTypedLeaf(pathtree.PathOf("a"))
+
+	assert.True(t, exists)
+	assert.False(t, existsAbove)
+	assert.Equal(t, pathtree.KindInt, leaf.Kind())
+	assert.Equal(t, int64(5), leaf.Any())
+}
@@ -0,0 +1,157 @@
+package pathtree
+
+import (
+	"slices"
+	"strings"
+)
+
+// PreserveOrder makes serialization list keys in the order they were
+// first added instead of sorting them.
+//
+// Keys that are already in the tree are ordered by name. Removing a key
+// forgets its position, so adding it again puts it last.
+func (pt *PathTree) PreserveOrder() {
+	if pt.order != nil {
+		return
+	}
+
+	pt.order = make(map[string]int64)
+	pt.recordExistingOrder(pt.tree, nil)
+}
+
+// recordExistingOrder records the nodes in a subtree by name.
+func (pt *PathTree) recordExistingOrder(tree treeData, prefix []string) {
+	for _, key := range sortedKeys(tree) {
+		path := append(slices.Clone(prefix), key)
+		pt.recordOrder(path)
+
+		if subtree, ok := tree[key].(treeData); ok {
+			pt.recordExistingOrder(subtree, path)
+		}
+	}
+}
+
+// recordOrder records the path and its ancestors if they are new.
+func (pt *PathTree) recordOrder(labels []string) {
+	if pt.order == nil {
+		return
+	}
+
+	for i := range labels {
+		key := pathKey(labels[:i+1])
+		if _, exists := pt.order[key]; !exists {
+			pt.order[key] = pt.nextOrder
+			pt.nextOrder++
+		}
+	}
+}
+
+// forgetOrder forgets the path and its descendants.
+func (pt *PathTree) forgetOrder(labels []string) {
+	if pt.order == nil {
+		return
+	}
+
+	key := pathKey(labels)
+	delete(pt.order, key)
+
+	for other := range pt.order {
+		if strings.HasPrefix(other, key+pathSeparator) {
+			delete(pt.order, other)
+		}
+	}
+}
+
+// Filter returns a copy of the tree with only the leaves for which keep
+// returns true.
+//
+// The copy preserves insertion order if the tree does. Leaf values are
+// copied by reference.
+func (pt *PathTree) Filter(keep func(path TreePath) bool) *PathTree {
+	filtered := New()
+	if pt.order != nil {
+		filtered.order = make(map[string]int64)
+		filtered.nextOrder = pt.nextOrder
+	}
+
+	_ = forEachLeaf(pt.tree, nil, func(path TreePath, value any) bool {
+		if !keep(path) {
+			return true
+		}
+
+		filtered.getOrMakeSubtree(path.Prefix())[path.End()] = value
+
+		if pt.order != nil {
+			labels := path.Labels()
+			for i := range labels {
+				key := pathKey(labels[:i+1])
+				filtered.order[key] = pt.order[key]
+			}
+		}
+
+		return true
+	})
+
+	return filtered
+}
+
+// keysOf returns the keys of a subtree in serialization order.
+//
+// Keys are sorted, or listed in insertion order if PreserveOrder was
+// called. The prefix is the path to the subtree.
+func (pt *PathTree) keysOf(tree treeData, prefix []string) []string {
+	if pt.order == nil {
+		return sortedKeys(tree)
+	}
+
+	keys := make([]string, 0, len(tree))
+	orders := make(map[string]int64, len(tree))
+	recorded := make(map[string]bool, len(tree))
+
+	path := slices.Clone(prefix)
+	for key := range tree {
+		keys = append(keys, key)
+		orders[key], recorded[key] = pt.order[pathKey(append(path, key))]
+	}
+
+	slices.SortFunc(keys, func(a, b string) int {
+		orderA, okA := orders[a], recorded[a]
+		orderB, okB := orders[b], recorded[b]
+
+		switch {
+		case okA && okB && orderA != orderB:
+			if orderA < orderB {
+				return -1
+			}
+			return 1
+		case okA != okB:
+			// Unrecorded keys go last.
+			if okA {
+				return -1
+			}
+			return 1
+		default:
+			return strings.Compare(a, b)
+		}
+	})
+
+	return keys
+}
+
+// sortedKeys returns the keys of a map in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// pathSeparator joins labels in a pathKey.
+const pathSeparator = "\x00"
+
+// pathKey returns a string that uniquely identifies a path.
+func pathKey(labels []string) string {
+	return strings.Join(labels, pathSeparator)
+}
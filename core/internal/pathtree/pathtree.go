@@ -1,9 +1,5 @@
 package pathtree
 
-import (
-	"github.com/wandb/simplejsonext"
-)
-
 // TreePath is the list of node labels along the path from the root
 // of a PathTree to a node.
 type TreePath struct {
@@ -81,6 +77,13 @@ func (p TreePath) End() string {
 // If the leaves are JSON values, then this is essentially a JSON object.
 type PathTree struct {
 	tree treeData
+
+	// order is when each node was added, by pathKey, if insertion order
+	// is preserved.
+	order map[string]int64
+
+	// nextOrder is the order of the next node to add.
+	nextOrder int64
 }
 
 // treeData is an internal representation for a nested key-value pair.
@@ -97,7 +100,7 @@ type PathItem struct {
 }
 
 func New() *PathTree {
-	return &PathTree{make(treeData)}
+	return &PathTree{tree: make(treeData)}
 }
 
 // CloneTree returns a nested-map representation of the tree.
@@ -117,6 +120,7 @@ func (pt *PathTree) CloneTree() map[string]any {
 // If path refers to a non-leaf node, that node is replaced by a leaf
 // and the subtree is discarded.
 func (pt *PathTree) Set(path TreePath, value any) {
+	pt.recordOrder(path.Labels())
 	subtree := pt.getOrMakeSubtree(path.Prefix())
 	subtree[path.End()] = value
 }
@@ -128,8 +132,11 @@ func (pt *PathTree) Set(path TreePath, value any) {
 // tree.
 func (pt *PathTree) SetSubtree(path TreePath, subtree map[string]any) {
 	// TODO: this is inefficient---it has repeated getOrMakeSubtree calls
-	for key, value := range subtree {
-		switch x := value.(type) {
+	//
+	// Keys are visited in sorted order so that their insertion order is
+	// deterministic.
+	for _, key := range sortedKeys(subtree) {
+		switch x := subtree[key].(type) {
 		case map[string]any:
 			pt.SetSubtree(path.With(key), x)
 		default:
@@ -146,6 +153,7 @@ func (pt *PathTree) Remove(path TreePath) {
 	}
 
 	delete(subtree, path.End())
+	pt.forgetOrder(path.Labels())
 
 	// Remove from parents to avoid keeping around empty maps.
 	for len(subtree) == 0 {
@@ -157,6 +165,7 @@ func (pt *PathTree) Remove(path TreePath) {
 
 		subtree = pt.getSubtree(path.Prefix())
 		delete(subtree, path.End())
+		pt.forgetOrder(path.Labels())
 	}
 }
 
@@ -188,6 +197,29 @@ func (pt *PathTree) GetLeaf(path TreePath) (any, bool) {
 	}
 }
 
+// Get returns the node at path.
+//
+// A subtree is returned as a nested-map copy. Returns nil and false if
+// there is no node at the path.
+func (pt *PathTree) Get(path TreePath) (any, bool) {
+	subtree := pt.getSubtree(path.Prefix())
+	if subtree == nil {
+		return nil, false
+	}
+
+	value, exists := subtree[path.End()]
+	if !exists {
+		return nil, false
+	}
+
+	switch x := value.(type) {
+	case treeData:
+		return toNestedMaps(x), true
+	default:
+		return x, true
+	}
+}
+
 // GetOrMakeLeaf returns the leaf value at path, creating one if necessary.
 func (pt *PathTree) GetOrMakeLeaf(
 	path TreePath,
@@ -197,6 +229,7 @@ func (pt *PathTree) GetOrMakeLeaf(
 
 	value, exists := subtree[path.End()]
 	if !exists {
+		pt.recordOrder(path.Labels())
 		value = makeDefault()
 		subtree[path.End()] = value
 	}
@@ -274,9 +307,10 @@ func flatten(tree treeData, prefix []string) []PathItem {
 // ToExtendedJSON encodes the tree as an extension of JSON that supports NaN
 // and +-Infinity.
 //
-// Values must be JSON-encodable.
+// Keys are sorted, or in insertion order if it is preserved, so that
+// the output is deterministic. Values must be JSON-encodable.
 func (pt *PathTree) ToExtendedJSON() ([]byte, error) {
-	return simplejsonext.Marshal(toNestedMaps(pt.tree))
+	return pt.Serialize(FormatJson, nil)
 }
 
 // getSubtree returns the subtree at the path or nil if the path doesn't lead
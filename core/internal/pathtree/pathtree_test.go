@@ -1,6 +1,8 @@
 package pathtree_test
 
 import (
+	"bytes"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -111,3 +113,121 @@ func TestFlatten(t *testing.T) {
 			Value: 3,
 		})
 }
+
+func TestSerialize_CanonicalJSON(t *testing.T) {
+	tree := pathtree.New()
+
+	tree.Set(pathtree.PathOf("z"), 1)
+	tree.Set(pathtree.PathOf("a", "y"), []any{map[string]any{"d": 2, "c": 3}})
+	tree.Set(pathtree.PathOf("a", "x"), math.Inf(1))
+	encoded, err := tree.Serialize(pathtree.FormatJson, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t,
+		`{"a":{"x":Infinity,"y":[{"c":3,"d":2}]},"z":1}`,
+		string(encoded))
+}
+
+func TestSerialize_PreserveOrder(t *testing.T) {
+	tree := pathtree.New()
+	tree.Set(pathtree.PathOf("b"), 1)
+	tree.PreserveOrder()
+
+	tree.Set(pathtree.PathOf("z", "y"), 2)
+	tree.Set(pathtree.PathOf("a"), 3)
+	tree.Set(pathtree.PathOf("z", "x"), 4)
+	tree.Set(pathtree.PathOf("c"), 5)
+	tree.Remove(pathtree.PathOf("c"))
+	tree.Set(pathtree.PathOf("b"), 6)
+
+	json, err := tree.Serialize(pathtree.FormatJson, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"b":6,"z":{"y":2,"x":4},"a":3}`, string(json))
+
+	yaml, err := tree.Serialize(pathtree.FormatYaml, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "b: 6\nz:\n    \"y\": 2\n    x: 4\na: 3\n", string(yaml))
+}
+
+func TestFilter_KeepsOrder(t *testing.T) {
+	tree := pathtree.New()
+	tree.PreserveOrder()
+	tree.Set(pathtree.PathOf("z"), 1)
+	tree.Set(pathtree.PathOf("secret"), 2)
+	tree.Set(pathtree.PathOf("a"), 3)
+
+	filtered := tree.Filter(func(path pathtree.TreePath) bool {
+		return path.End() != "secret"
+	})
+	json, err := filtered.ToExtendedJSON()
+
+	assert.NoError(t, err)
+	assert.Equal(t, `{"z":1,"a":3}`, string(json))
+}
+
+func TestToExtendedJSON_SortedKeys(t *testing.T) {
+	tree := pathtree.New()
+	tree.Set(pathtree.PathOf("b", "d"), 1)
+	tree.Set(pathtree.PathOf("b", "c"), 2)
+	tree.Set(pathtree.PathOf("a"), 3)
+
+	json, err := tree.ToExtendedJSON()
+
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":3,"b":{"c":2,"d":1}}`, string(json))
+}
+
+func TestSerializeTo(t *testing.T) {
+	tree := pathtree.New()
+	tree.Set(pathtree.PathOf("b"), []any{1, 2})
+	tree.Set(pathtree.PathOf("a", "x"), "text")
+	tree.Set(pathtree.PathOf("a", "y"), 1.5)
+
+	testCases := []struct {
+		format   pathtree.Format
+		expected string
+	}{
+		{pathtree.FormatYaml, "a:\n    x: text\n    \"y\": 1.5\nb:\n    - 1\n    - 2\n"},
+		{pathtree.FormatJson, `{"a":{"x":"text","y":1.5},"b":[1,2]}`},
+	}
+
+	for _, tc := range testCases {
+		var buf bytes.Buffer
+
+		err := tree.SerializeTo(&buf, tc.format, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, buf.String())
+	}
+}
+
+func TestSerializeTo_EmptyYaml(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := pathtree.New().SerializeTo(&buf, pathtree.FormatYaml, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "{}\n", buf.String())
+}
+
+func TestSerialize_Postprocess(t *testing.T) {
+	tree := pathtree.New()
+
+	tree.Set(pathtree.PathOf("a", "b"), 1)
+	encoded, err := tree.Serialize(pathtree.FormatYaml,
+		func(value any) any { return []any{value} })
+
+	assert.NoError(t, err)
+	assert.Equal(t, "a:\n    - b: 1\n", string(encoded))
+}
+
+func TestSerializeTo_YamlComplexKey(t *testing.T) {
+	tree := pathtree.New()
+	tree.Set(pathtree.PathOf("multi\nline", "x"), 1)
+
+	var buf bytes.Buffer
+	err := tree.SerializeTo(&buf, pathtree.FormatYaml, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "? |-\n    multi\n    line\n:   x: 1\n", buf.String())
+}
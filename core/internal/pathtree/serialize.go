@@ -0,0 +1,389 @@
+package pathtree
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/wandb/simplejsonext"
+	"gopkg.in/yaml.v3"
+)
+
+// Format is an encoding for a serialized tree.
+type Format int
+
+const (
+	FormatYaml Format = iota
+
+	// FormatJson is canonical JSON: equal trees always serialize to the
+	// same bytes.
+	//
+	// NaN and +-Infinity are supported as in ToExtendedJSON.
+	FormatJson
+)
+
+// Serialize encodes the tree in the given format.
+//
+// The output is deterministic: keys are sorted, or listed in insertion
+// order if PreserveOrder was called.
+//
+// If postprocess is not nil, it is applied to each top-level value before
+// encoding. Values must be encodable in the format.
+func (pt *PathTree) Serialize(
+	format Format,
+	postprocess func(any) any,
+) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := pt.SerializeTo(&buf, format, postprocess); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// SerializeTo is like Serialize but writes the output to w as it is
+// produced.
+//
+// The tree is walked in place rather than copied, and YAML is written
+// one entry at a time, so memory use doesn't grow with the size of the
+// tree. On error, w may have received partial output.
+//
+// Subtrees are passed to postprocess as opaque values: it may wrap them
+// in maps or lists, but not inspect them.
+func (pt *PathTree) SerializeTo(
+	w io.Writer,
+	format Format,
+	postprocess func(any) any,
+) error {
+	s := &serializer{
+		pt:          pt,
+		w:           bufio.NewWriter(w),
+		postprocess: postprocess,
+	}
+	root := subtree{tree: pt.tree}
+
+	var err error
+	switch format {
+	case FormatYaml:
+		err = s.writeYamlDocument(root)
+	case FormatJson:
+		err = s.writeJSON(root)
+	default:
+		return fmt.Errorf("pathtree: unsupported format: %v", format)
+	}
+
+	if err != nil {
+		return err
+	}
+	return s.w.Flush()
+}
+
+// subtree is a non-leaf node of the tree being serialized.
+type subtree struct {
+	tree treeData
+
+	// prefix is the path to the node, which is empty for the root.
+	prefix []string
+}
+
+// serializer writes a tree in some format.
+type serializer struct {
+	pt          *PathTree
+	w           *bufio.Writer
+	postprocess func(any) any
+}
+
+// entries returns the keys of a map or subtree in serialization order
+// and a function to look up their values.
+//
+// Returns false if the value is neither a map nor a subtree.
+func (s *serializer) entries(value any) ([]string, func(string) any, bool) {
+	switch x := value.(type) {
+	case subtree:
+		return s.pt.keysOf(x.tree, x.prefix),
+			func(key string) any { return s.child(x, key) },
+			true
+	case map[string]any:
+		return sortedKeys(x), func(key string) any { return x[key] }, true
+	default:
+		return nil, nil, false
+	}
+}
+
+// child returns the value at a key in a subtree.
+//
+// Top-level values are postprocessed.
+func (s *serializer) child(parent subtree, key string) any {
+	var value any = parent.tree[key]
+	if x, ok := value.(treeData); ok {
+		value = subtree{
+			tree:   x,
+			prefix: append(slices.Clone(parent.prefix), key),
+		}
+	}
+
+	if len(parent.prefix) == 0 && s.postprocess != nil {
+		value = s.postprocess(value)
+	}
+
+	return value
+}
+
+// writeYamlDocument encodes the tree as a YAML document.
+func (s *serializer) writeYamlDocument(root subtree) error {
+	keys, get, _ := s.entries(root)
+	if len(keys) == 0 {
+		_, err := s.w.WriteString("{}\n")
+		return err
+	}
+
+	return s.writeYamlMapping(keys, get, 0)
+}
+
+// writeYamlMapping writes a block mapping whose keys start at the given
+// column.
+//
+// The first key is not indented: the caller positions it.
+func (s *serializer) writeYamlMapping(
+	keys []string,
+	get func(string) any,
+	indent int,
+) error {
+	for i, key := range keys {
+		if i > 0 {
+			s.writeYamlIndent(indent)
+		}
+		isComplexKey, err := s.writeYamlKey(key, indent)
+		if err != nil {
+			return err
+		}
+		if err := s.writeYamlValue(get(key), indent, isComplexKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeYamlKey writes a mapping key up to and including the colon.
+//
+// Long and multiline keys are written as complex keys, like the yaml
+// package does, in which case it returns true.
+func (s *serializer) writeYamlKey(key string, indent int) (bool, error) {
+	encoded, err := yaml.Marshal(key)
+	if err != nil {
+		return false, err
+	}
+
+	if len(key) <= maxYamlSimpleKeyLength && bytes.Count(encoded, []byte{'\n'}) == 1 {
+		s.w.Write(encoded[:len(encoded)-1])
+		s.w.WriteByte(':')
+		return false, nil
+	}
+
+	s.w.WriteString("? ")
+	s.writeYamlLines(encoded, indent)
+	s.writeYamlIndent(indent)
+	s.w.WriteByte(':')
+	return true, nil
+}
+
+// maxYamlSimpleKeyLength is the longest key the yaml package writes as
+// a simple key.
+const maxYamlSimpleKeyLength = 128
+
+// writeYamlValue writes a mapping value after its key's colon.
+//
+// Maps and subtrees are written entry by entry. Other values contain no
+// subtrees unless postprocess added them, and are encoded whole.
+func (s *serializer) writeYamlValue(
+	value any,
+	indent int,
+	isComplexKey bool,
+) error {
+	if keys, get, ok := s.entries(value); ok {
+		if len(keys) == 0 {
+			_, err := s.w.WriteString(" {}\n")
+			return err
+		}
+
+		s.writeYamlCollectionStart(indent, isComplexKey)
+		return s.writeYamlMapping(keys, get, indent+yamlIndent)
+	}
+
+	// TODO: Does `yaml` support NaN and +-Infinity?
+	node, err := s.toYamlNode(value)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := yaml.Marshal(node)
+	if err != nil {
+		return err
+	}
+
+	// Scalars follow the colon; multiline scalars are already indented
+	// relative to their key.
+	if (node.Kind == yaml.MappingNode || node.Kind == yaml.SequenceNode) &&
+		len(node.Content) > 0 {
+		s.writeYamlCollectionStart(indent, isComplexKey)
+		s.writeYamlLines(encoded, indent+yamlIndent)
+	} else {
+		s.w.WriteByte(' ')
+		s.writeYamlLines(encoded, indent)
+	}
+
+	return nil
+}
+
+// writeYamlCollectionStart positions the first line of a non-empty
+// mapping or sequence value after its key's colon.
+//
+// The value starts on the next line, indented, except after a complex
+// key where the yaml package continues the colon's line.
+func (s *serializer) writeYamlCollectionStart(indent int, isComplexKey bool) {
+	if isComplexKey {
+		s.writeYamlIndent(yamlIndent - 1)
+	} else {
+		s.w.WriteByte('\n')
+		s.writeYamlIndent(indent + yamlIndent)
+	}
+}
+
+// yamlIndent is the indentation used by the yaml package.
+const yamlIndent = 4
+
+// writeYamlLines writes YAML encoded at column zero, indenting all but
+// its first line.
+func (s *serializer) writeYamlLines(encoded []byte, indent int) {
+	for len(encoded) > 0 {
+		line, rest, _ := bytes.Cut(encoded, []byte{'\n'})
+		s.w.Write(line)
+		s.w.WriteByte('\n')
+
+		encoded = rest
+		if len(encoded) > 0 {
+			s.writeYamlIndent(indent)
+		}
+	}
+}
+
+// writeYamlIndent writes spaces up to the given column.
+func (s *serializer) writeYamlIndent(indent int) {
+	for range indent {
+		s.w.WriteByte(' ')
+	}
+}
+
+// writeJSON encodes a value as JSON with object keys in serialization
+// order.
+func (s *serializer) writeJSON(value any) error {
+	if keys, get, ok := s.entries(value); ok {
+		s.w.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				s.w.WriteByte(',')
+			}
+			if err := writeJSONLeaf(s.w, key); err != nil {
+				return err
+			}
+			s.w.WriteByte(':')
+			if err := s.writeJSON(get(key)); err != nil {
+				return err
+			}
+		}
+		s.w.WriteByte('}')
+		return nil
+	}
+
+	switch x := value.(type) {
+	case []byte:
+		// Encoded as a base64 string rather than a list.
+		return writeJSONLeaf(s.w, x)
+	}
+
+	// Lists may contain maps, so their elements are encoded recursively.
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Slice && !rv.IsNil() {
+		s.w.WriteByte('[')
+		for i := 0; i < rv.Len(); i++ {
+			if i > 0 {
+				s.w.WriteByte(',')
+			}
+			if err := s.writeJSON(rv.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		s.w.WriteByte(']')
+		return nil
+	}
+
+	return writeJSONLeaf(s.w, value)
+}
+
+// writeJSONLeaf encodes a value that contains no maps.
+func writeJSONLeaf(buf *bufio.Writer, value any) error {
+	// Integers and bools are common and simple, so they skip the encoder.
+	switch leaf := LeafOf(value); leaf.Kind() {
+	case KindInt:
+		x, _ := leaf.AsInt()
+		buf.Write(strconv.AppendInt(buf.AvailableBuffer(), x, 10))
+		return nil
+	case KindBool:
+		x, _ := leaf.AsBool()
+		buf.Write(strconv.AppendBool(buf.AvailableBuffer(), x))
+		return nil
+	}
+
+	encoded, err := simplejsonext.Marshal(value)
+	if err != nil {
+		return err
+	}
+	buf.Write(encoded)
+	return nil
+}
+
+// toYamlNode converts a value to a YAML node with mapping keys in
+// serialization order.
+func (s *serializer) toYamlNode(value any) (*yaml.Node, error) {
+	if keys, get, ok := s.entries(value); ok {
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		for _, key := range keys {
+			keyNode := &yaml.Node{}
+			if err := keyNode.Encode(key); err != nil {
+				return nil, err
+			}
+
+			valueNode, err := s.toYamlNode(get(key))
+			if err != nil {
+				return nil, err
+			}
+
+			node.Content = append(node.Content, keyNode, valueNode)
+		}
+		return node, nil
+	}
+
+	// Lists may contain maps, so their elements are converted recursively.
+	if _, isBytes := value.([]byte); !isBytes {
+		if rv := reflect.ValueOf(value); rv.Kind() == reflect.Slice && !rv.IsNil() {
+			node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+			for i := 0; i < rv.Len(); i++ {
+				element, err := s.toYamlNode(rv.Index(i).Interface())
+				if err != nil {
+					return nil, err
+				}
+				node.Content = append(node.Content, element)
+			}
+			return node, nil
+		}
+	}
+
+	node := &yaml.Node{}
+	if err := node.Encode(value); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
@@ -0,0 +1,87 @@
+package runconfig
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/pathtree"
+)
+
+// artifactRefKey is the key of a dict that refers to an artifact.
+const artifactRefKey = "$artifact"
+
+// An ArtifactRef is a config value that refers to an artifact, written as
+// {"$artifact": "entity/project/name:alias"}.
+type ArtifactRef struct {
+	// Path is the path of the dict in the config.
+	Path pathtree.TreePath
+
+	// Name is the artifact's name, such as "entity/project/name:alias".
+	//
+	// The entity, project and alias may be omitted.
+	Name string
+}
+
+// ArtifactRefs returns the artifact references in the config, sorted by
+// path.
+//
+// Only dicts whose only key is "$artifact" and whose value is a string
+// count. References inside lists and under "_wandb" are not included.
+func (rc *RunConfig) ArtifactRefs() []ArtifactRef {
+	var refs []ArtifactRef
+
+	rc.pathTree.ForEachLeaf(func(path pathtree.TreePath, value any) bool {
+		if path.End() != artifactRefKey || path.Labels()[0] == metadataKey {
+			return true
+		}
+
+		name, ok := value.(string)
+		if !ok {
+			return true
+		}
+
+		parent, ok := path.Parent()
+		if !ok {
+			return true
+		}
+
+		dict, _ := rc.pathTree.Get(parent)
+		if subtree, _ := dict.(map[string]any); len(subtree) != 1 {
+			return true
+		}
+
+		refs = append(refs, ArtifactRef{Path: parent, Name: name})
+		return true
+	})
+
+	slices.SortFunc(refs, func(a, b ArtifactRef) int {
+		return slices.Compare(a.Path.Labels(), b.Path.Labels())
+	})
+	return refs
+}
+
+// ParseArtifactRefName splits an artifact reference's name into its
+// entity, project, name and alias.
+//
+// Missing parts are returned empty, except for the alias, which defaults
+// to "latest".
+func ParseArtifactRefName(refName string) (entity, project, name, alias string) {
+	name, alias, _ = strings.Cut(refName, ":")
+	if alias == "" {
+		alias = "latest"
+	}
+
+	parts := strings.Split(name, "/")
+	switch len(parts) {
+	case 1:
+		name = parts[0]
+	case 2:
+		project, name = parts[0], parts[1]
+	default:
+		entity = parts[len(parts)-3]
+		project = parts[len(parts)-2]
+		name = parts[len(parts)-1]
+	}
+
+	return entity, project, name, alias
+}
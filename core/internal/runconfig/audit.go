@@ -0,0 +1,58 @@
+package runconfig
+
+import (
+	"reflect"
+
+	"github.com/wandb/wandb/core/internal/pathtree"
+)
+
+// A ValueChange is a change made to the config.
+type ValueChange struct {
+	Kind ChangeKind
+	Path pathtree.TreePath
+
+	// OldValue is the value before the change, or nil if it was added.
+	OldValue any
+
+	// NewValue is the value after the change, or nil if it was removed.
+	NewValue any
+}
+
+// SetChangeCallback sets a function to call for each change made by
+// ApplyChangeRecord or MergeResumedConfig.
+//
+// Changes are reported at the path of the update, so a dict update
+// produces a single change whose values are whole subtrees. Updates
+// that don't change anything are not reported.
+func (rc *RunConfig) SetChangeCallback(onChange func(ValueChange)) {
+	rc.onChange = onChange
+}
+
+// trackChange applies an update to the path and reports it to the change
+// callback.
+func (rc *RunConfig) trackChange(path pathtree.TreePath, apply func()) {
+	if rc.onChange == nil {
+		apply()
+		return
+	}
+
+	oldValue, hadOld := rc.pathTree.Get(path)
+	apply()
+	newValue, hasNew := rc.pathTree.Get(path)
+
+	change := ValueChange{Path: path, OldValue: oldValue, NewValue: newValue}
+	switch {
+	case !hadOld && !hasNew:
+		return
+	case !hadOld:
+		change.Kind = ChangeAdded
+	case !hasNew:
+		change.Kind = ChangeRemoved
+	case reflect.DeepEqual(oldValue, newValue):
+		return
+	default:
+		change.Kind = ChangeChanged
+	}
+
+	rc.onChange(change)
+}
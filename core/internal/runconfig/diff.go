@@ -0,0 +1,103 @@
+package runconfig
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/pathtree"
+)
+
+// ChangeKind is how a leaf differs between two configs.
+type ChangeKind int
+
+const (
+	ChangeAdded ChangeKind = iota
+	ChangeRemoved
+	ChangeChanged
+)
+
+// ChangeItem is a leaf that differs between two configs.
+type ChangeItem struct {
+	Kind ChangeKind
+	Path pathtree.TreePath
+
+	// Value is the new value, or nil if the leaf was removed.
+	Value any
+}
+
+// Diff returns the leaves that differ in the other config.
+//
+// Items are sorted by path. Leaves are compared with reflect.DeepEqual.
+func (rc *RunConfig) Diff(other *RunConfig) []ChangeItem {
+	oldLeaves := make(map[string]pathtree.PathItem)
+	for _, item := range rc.pathTree.Flatten() {
+		oldLeaves[pathKey(item.Path)] = item
+	}
+
+	var changes []ChangeItem
+	for _, item := range other.pathTree.Flatten() {
+		key := pathKey(item.Path)
+		old, exists := oldLeaves[key]
+		delete(oldLeaves, key)
+
+		switch {
+		case !exists:
+			changes = append(changes,
+				ChangeItem{Kind: ChangeAdded, Path: item.Path, Value: item.Value})
+		case !reflect.DeepEqual(old.Value, item.Value):
+			changes = append(changes,
+				ChangeItem{Kind: ChangeChanged, Path: item.Path, Value: item.Value})
+		}
+	}
+
+	for _, item := range oldLeaves {
+		changes = append(changes,
+			ChangeItem{Kind: ChangeRemoved, Path: item.Path})
+	}
+
+	slices.SortFunc(changes, func(a, b ChangeItem) int {
+		return slices.Compare(a.Path.Labels(), b.Path.Labels())
+	})
+	return changes
+}
+
+// Delta returns a config with only the top-level keys that have changes
+// and are still set.
+//
+// Because top-level keys are copied whole, sending the delta replaces
+// every changed key including any nested removals.
+func (rc *RunConfig) Delta(changes []ChangeItem) *RunConfig {
+	delta := New()
+
+	for _, change := range changes {
+		path := pathtree.PathOf(change.Path.Labels()[0])
+		if delta.pathTree.HasNode(path) {
+			continue
+		}
+
+		value, exists := rc.pathTree.Get(path)
+		if !exists {
+			continue
+		}
+
+		switch x := value.(type) {
+		case map[string]any:
+			delta.pathTree.SetSubtree(path, x)
+		default:
+			delta.pathTree.Set(path, x)
+		}
+	}
+
+	return delta
+}
+
+// IsEmpty returns whether the config has no values.
+func (rc *RunConfig) IsEmpty() bool {
+	return rc.pathTree.IsEmpty()
+}
+
+// pathKey returns a string that uniquely identifies a path.
+func pathKey(path pathtree.TreePath) string {
+	return strings.Join(path.Labels(), "\x00")
+}
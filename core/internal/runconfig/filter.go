@@ -0,0 +1,107 @@
+package runconfig
+
+import (
+	"path"
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/pathtree"
+)
+
+// A FilterOption limits the keys returned by Serialize and CloneTree.
+//
+// Patterns are dot-separated, and each part may use the wildcards of
+// path.Match, such as "model.*". A pattern that matches a key also
+// matches everything under it.
+type FilterOption func(*keyFilter)
+
+// WithInclude keeps only the keys that match a pattern.
+func WithInclude(patterns ...string) FilterOption {
+	return func(f *keyFilter) {
+		f.include = append(f.include, splitPatterns(patterns)...)
+	}
+}
+
+// WithExclude omits the keys that match a pattern.
+//
+// It takes precedence over WithInclude.
+func WithExclude(patterns ...string) FilterOption {
+	return func(f *keyFilter) {
+		f.exclude = append(f.exclude, splitPatterns(patterns)...)
+	}
+}
+
+// keyFilter selects leaves in the config by their path.
+type keyFilter struct {
+	// include are the split patterns for keys to keep, or empty to keep
+	// all keys.
+	include [][]string
+
+	// exclude are the split patterns for keys to omit.
+	exclude [][]string
+}
+
+// filtered returns the tree with only the leaves that pass the filters.
+//
+// The tree itself is returned if there are no filters.
+func (rc *RunConfig) filtered(opts []FilterOption) *pathtree.PathTree {
+	if len(opts) == 0 {
+		return rc.pathTree
+	}
+
+	filter := &keyFilter{}
+	for _, opt := range opts {
+		opt(filter)
+	}
+
+	return rc.pathTree.Filter(filter.allows)
+}
+
+// allows returns whether to keep the leaf at the path.
+func (f *keyFilter) allows(path pathtree.TreePath) bool {
+	labels := path.Labels()
+
+	for _, pattern := range f.exclude {
+		if matchesPattern(pattern, labels) {
+			return false
+		}
+	}
+
+	if len(f.include) == 0 {
+		return true
+	}
+
+	for _, pattern := range f.include {
+		if matchesPattern(pattern, labels) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesPattern returns whether the pattern matches the path or one of
+// its ancestors.
+//
+// Malformed patterns match nothing.
+func matchesPattern(pattern []string, labels []string) bool {
+	if len(pattern) > len(labels) {
+		return false
+	}
+
+	for i, part := range pattern {
+		if ok, err := path.Match(part, labels[i]); !ok || err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// splitPatterns splits dot-separated patterns into their parts.
+func splitPatterns(patterns []string) [][]string {
+	split := make([][]string, len(patterns))
+	for i, pattern := range patterns {
+		split[i] = strings.Split(pattern, ".")
+	}
+	return split
+}
@@ -0,0 +1,147 @@
+package runconfig
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/wandb/simplejsonext"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// envReferenceRegexp matches references to environment variables such
+// as "${env:DATA_DIR}".
+var envReferenceRegexp = regexp.MustCompile(`\$\{env:([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// InterpolateEnv replaces references like "${env:DATA_DIR}" in the
+// string values of a config record by the values of environment
+// variables.
+//
+// Strings inside lists and dicts are included, but values under
+// "_wandb" are not. lookupEnv should look up variables in the user
+// process's environment, which may differ from this process's.
+//
+// References to unset variables are left as they are and reported in
+// the returned error.
+func InterpolateEnv(
+	config *service.ConfigRecord,
+	lookupEnv func(name string) (string, bool),
+) error {
+	var errs []error
+
+	for _, item := range config.GetUpdate() {
+		labels := item.GetNestedKey()
+		if len(labels) == 0 {
+			labels = []string{item.GetKey()}
+		}
+		if labels[0] == metadataKey {
+			continue
+		}
+
+		value, err := simplejsonext.UnmarshalString(item.GetValueJson())
+		if err != nil {
+			// Reported when the record is applied.
+			continue
+		}
+
+		var missing []string
+		newValue, isChanged := interpolateEnv(value, lookupEnv, &missing)
+		if isChanged {
+			valueJSON, err := simplejsonext.MarshalToString(newValue)
+			if err != nil {
+				errs = append(errs, err)
+			} else {
+				item.ValueJson = valueJSON
+			}
+		}
+
+		for _, name := range missing {
+			errs = append(errs, fmt.Errorf(
+				"runconfig: %q: environment variable %s is not set",
+				strings.Join(labels, "."),
+				name,
+			))
+		}
+	}
+
+	slices.SortFunc(errs, func(a, b error) int {
+		return strings.Compare(a.Error(), b.Error())
+	})
+	return errors.Join(errs...)
+}
+
+// interpolateEnv returns the value with environment variable references
+// replaced, and whether there were any.
+//
+// Lists and dicts are copied if they contain a reference. The names of
+// unset variables are appended to missing.
+func interpolateEnv(
+	value any,
+	lookupEnv func(name string) (string, bool),
+	missing *[]string,
+) (any, bool) {
+	switch x := value.(type) {
+	case string:
+		isChanged := false
+		result := envReferenceRegexp.ReplaceAllStringFunc(x,
+			func(reference string) string {
+				name := envReferenceRegexp.FindStringSubmatch(reference)[1]
+
+				envValue, ok := lookupEnv(name)
+				if !ok {
+					*missing = append(*missing, name)
+					return reference
+				}
+
+				isChanged = true
+				return envValue
+			})
+		return result, isChanged
+
+	case []any:
+		var clone []any
+		for i, element := range x {
+			newElement, isChanged := interpolateEnv(element, lookupEnv, missing)
+			if !isChanged {
+				continue
+			}
+
+			if clone == nil {
+				clone = slices.Clone(x)
+			}
+			clone[i] = newElement
+		}
+
+		if clone == nil {
+			return x, false
+		}
+		return clone, true
+
+	case map[string]any:
+		var clone map[string]any
+		for key, subvalue := range x {
+			newSubvalue, isChanged := interpolateEnv(subvalue, lookupEnv, missing)
+			if !isChanged {
+				continue
+			}
+
+			if clone == nil {
+				clone = make(map[string]any, len(x))
+				for key, subvalue := range x {
+					clone[key] = subvalue
+				}
+			}
+			clone[key] = newSubvalue
+		}
+
+		if clone == nil {
+			return x, false
+		}
+		return clone, true
+
+	default:
+		return value, false
+	}
+}
@@ -0,0 +1,68 @@
+package runconfig
+
+import (
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/pathtree"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// DefaultKeyEscape is the usual character for escaping dots in keys.
+const DefaultKeyEscape = '\\'
+
+// SetDottedKeys sets whether ApplyChangeRecord splits keys like
+// "optimizer.lr" into nested paths.
+//
+// Items that set NestedKey are unaffected. In a dotted key, the escape
+// character makes the following dot or escape character literal, as in
+// `file\.txt`. It is usually DefaultKeyEscape, and if it is 0, dots
+// can't be escaped.
+func (rc *RunConfig) SetDottedKeys(isDotted bool, escape rune) {
+	rc.isDottedKeys = isDotted
+	rc.keyEscape = escape
+}
+
+// keyPath returns the path in the config that an item refers to.
+func (rc *RunConfig) keyPath(item *service.ConfigItem) pathtree.TreePath {
+	if len(item.GetNestedKey()) > 0 || !rc.isDottedKeys {
+		return keyPath(item)
+	}
+
+	labels := splitDottedKey(item.GetKey(), rc.keyEscape)
+	return pathtree.PathOf(labels[0], labels[1:]...)
+}
+
+// splitDottedKey splits a key on dots that are not escaped.
+func splitDottedKey(key string, escape rune) []string {
+	var labels []string
+	var label strings.Builder
+	isEscaped := false
+
+	for _, c := range key {
+		switch {
+		case isEscaped:
+			if c != '.' && c != escape {
+				// Only dots and escapes can be escaped.
+				label.WriteRune(escape)
+			}
+			label.WriteRune(c)
+			isEscaped = false
+
+		case c == escape:
+			isEscaped = true
+
+		case c == '.':
+			labels = append(labels, label.String())
+			label.Reset()
+
+		default:
+			label.WriteRune(c)
+		}
+	}
+
+	if isEscaped {
+		label.WriteRune(escape)
+	}
+
+	return append(labels, label.String())
+}
@@ -0,0 +1,152 @@
+package runconfig
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/pathtree"
+)
+
+// lockedKey is a path that updates can't change.
+type lockedKey struct {
+	path pathtree.TreePath
+
+	// owner is who locked the path, such as "sweep".
+	owner string
+}
+
+// Lock prevents ApplyChangeRecord from changing or removing the value at
+// the path or anything under it.
+//
+// The owner, such as "sweep", is named in the error reported for an
+// ignored update.
+func (rc *RunConfig) Lock(path pathtree.TreePath, owner string) {
+	if rc.locked == nil {
+		rc.locked = make(map[string]lockedKey)
+	}
+
+	rc.locked[pathKey(path)] = lockedKey{path: path, owner: owner}
+}
+
+// IsLocked returns whether the path or one of its ancestors is locked.
+func (rc *RunConfig) IsLocked(path pathtree.TreePath) bool {
+	for _, lock := range rc.locked {
+		if isPrefix(lock.path, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// lockedValuesUnder returns the locked values at or under the path.
+func (rc *RunConfig) lockedValuesUnder(path pathtree.TreePath) []pathtree.PathItem {
+	var items []pathtree.PathItem
+
+	for _, lock := range rc.locked {
+		if !isPrefix(path, lock.path) {
+			continue
+		}
+
+		if value, exists := rc.pathTree.Get(lock.path); exists {
+			items = append(items, pathtree.PathItem{Path: lock.path, Value: value})
+		}
+	}
+
+	return items
+}
+
+// checkLocked returns whether setting the value at the path would
+// change a locked value, in which case the update is ignored.
+//
+// If value is a map, locked values under the path are instead removed
+// from a copy of it, and the copy is returned so that the remaining
+// values can be applied. An error is still returned for the removed ones.
+func (rc *RunConfig) checkLocked(
+	path pathtree.TreePath,
+	value any,
+) (newValue any, isIgnored bool, err error) {
+	var errs []string
+
+	for _, lock := range rc.locked {
+		switch {
+		case isPrefix(lock.path, path):
+			return nil, true, lock.errorFor(path)
+
+		case isPrefix(path, lock.path):
+			subtree, ok := value.(map[string]any)
+			if !ok {
+				return nil, true, lock.errorFor(lock.path)
+			}
+
+			pruned, isRemoved := withoutPath(
+				subtree,
+				lock.path.Labels()[path.Len():],
+			)
+			if isRemoved {
+				value = pruned
+				errs = append(errs, lock.errorFor(lock.path).Error())
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		slices.Sort(errs)
+		return value, false, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+
+	return value, false, nil
+}
+
+// errorFor returns the error for an ignored update at the path.
+func (lock lockedKey) errorFor(path pathtree.TreePath) error {
+	return fmt.Errorf(
+		"runconfig: %q was locked by %q (ignored update)",
+		strings.Join(path.Labels(), "."),
+		lock.owner,
+	)
+}
+
+// isPrefix returns whether the prefix path is equal to or an ancestor of
+// the path.
+func isPrefix(prefix, path pathtree.TreePath) bool {
+	return prefix.Len() <= path.Len() &&
+		slices.Equal(prefix.Labels(), path.Labels()[:prefix.Len()])
+}
+
+// withoutPath returns a copy of the tree without the value at the
+// relative path, and whether there was such a value.
+//
+// Only the maps along the path are copied.
+func withoutPath(tree map[string]any, path []string) (map[string]any, bool) {
+	value, exists := tree[path[0]]
+	if !exists {
+		return tree, false
+	}
+
+	var newValue map[string]any
+	if len(path) > 1 {
+		// A non-map value would replace the locked one, so it's removed
+		// entirely.
+		if subtree, ok := value.(map[string]any); ok {
+			var isRemoved bool
+			newValue, isRemoved = withoutPath(subtree, path[1:])
+			if !isRemoved {
+				return tree, false
+			}
+		}
+	}
+
+	clone := make(map[string]any, len(tree))
+	for key, value := range tree {
+		clone[key] = value
+	}
+
+	if newValue != nil {
+		clone[path[0]] = newValue
+	} else {
+		delete(clone, path[0])
+	}
+
+	return clone, true
+}
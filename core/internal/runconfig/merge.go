@@ -0,0 +1,123 @@
+package runconfig
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/wandb/wandb/core/internal/pathtree"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// MergeStrategy is how an update combines with existing values.
+//
+// The zero value deep-merges dicts and replaces lists.
+type MergeStrategy struct {
+	// ReplaceDicts is whether a dict replaces an existing dict instead of
+	// updating its keys recursively.
+	ReplaceDicts bool
+
+	// AppendLists is whether a list is appended to an existing list
+	// instead of replacing it.
+	AppendLists bool
+}
+
+// SetMergeStrategy sets the strategy for updates that don't choose one.
+func (rc *RunConfig) SetMergeStrategy(strategy MergeStrategy) {
+	rc.mergeStrategy = strategy
+}
+
+// mergeStrategyFor returns the strategy for an update.
+func (rc *RunConfig) mergeStrategyFor(item *service.ConfigItem) MergeStrategy {
+	strategy := rc.mergeStrategy
+
+	switch item.GetDictMerge() {
+	case service.ConfigItem_DICT_MERGE_DEEP:
+		strategy.ReplaceDicts = false
+	case service.ConfigItem_DICT_MERGE_REPLACE:
+		strategy.ReplaceDicts = true
+	}
+
+	switch item.GetListMerge() {
+	case service.ConfigItem_LIST_MERGE_REPLACE:
+		strategy.AppendLists = false
+	case service.ConfigItem_LIST_MERGE_APPEND:
+		strategy.AppendLists = true
+	}
+
+	return strategy
+}
+
+// merge combines a value with the config at the path.
+func (rc *RunConfig) merge(
+	path pathtree.TreePath,
+	value any,
+	strategy MergeStrategy,
+) {
+	switch x := value.(type) {
+	case map[string]any:
+		if strategy.ReplaceDicts {
+			rc.replaceSubtree(path, x)
+			return
+		}
+
+		// Keys are merged in sorted order so that the order in which
+		// new keys are added is deterministic.
+		keys := make([]string, 0, len(x))
+		for key := range x {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			rc.merge(path.With(key), x[key], strategy)
+		}
+
+	case []any:
+		if strategy.AppendLists {
+			if old, ok := rc.pathTree.GetLeaf(path); ok {
+				if oldList, ok := toList(old); ok {
+					x = append(oldList, x...)
+				}
+			}
+		}
+		rc.pathTree.Set(path, x)
+
+	default:
+		rc.pathTree.Set(path, x)
+	}
+}
+
+// replaceSubtree replaces the subtree at the path, except for locked
+// values.
+func (rc *RunConfig) replaceSubtree(
+	path pathtree.TreePath,
+	subtree map[string]any,
+) {
+	locked := rc.lockedValuesUnder(path)
+
+	rc.pathTree.Remove(path)
+	rc.pathTree.SetSubtree(path, subtree)
+
+	for _, item := range locked {
+		switch x := item.Value.(type) {
+		case map[string]any:
+			rc.pathTree.SetSubtree(item.Path, x)
+		default:
+			rc.pathTree.Set(item.Path, x)
+		}
+	}
+}
+
+// toList returns a copy of a slice as a list of values.
+func toList(value any) ([]any, bool) {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice {
+		return nil, false
+	}
+
+	list := make([]any, rv.Len())
+	for i := range list {
+		list[i] = rv.Index(i).Interface()
+	}
+	return list, true
+}
@@ -0,0 +1,60 @@
+package runconfig
+
+import (
+	"fmt"
+
+	"github.com/wandb/wandb/core/internal/pathtree"
+)
+
+// metadataKey is the top-level config key for W&B run metadata.
+const metadataKey = "_wandb"
+
+// clientMetadataKeys are keys under "_wandb" that the client writes.
+var clientMetadataKeys = []string{"code_path", "visualize", "viz"}
+
+// Metadata is a key under "_wandb" that is owned by one subsystem.
+type Metadata struct {
+	rc  *RunConfig
+	key string
+}
+
+// RegisterMetadata claims a key under "_wandb" for the owner, such as
+// "telemetry".
+//
+// A key can be registered only once, so that subsystems don't overwrite
+// each other's metadata. It is an error to register a key that is
+// already owned, including keys that the client writes.
+func (rc *RunConfig) RegisterMetadata(key, owner string) (*Metadata, error) {
+	if rc.metadataOwners == nil {
+		rc.metadataOwners = make(map[string]string)
+		for _, clientKey := range clientMetadataKeys {
+			rc.metadataOwners[clientKey] = "client"
+		}
+	}
+
+	if prevOwner, ok := rc.metadataOwners[key]; ok {
+		return nil, fmt.Errorf(
+			"runconfig: metadata key %q is already owned by %q",
+			key,
+			prevOwner,
+		)
+	}
+
+	rc.metadataOwners[key] = owner
+	return &Metadata{rc: rc, key: key}, nil
+}
+
+// mustRegisterMetadata is RegisterMetadata for the keys that the
+// RunConfig itself owns, which never collide.
+func (rc *RunConfig) mustRegisterMetadata(key, owner string) *Metadata {
+	metadata, err := rc.RegisterMetadata(key, owner)
+	if err != nil {
+		panic(err)
+	}
+	return metadata
+}
+
+// Set replaces the value of the metadata.
+func (m *Metadata) Set(value any) {
+	m.rc.pathTree.Set(pathtree.PathOf(metadataKey, m.key), value)
+}
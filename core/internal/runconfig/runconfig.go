@@ -2,19 +2,21 @@ package runconfig
 
 import (
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/wandb/simplejsonext"
 	"github.com/wandb/wandb/core/internal/corelib"
+	"github.com/wandb/wandb/core/internal/nonfinite"
 	"github.com/wandb/wandb/core/internal/pathtree"
 	"github.com/wandb/wandb/core/pkg/service"
-	"gopkg.in/yaml.v3"
 )
 
-type Format int
+type Format = pathtree.Format
 
 const (
-	FormatYaml Format = iota
-	FormatJson
+	FormatYaml = pathtree.FormatYaml
+	FormatJson = pathtree.FormatJson
 )
 
 // The configuration of a run.
@@ -27,12 +29,61 @@ const (
 // The server process builds this up incrementally throughout a run's lifetime.
 type RunConfig struct {
 	pathTree *pathtree.PathTree
+
+	// validators check updates before they are applied.
+	validators []Validator
+
+	// sizeLimits bounds the size of updates.
+	sizeLimits SizeLimits
+
+	// locked are paths that updates can't change, by pathKey.
+	locked map[string]lockedKey
+
+	// mergeStrategy is the default way updates combine with existing
+	// values.
+	mergeStrategy MergeStrategy
+
+	// nonFinitePolicy is how to handle NaN and +-Infinity in updates.
+	nonFinitePolicy nonfinite.Policy
+
+	// isStrictTypes is whether to reject values with unsupported types.
+	isStrictTypes bool
+
+	// isDottedKeys is whether to split item keys on dots.
+	isDottedKeys bool
+
+	// keyEscape escapes dots in item keys if isDottedKeys is set.
+	keyEscape rune
+
+	// onChange, if set, is called for each change to the config.
+	onChange func(ValueChange)
+
+	// metadataOwners maps keys under "_wandb" to their owners.
+	metadataOwners map[string]string
+
+	// W&B-internal metadata set by AddTelemetryAndMetrics.
+	cliVersion    *Metadata
+	pythonVersion *Metadata
+	telemetry     *Metadata
+	metrics       *Metadata
 }
 
+// A Validator checks a value before it is set at a path in the config.
+//
+// Map values are whole subtrees being set at the path.
+type Validator func(path pathtree.TreePath, value any) error
+
 func New() *RunConfig {
-	return &RunConfig{
+	rc := &RunConfig{
 		pathTree: pathtree.New(),
 	}
+
+	rc.cliVersion = rc.mustRegisterMetadata("cli_version", "telemetry")
+	rc.pythonVersion = rc.mustRegisterMetadata("python_version", "telemetry")
+	rc.telemetry = rc.mustRegisterMetadata("t", "telemetry")
+	rc.metrics = rc.mustRegisterMetadata("m", "metrics")
+
+	return rc
 }
 
 func NewFrom(tree map[string]any) *RunConfig {
@@ -50,32 +101,65 @@ func NewFrom(tree map[string]any) *RunConfig {
 	return rc
 }
 
-func (rc *RunConfig) Serialize(format Format) ([]byte, error) {
+// Serialize encodes the config as it is stored in a run's config file,
+// with each top-level value wrapped in a "value" key.
+//
+// Options can omit some keys.
+func (rc *RunConfig) Serialize(
+	format Format,
+	opts ...FilterOption,
+) ([]byte, error) {
+	return rc.filtered(opts).Serialize(format, wrapConfigValue)
+}
 
-	value := make(map[string]any)
-	for treeKey, treeValue := range rc.pathTree.CloneTree() {
-		value[treeKey] = map[string]any{"value": treeValue}
-	}
+// SerializeTo is like Serialize but writes to w without holding the
+// whole output in memory.
+func (rc *RunConfig) SerializeTo(
+	w io.Writer,
+	format Format,
+	opts ...FilterOption,
+) error {
+	return rc.filtered(opts).SerializeTo(w, format, wrapConfigValue)
+}
 
-	switch format {
-	case FormatYaml:
-		// TODO: Does `yaml` support NaN and +-Infinity?
-		return yaml.Marshal(value)
-	case FormatJson:
-		return simplejsonext.Marshal(value)
-	default:
-		return nil, fmt.Errorf("unsupported format: %v", format)
-	}
+// wrapConfigValue wraps a top-level value as it is stored in a run's
+// config file.
+func wrapConfigValue(value any) any {
+	return map[string]any{"value": value}
+}
+
+// PreserveOrder makes Serialize list keys in the order they were first
+// set instead of sorting them.
+func (rc *RunConfig) PreserveOrder() {
+	rc.pathTree.PreserveOrder()
+}
+
+// SetNonFinitePolicy sets how ApplyChangeRecord handles NaN and
+// +-Infinity in updates.
+//
+// Under the Error policy, such updates are skipped and reported.
+func (rc *RunConfig) SetNonFinitePolicy(policy nonfinite.Policy) {
+	rc.nonFinitePolicy = policy
+}
+
+// AddValidator registers a function to check updates in
+// ApplyChangeRecord.
+func (rc *RunConfig) AddValidator(validator Validator) {
+	rc.validators = append(rc.validators, validator)
 }
 
 // Updates and/or removes values from the configuration tree.
 //
 // Does a best-effort job to apply all changes. Errors are passed to `onError`
-// and skipped.
+// and skipped. This includes updates rejected by a validator, updates
+// over the size limits, which are reported as a *SizeLimitError, changes
+// to locked paths, and values with unsupported types in strict mode.
 func (rc *RunConfig) ApplyChangeRecord(
 	configRecord *service.ConfigRecord,
 	onError func(error),
 ) {
+	sizes := rc.measureSizes()
+
 	for _, item := range configRecord.GetUpdate() {
 		value, err := simplejsonext.UnmarshalString(item.GetValueJson())
 		if err != nil {
@@ -83,46 +167,91 @@ func (rc *RunConfig) ApplyChangeRecord(
 			continue
 		}
 
-		switch x := value.(type) {
-		case map[string]any:
-			rc.pathTree.SetSubtree(keyPath(item), x)
-		default:
-			rc.pathTree.Set(keyPath(item), x)
+		path := rc.keyPath(item)
+		value, keep, err := nonfinite.Apply(value, rc.nonFinitePolicy)
+		if err != nil {
+			onError(fmt.Errorf(
+				"runconfig: %q: %v",
+				strings.Join(path.Labels(), "."),
+				err,
+			))
+			continue
+		}
+		if !keep {
+			continue
+		}
+
+		if rc.isStrictTypes {
+			err := checkStrictType(strings.Join(path.Labels(), "."), value)
+			if err != nil {
+				onError(err)
+				continue
+			}
+		}
+
+		value, isIgnored, err := rc.checkLocked(path, value)
+		if err != nil {
+			onError(err)
+		}
+		if isIgnored {
+			continue
 		}
+
+		if err := rc.validate(path, value); err != nil {
+			onError(err)
+			continue
+		}
+
+		if err := rc.checkSize(path, item.GetValueJson(), sizes); err != nil {
+			onError(err)
+			continue
+		}
+
+		rc.trackChange(path, func() {
+			rc.merge(path, value, rc.mergeStrategyFor(item))
+		})
 	}
 
 	for _, item := range configRecord.GetRemove() {
-		rc.pathTree.Remove(keyPath(item))
+		path := rc.keyPath(item)
+		if _, isIgnored, err := rc.checkLocked(path, nil); isIgnored {
+			onError(err)
+			continue
+		}
+
+		rc.trackChange(path, func() { rc.pathTree.Remove(path) })
 	}
 }
 
+// validate runs the validators on an update.
+func (rc *RunConfig) validate(path pathtree.TreePath, value any) error {
+	for _, validator := range rc.validators {
+		if err := validator(path, value); err != nil {
+			return fmt.Errorf(
+				"runconfig: invalid value for %q: %v",
+				strings.Join(path.Labels(), "."),
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
 // Inserts W&B-internal values into the run's configuration.
 func (rc *RunConfig) AddTelemetryAndMetrics(
 	telemetry *service.TelemetryRecord,
 	metrics []map[string]interface{},
 ) {
 	if telemetry.GetCliVersion() != "" {
-		rc.pathTree.Set(
-			pathtree.PathOf("_wandb", "cli_version"),
-			telemetry.CliVersion,
-		)
+		rc.cliVersion.Set(telemetry.CliVersion)
 	}
 	if telemetry.GetPythonVersion() != "" {
-		rc.pathTree.Set(
-			pathtree.PathOf("_wandb", "python_version"),
-			telemetry.PythonVersion,
-		)
+		rc.pythonVersion.Set(telemetry.PythonVersion)
 	}
 
-	rc.pathTree.Set(
-		pathtree.PathOf("_wandb", "t"),
-		corelib.ProtoEncodeToDict(telemetry),
-	)
-
-	rc.pathTree.Set(
-		pathtree.PathOf("_wandb", "m"),
-		metrics,
-	)
+	rc.telemetry.Set(corelib.ProtoEncodeToDict(telemetry))
+	rc.metrics.Set(metrics)
 }
 
 // Incorporates the config from a run that's being resumed.
@@ -135,12 +264,12 @@ func (rc *RunConfig) MergeResumedConfig(oldConfig map[string]any) {
 	// logged visualizations, hence this special handling.
 	rc.addUnsetKeysFromSubtree(
 		oldConfig,
-		[]string{"_wandb", "visualize"},
+		[]string{metadataKey, "visualize"},
 	)
 
 	rc.addUnsetKeysFromSubtree(
 		oldConfig,
-		[]string{"_wandb", "viz"},
+		[]string{metadataKey, "viz"},
 	)
 }
 
@@ -169,17 +298,22 @@ func (rc *RunConfig) addUnsetKeysFromSubtree(
 		}
 
 		path := pathtree.PathWithPrefix(prefix, key)
-		switch x := value.(type) {
-		case map[string]any:
-			rc.pathTree.SetSubtree(path, x)
-		default:
-			rc.pathTree.Set(path, x)
-		}
+		rc.trackChange(path, func() {
+			switch x := value.(type) {
+			case map[string]any:
+				rc.pathTree.SetSubtree(path, x)
+			default:
+				rc.pathTree.Set(path, x)
+			}
+		})
 	}
 }
 
-func (rc *RunConfig) CloneTree() map[string]any {
-	return rc.pathTree.CloneTree()
+// CloneTree returns the config as nested maps.
+//
+// Options can omit some keys.
+func (rc *RunConfig) CloneTree(opts ...FilterOption) map[string]any {
+	return rc.filtered(opts).CloneTree()
 }
 
 // keyPath returns the key path for the given config item.
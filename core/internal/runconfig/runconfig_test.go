@@ -1,10 +1,14 @@
 package runconfig_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/wandb/wandb/core/internal/corelib"
+	"github.com/wandb/wandb/core/internal/nonfinite"
+	"github.com/wandb/wandb/core/internal/pathtree"
 	"github.com/wandb/wandb/core/internal/runconfig"
 	"github.com/wandb/wandb/core/pkg/service"
 )
@@ -44,6 +48,243 @@ func TestConfigUpdate(t *testing.T) {
 	)
 }
 
+func TestConfigUpdate_Validator(t *testing.T) {
+	runConfig := runconfig.New()
+	runConfig.AddValidator(func(path pathtree.TreePath, value any) error {
+		if path.End() == "lr" {
+			if _, ok := value.(float64); !ok {
+				return errors.New("not a float")
+			}
+		}
+		return nil
+	})
+	var errs []error
+
+	runConfig.ApplyChangeRecord(
+		&service.ConfigRecord{
+			Update: []*service.ConfigItem{
+				{NestedKey: []string{"opt", "lr"}, ValueJson: "\"fast\""},
+				{Key: "epochs", ValueJson: "3"},
+			},
+		},
+		func(err error) { errs = append(errs, err) },
+	)
+
+	assert.Equal(t,
+		map[string]any{"epochs": int64(3)},
+		runConfig.CloneTree())
+	require.Len(t, errs, 1)
+	assert.ErrorContains(t, errs[0], `"opt.lr": not a float`)
+}
+
+func TestConfigUpdate_StrictTypes(t *testing.T) {
+	runConfig := runconfig.New()
+	runConfig.SetStrictTypes(true)
+	var errs []error
+
+	runConfig.ApplyChangeRecord(
+		&service.ConfigRecord{
+			Update: []*service.ConfigItem{
+				{Key: "ok", ValueJson: `{"a": [1, 2.5, "x", true]}`},
+				{Key: "null", ValueJson: "null"},
+				{Key: "nested", ValueJson: `{"a": [1, {"b": null}]}`},
+				{Key: "empty", ValueJson: `{"": 1}`},
+			},
+		},
+		func(err error) { errs = append(errs, err) },
+	)
+
+	assert.Equal(t,
+		map[string]any{
+			"ok": map[string]any{"a": []any{int64(1), 2.5, "x", true}},
+		},
+		runConfig.CloneTree())
+	require.Len(t, errs, 3)
+	assert.ErrorContains(t, errs[0], `"null": null is not allowed`)
+	assert.ErrorContains(t, errs[1], `"nested.a[1].b": null is not allowed`)
+	assert.ErrorContains(t, errs[2], `"empty": empty keys are not allowed`)
+}
+
+func TestConfigUpdate_DottedKeys(t *testing.T) {
+	runConfig := runconfig.New()
+	runConfig.SetDottedKeys(true, runconfig.DefaultKeyEscape)
+
+	runConfig.ApplyChangeRecord(
+		&service.ConfigRecord{
+			Update: []*service.ConfigItem{
+				{Key: "optimizer.lr", ValueJson: "0.1"},
+				{Key: "optimizer.name", ValueJson: `"adam"`},
+				{Key: `file\.txt`, ValueJson: "1"},
+				{Key: `a\\.b\c`, ValueJson: "2"},
+				{NestedKey: []string{"x.y"}, ValueJson: "3"},
+			},
+			Remove: []*service.ConfigItem{{Key: "optimizer.name"}},
+		},
+		ignoreError,
+	)
+
+	assert.Equal(t,
+		map[string]any{
+			"optimizer": map[string]any{"lr": 0.1},
+			"file.txt":  int64(1),
+			`a\`:        map[string]any{`b\c`: int64(2)},
+			"x.y":       int64(3),
+		},
+		runConfig.CloneTree())
+}
+
+func TestConfigUpdate_SizeLimits(t *testing.T) {
+	runConfig := runconfig.NewFrom(map[string]any{
+		"big":   "0123456789",
+		"small": 1,
+	})
+	runConfig.SetSizeLimits(runconfig.SizeLimits{
+		MaxBytes:      40,
+		MaxValueBytes: 8,
+	})
+	var errs []error
+
+	runConfig.ApplyChangeRecord(
+		&service.ConfigRecord{
+			Update: []*service.ConfigItem{
+				{Key: "value", ValueJson: `"too long"`},
+				{Key: "a", ValueJson: "1"},
+				{Key: "b", ValueJson: "2"},
+				{Key: "small", ValueJson: "3"},
+			},
+		},
+		func(err error) { errs = append(errs, err) },
+	)
+
+	assert.Equal(t,
+		map[string]any{
+			"big":   "0123456789",
+			"small": int64(3),
+			"a":     int64(1),
+		},
+		runConfig.CloneTree())
+	require.Len(t, errs, 2)
+	var valueErr, totalErr *runconfig.SizeLimitError
+	require.ErrorAs(t, errs[0], &valueErr)
+	assert.Equal(t, "value", valueErr.Key)
+	assert.False(t, valueErr.IsTotal)
+	require.ErrorAs(t, errs[1], &totalErr)
+	assert.Equal(t, "b", totalErr.Key)
+	assert.True(t, totalErr.IsTotal)
+	assert.Equal(t, []string{"big", "small", "a"}, totalErr.LargestKeys)
+}
+
+func TestConfigUpdate_Locked(t *testing.T) {
+	runConfig := runconfig.NewFrom(map[string]any{
+		"opt": map[string]any{"lr": 0.1, "momentum": 0.9},
+	})
+	runConfig.Lock(pathtree.PathOf("opt", "lr"), "sweep")
+	var errs []error
+
+	runConfig.ApplyChangeRecord(
+		&service.ConfigRecord{
+			Update: []*service.ConfigItem{
+				{NestedKey: []string{"opt", "lr"}, ValueJson: "0.5"},
+				{Key: "opt", ValueJson: `{"lr": 0.5, "momentum": 0.8}`},
+				{Key: "opt", ValueJson: `{"momentum": 0.7}`},
+				{Key: "epochs", ValueJson: "3"},
+			},
+			Remove: []*service.ConfigItem{
+				{Key: "opt"},
+			},
+		},
+		func(err error) { errs = append(errs, err) },
+	)
+
+	assert.Equal(t,
+		map[string]any{
+			"opt":    map[string]any{"lr": 0.1, "momentum": 0.7},
+			"epochs": int64(3),
+		},
+		runConfig.CloneTree())
+	assert.True(t, runConfig.IsLocked(pathtree.PathOf("opt", "lr", "x")))
+	assert.False(t, runConfig.IsLocked(pathtree.PathOf("opt")))
+	require.Len(t, errs, 3)
+	assert.ErrorContains(t, errs[0], `"opt.lr" was locked by "sweep"`)
+	assert.ErrorContains(t, errs[1], `"opt.lr" was locked by "sweep"`)
+	assert.ErrorContains(t, errs[2], `"opt.lr" was locked by "sweep"`)
+}
+
+func TestConfigUpdate_MergeStrategy(t *testing.T) {
+	runConfig := runconfig.NewFrom(map[string]any{
+		"a": map[string]any{"x": 1, "y": []any{1}},
+		"b": map[string]any{"x": 1, "y": []any{1}},
+		"c": []any{1},
+	})
+	runConfig.SetMergeStrategy(runconfig.MergeStrategy{AppendLists: true})
+
+	runConfig.ApplyChangeRecord(
+		&service.ConfigRecord{
+			Update: []*service.ConfigItem{
+				{Key: "a", ValueJson: `{"y": [2]}`},
+				{
+					Key:       "b",
+					ValueJson: `{"y": [2]}`,
+					DictMerge: service.ConfigItem_DICT_MERGE_REPLACE,
+				},
+				{
+					Key:       "c",
+					ValueJson: `[2]`,
+					ListMerge: service.ConfigItem_LIST_MERGE_REPLACE,
+				},
+			},
+		}, ignoreError,
+	)
+
+	assert.Equal(t,
+		map[string]any{
+			"a": map[string]any{"x": 1, "y": []any{1, int64(2)}},
+			"b": map[string]any{"y": []any{int64(2)}},
+			"c": []any{int64(2)},
+		},
+		runConfig.CloneTree())
+}
+
+func TestConfigUpdate_ReplaceKeepsLocked(t *testing.T) {
+	runConfig := runconfig.NewFrom(map[string]any{
+		"opt": map[string]any{"lr": 0.1, "momentum": 0.9},
+	})
+	runConfig.Lock(pathtree.PathOf("opt", "lr"), "sweep")
+	runConfig.SetMergeStrategy(runconfig.MergeStrategy{ReplaceDicts: true})
+
+	runConfig.ApplyChangeRecord(
+		&service.ConfigRecord{
+			Update: []*service.ConfigItem{
+				{Key: "opt", ValueJson: `{"decay": 0.5}`},
+			},
+		}, ignoreError,
+	)
+
+	assert.Equal(t,
+		map[string]any{"opt": map[string]any{"lr": 0.1, "decay": 0.5}},
+		runConfig.CloneTree())
+}
+
+func TestConfigUpdate_NonFinitePolicy(t *testing.T) {
+	runConfig := runconfig.New()
+	runConfig.SetNonFinitePolicy(nonfinite.Error)
+	var errs []error
+
+	runConfig.ApplyChangeRecord(
+		&service.ConfigRecord{
+			Update: []*service.ConfigItem{
+				{Key: "a", ValueJson: `{"b": NaN}`},
+				{Key: "c", ValueJson: "1"},
+			},
+		},
+		func(err error) { errs = append(errs, err) },
+	)
+
+	assert.Equal(t, map[string]any{"c": int64(1)}, runConfig.CloneTree())
+	require.Len(t, errs, 1)
+	assert.ErrorContains(t, errs[0], `"a"`)
+}
+
 func TestConfigRemove(t *testing.T) {
 	runConfig := runconfig.NewFrom(map[string]any{
 		"a": 9,
@@ -94,6 +335,73 @@ func TestConfigSerialize(t *testing.T) {
 	)
 }
 
+func TestConfigSerialize_Json(t *testing.T) {
+	runConfig := runconfig.NewFrom(map[string]any{
+		"number": 9,
+		"nested": map[string]any{
+			"text": "xyz",
+			"list": []string{"a", "b", "c"},
+		},
+	})
+
+	json, err := runConfig.Serialize(runconfig.FormatJson)
+
+	assert.NoError(t, err)
+	assert.Equal(t,
+		`{"nested":{"value":{"list":["a","b","c"],"text":"xyz"}},`+
+			`"number":{"value":9}}`,
+		string(json),
+	)
+}
+
+func TestConfigSerialize_PreserveOrder(t *testing.T) {
+	runConfig := runconfig.New()
+	runConfig.PreserveOrder()
+
+	runConfig.ApplyChangeRecord(
+		&service.ConfigRecord{
+			Update: []*service.ConfigItem{
+				{Key: "lr", ValueJson: "0.1"},
+				{Key: "model", ValueJson: `{"layers": 3, "dropout": 0.5}`},
+				{Key: "epochs", ValueJson: "10"},
+			},
+		},
+		ignoreError,
+	)
+	json, err := runConfig.Serialize(runconfig.FormatJson,
+		runconfig.WithExclude("epochs"))
+
+	assert.NoError(t, err)
+	assert.Equal(t,
+		`{"lr":{"value":0.1},`+
+			`"model":{"value":{"dropout":0.5,"layers":3}}}`,
+		string(json))
+}
+
+func TestConfigSerialize_Filters(t *testing.T) {
+	runConfig := runconfig.NewFrom(map[string]any{
+		"model":   map[string]any{"layers": 3, "token": "abc"},
+		"secrets": map[string]any{"key": "xyz"},
+		"lr":      0.1,
+	})
+
+	json, err := runConfig.Serialize(runconfig.FormatJson,
+		runconfig.WithInclude("model", "secrets"),
+		runconfig.WithExclude("*.tok*", "secrets"))
+
+	assert.NoError(t, err)
+	assert.Equal(t,
+		`{"model":{"value":{"layers":3}}}`,
+		string(json))
+	assert.Equal(t,
+		map[string]any{
+			"model": map[string]any{"layers": 3, "token": "abc"},
+			"lr":    0.1,
+		},
+		runConfig.CloneTree(runconfig.WithExclude("secrets")))
+	assert.Len(t, runConfig.CloneTree(), 3)
+}
+
 func TestAddTelemetryAndMetrics(t *testing.T) {
 	runConfig := runconfig.New()
 	telemetry := &service.TelemetryRecord{}
@@ -114,6 +422,32 @@ func TestAddTelemetryAndMetrics(t *testing.T) {
 	)
 }
 
+func TestRegisterMetadata(t *testing.T) {
+	runConfig := runconfig.New()
+
+	metadata, err := runConfig.RegisterMetadata("x", "test")
+	require.NoError(t, err)
+	metadata.Set(map[string]any{"a": 1})
+
+	assert.Equal(t,
+		map[string]any{"_wandb": map[string]any{"x": map[string]any{"a": 1}}},
+		runConfig.CloneTree())
+}
+
+func TestRegisterMetadata_Conflict(t *testing.T) {
+	runConfig := runconfig.New()
+	_, err := runConfig.RegisterMetadata("x", "first")
+	require.NoError(t, err)
+
+	_, errOwned := runConfig.RegisterMetadata("x", "second")
+	_, errTelemetry := runConfig.RegisterMetadata("t", "second")
+	_, errClient := runConfig.RegisterMetadata("code_path", "second")
+
+	assert.ErrorContains(t, errOwned, `already owned by "first"`)
+	assert.ErrorContains(t, errTelemetry, `already owned by "telemetry"`)
+	assert.ErrorContains(t, errClient, `already owned by "client"`)
+}
+
 func ignoreError(_err error) {}
 
 func TestCloneTree(t *testing.T) {
@@ -150,3 +484,170 @@ func TestCloneTree(t *testing.T) {
 		runConfig.CloneTree(),
 	)
 }
+
+func TestDiff(t *testing.T) {
+	old := runconfig.NewFrom(map[string]any{
+		"same":    1,
+		"changed": 2,
+		"removed": 3,
+		"nested":  map[string]any{"a": 1, "b": []any{1, 2}},
+	})
+	new := runconfig.NewFrom(map[string]any{
+		"same":    1,
+		"changed": 20,
+		"added":   4,
+		"nested":  map[string]any{"b": []any{1, 2}},
+	})
+
+	changes := old.Diff(new)
+
+	assert.Equal(t,
+		[]runconfig.ChangeItem{
+			{Kind: runconfig.ChangeAdded, Path: pathtree.PathOf("added"), Value: 4},
+			{Kind: runconfig.ChangeChanged, Path: pathtree.PathOf("changed"), Value: 20},
+			{Kind: runconfig.ChangeRemoved, Path: pathtree.PathOf("nested", "a")},
+			{Kind: runconfig.ChangeRemoved, Path: pathtree.PathOf("removed")},
+		},
+		changes)
+	assert.Empty(t, new.Diff(new))
+}
+
+func TestDelta(t *testing.T) {
+	old := runconfig.NewFrom(map[string]any{
+		"same":    1,
+		"removed": 2,
+		"nested":  map[string]any{"a": 1, "b": 2},
+	})
+	new := runconfig.NewFrom(map[string]any{
+		"same":   1,
+		"nested": map[string]any{"b": 2},
+	})
+
+	delta := new.Delta(old.Diff(new))
+
+	assert.Equal(t,
+		map[string]any{"nested": map[string]any{"b": 2}},
+		delta.CloneTree())
+}
+
+func TestStats(t *testing.T) {
+	runConfig := runconfig.NewFrom(map[string]any{
+		"lr":     0.5,
+		"model":  map[string]any{"layers": 3, "name": "x"},
+		"_wandb": map[string]any{"cli_version": "1.2.3"},
+	})
+
+	stats := runConfig.Stats()
+
+	assert.Equal(t, 3, stats.Leaves)
+	assert.Equal(t,
+		len(`{"lr":0.5,"model":{"layers":3,"name":"x"}}`),
+		stats.Bytes)
+}
+
+func TestInterpolateEnv(t *testing.T) {
+	record := &service.ConfigRecord{
+		Update: []*service.ConfigItem{
+			{Key: "data", ValueJson: `"${env:ROOT}/data"`},
+			{Key: "paths", ValueJson: `["${env:ROOT}", {"x": "${env:HOME_DIR}"}]`},
+			{NestedKey: []string{"a", "b"}, ValueJson: `"${env:UNSET} and ${env:ROOT}"`},
+			{Key: "plain", ValueJson: `1.5`},
+			{Key: "_wandb", ValueJson: `{"code": "${env:ROOT}"}`},
+		},
+	}
+	env := map[string]string{"ROOT": "/mnt", "HOME_DIR": "/home"}
+
+	err := runconfig.InterpolateEnv(record, func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	})
+
+	assert.ErrorContains(t, err,
+		`runconfig: "a.b": environment variable UNSET is not set`)
+	var values []string
+	for _, item := range record.Update {
+		values = append(values, item.ValueJson)
+	}
+	assert.Equal(t,
+		[]string{
+			`"/mnt/data"`,
+			`["/mnt",{"x":"/home"}]`,
+			`"${env:UNSET} and /mnt"`,
+			`1.5`,
+			`{"code": "${env:ROOT}"}`,
+		},
+		values)
+}
+
+func TestChangeCallback(t *testing.T) {
+	runConfig := runconfig.NewFrom(map[string]any{
+		"lr":    0.1,
+		"model": map[string]any{"layers": int64(3)},
+	})
+	var changes []runconfig.ValueChange
+	runConfig.SetChangeCallback(func(change runconfig.ValueChange) {
+		changes = append(changes, change)
+	})
+
+	runConfig.ApplyChangeRecord(
+		&service.ConfigRecord{
+			Update: []*service.ConfigItem{
+				{Key: "lr", ValueJson: "0.2"},
+				{Key: "model", ValueJson: `{"layers": 3}`},
+				{Key: "epochs", ValueJson: "5"},
+			},
+			Remove: []*service.ConfigItem{{Key: "model"}, {Key: "missing"}},
+		},
+		ignoreError,
+	)
+
+	assert.Equal(t,
+		[]runconfig.ValueChange{
+			{
+				Kind:     runconfig.ChangeChanged,
+				Path:     pathtree.PathOf("lr"),
+				OldValue: 0.1,
+				NewValue: 0.2,
+			},
+			{
+				Kind:     runconfig.ChangeAdded,
+				Path:     pathtree.PathOf("epochs"),
+				NewValue: int64(5),
+			},
+			{
+				Kind:     runconfig.ChangeRemoved,
+				Path:     pathtree.PathOf("model"),
+				OldValue: map[string]any{"layers": int64(3)},
+			},
+		},
+		changes)
+}
+
+func TestArtifactRefs(t *testing.T) {
+	runConfig := runconfig.NewFrom(map[string]any{
+		"data":    map[string]any{"$artifact": "ent/proj/dataset:prod"},
+		"model":   map[string]any{"weights": map[string]any{"$artifact": "model"}},
+		"notref":  map[string]any{"$artifact": "x", "other": 1},
+		"notname": map[string]any{"$artifact": 1},
+		"_wandb":  map[string]any{"x": map[string]any{"$artifact": "y"}},
+	})
+
+	refs := runConfig.ArtifactRefs()
+
+	assert.Equal(t,
+		[]runconfig.ArtifactRef{
+			{Path: pathtree.PathOf("data"), Name: "ent/proj/dataset:prod"},
+			{Path: pathtree.PathOf("model", "weights"), Name: "model"},
+		},
+		refs)
+}
+
+func TestParseArtifactRefName(t *testing.T) {
+	entity, project, name, alias := runconfig.ParseArtifactRefName("e/p/n:v1")
+	assert.Equal(t, []string{"e", "p", "n", "v1"},
+		[]string{entity, project, name, alias})
+
+	entity, project, name, alias = runconfig.ParseArtifactRefName("n")
+	assert.Equal(t, []string{"", "", "n", "latest"},
+		[]string{entity, project, name, alias})
+}
@@ -0,0 +1,172 @@
+package runconfig
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/wandb/simplejsonext"
+	"github.com/wandb/wandb/core/internal/pathtree"
+)
+
+// maxLargestKeys is how many of the largest keys to name when the
+// config is too large.
+const maxLargestKeys = 3
+
+// SizeLimits bounds the size of the config.
+//
+// Sizes are measured in bytes of JSON.
+type SizeLimits struct {
+	// MaxBytes is the maximum size of the whole config, or 0 for no limit.
+	MaxBytes int
+
+	// MaxValueBytes is the maximum size of a value in an update, or 0 for
+	// no limit.
+	MaxValueBytes int
+}
+
+// SizeLimitError is reported by ApplyChangeRecord for an update that is
+// skipped because it is too large.
+type SizeLimitError struct {
+	// Key is the dot-separated key of the skipped update.
+	Key string
+
+	// Bytes is the size of the value, or of the whole config after the
+	// update if IsTotal is set.
+	Bytes int
+
+	// Limit is the limit that Bytes exceeds.
+	Limit int
+
+	// IsTotal is whether the limit is on the size of the whole config.
+	IsTotal bool
+
+	// LargestKeys are the top-level keys taking up the most space,
+	// largest first, if IsTotal is set.
+	LargestKeys []string
+}
+
+func (e *SizeLimitError) Error() string {
+	if e.IsTotal {
+		return fmt.Sprintf(
+			"runconfig: skipped %q: config would be %d bytes,"+
+				" over the limit of %d (largest keys: %s)",
+			e.Key, e.Bytes, e.Limit, strings.Join(e.LargestKeys, ", "))
+	}
+
+	return fmt.Sprintf(
+		"runconfig: skipped %q: value is %d bytes, over the limit of %d",
+		e.Key, e.Bytes, e.Limit)
+}
+
+// SetSizeLimits sets limits that ApplyChangeRecord enforces.
+//
+// Existing values are kept even if they exceed the limits.
+func (rc *RunConfig) SetSizeLimits(limits SizeLimits) {
+	rc.sizeLimits = limits
+}
+
+// configSizes is the approximate encoded size of the config.
+type configSizes struct {
+	// total is the size of the whole config.
+	total int
+
+	// byKey is the size of each top-level key and its value.
+	byKey map[string]int
+}
+
+// measureSizes returns the current size of the config, or nil if
+// there is no limit on it.
+func (rc *RunConfig) measureSizes() *configSizes {
+	if rc.sizeLimits.MaxBytes <= 0 {
+		return nil
+	}
+
+	sizes := &configSizes{total: len("{}"), byKey: make(map[string]int)}
+	for key, value := range rc.pathTree.CloneTree() {
+		size := keySize(key) + encodedSize(value)
+		sizes.byKey[key] = size
+		sizes.total += size
+	}
+
+	return sizes
+}
+
+// checkSize returns an error if setting the JSON-encoded value at the
+// path would exceed a limit, and otherwise updates sizes to include it.
+func (rc *RunConfig) checkSize(
+	path pathtree.TreePath,
+	valueJson string,
+	sizes *configSizes,
+) error {
+	key := strings.Join(path.Labels(), ".")
+
+	if limit := rc.sizeLimits.MaxValueBytes; limit > 0 && len(valueJson) > limit {
+		return &SizeLimitError{Key: key, Bytes: len(valueJson), Limit: limit}
+	}
+
+	if sizes == nil {
+		return nil
+	}
+
+	delta := len(valueJson)
+	if old, exists := rc.pathTree.Get(path); exists {
+		delta -= encodedSize(old)
+	} else {
+		delta += keySize(path.End())
+	}
+
+	if limit := rc.sizeLimits.MaxBytes; delta > 0 && sizes.total+delta > limit {
+		return &SizeLimitError{
+			Key:         key,
+			Bytes:       sizes.total + delta,
+			Limit:       limit,
+			IsTotal:     true,
+			LargestKeys: sizes.largestKeys(),
+		}
+	}
+
+	top := path.Labels()[0]
+	if path.Len() == 1 {
+		sizes.byKey[top] = keySize(top) + len(valueJson)
+	} else {
+		sizes.byKey[top] += delta
+	}
+	sizes.total += delta
+	return nil
+}
+
+// largestKeys returns the top-level keys taking up the most space.
+func (sizes *configSizes) largestKeys() []string {
+	keys := make([]string, 0, len(sizes.byKey))
+	for key := range sizes.byKey {
+		keys = append(keys, key)
+	}
+
+	slices.SortFunc(keys, func(a, b string) int {
+		if sizes.byKey[a] != sizes.byKey[b] {
+			return sizes.byKey[b] - sizes.byKey[a]
+		}
+		return strings.Compare(a, b)
+	})
+
+	if len(keys) > maxLargestKeys {
+		keys = keys[:maxLargestKeys]
+	}
+	return keys
+}
+
+// keySize is the size of a key in a JSON object, including its quotes,
+// colon and separating comma.
+func keySize(key string) int {
+	return len(key) + len(`"":,`)
+}
+
+// encodedSize returns the size of a value encoded as JSON.
+func encodedSize(value any) int {
+	encoded, err := simplejsonext.Marshal(value)
+	if err != nil {
+		return 0
+	}
+	return len(encoded)
+}
@@ -0,0 +1,38 @@
+package runconfig
+
+import (
+	"github.com/wandb/wandb/core/internal/pathtree"
+)
+
+// Stats describes the size of the user's part of the config.
+type Stats struct {
+	// Bytes is the size of the config encoded as JSON.
+	Bytes int
+
+	// Leaves is the number of leaf values in the config.
+	Leaves int
+}
+
+// Stats measures the config, excluding W&B-internal values under
+// "_wandb".
+//
+// This helps identify configs that are unexpectedly large, such as
+// ones that contain a whole dataset.
+func (rc *RunConfig) Stats() Stats {
+	var stats Stats
+
+	userTree := rc.pathTree.Filter(func(path pathtree.TreePath) bool {
+		if path.Labels()[0] == metadataKey {
+			return false
+		}
+
+		stats.Leaves++
+		return true
+	})
+
+	if encoded, err := userTree.ToExtendedJSON(); err == nil {
+		stats.Bytes = len(encoded)
+	}
+
+	return stats
+}
@@ -0,0 +1,77 @@
+package runconfig
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/wandb/wandb/core/internal/pathtree"
+)
+
+// SetStrictTypes sets whether ApplyChangeRecord rejects updates whose
+// values can't be serialized in every format.
+//
+// In strict mode, a value must be a string, bool, number, list or dict,
+// recursively. Nulls and dicts with empty keys are rejected. Otherwise,
+// any decoded JSON value is stored, and errors surface only when the
+// config is serialized.
+func (rc *RunConfig) SetStrictTypes(isStrict bool) {
+	rc.isStrictTypes = isStrict
+}
+
+// checkStrictType returns an error if the value has an unsupported type
+// in strict mode.
+//
+// The error names the path to the offending part of the value.
+func checkStrictType(key string, value any) error {
+	leaf := pathtree.LeafOf(value)
+
+	switch leaf.Kind() {
+	case pathtree.KindString,
+		pathtree.KindBool,
+		pathtree.KindInt,
+		pathtree.KindFloat:
+		return nil
+
+	case pathtree.KindNull:
+		return fmt.Errorf(
+			"runconfig: %q: null is not allowed in strict mode", key)
+
+	case pathtree.KindList:
+		elements, _ := leaf.AsList()
+		for i, element := range elements {
+			elementKey := fmt.Sprintf("%s[%d]", key, i)
+			if err := checkStrictType(elementKey, element.Any()); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case pathtree.KindMap:
+		entries, _ := leaf.AsMap()
+
+		// Sorted so that the error is deterministic.
+		subkeys := make([]string, 0, len(entries))
+		for subkey := range entries {
+			subkeys = append(subkeys, subkey)
+		}
+		slices.Sort(subkeys)
+
+		for _, subkey := range subkeys {
+			if subkey == "" {
+				return fmt.Errorf(
+					"runconfig: %q: empty keys are not allowed in strict mode",
+					key)
+			}
+
+			subvalue := entries[subkey].Any()
+			if err := checkStrictType(key+"."+subkey, subvalue); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	default:
+		return fmt.Errorf(
+			"runconfig: %q: unsupported type %T in strict mode", key, value)
+	}
+}
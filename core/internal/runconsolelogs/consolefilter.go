@@ -0,0 +1,268 @@
+package runconsolelogs
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/wandb/wandb/core/internal/sparselist"
+	"golang.org/x/time/rate"
+)
+
+// consoleFilter collapses repeated lines and skips lines from overly
+// chatty programs before console output is sent to the filestream.
+//
+// Since lines are removed, the filter assigns each console line a new
+// line number in the filestream's copy of the logs. The saved output
+// file is unaffected.
+//
+// A collapsed or skipped line whose content later changes, like a
+// progress bar, is sent once it changes, so that its final contents
+// reach the filestream. It's placed after the lines sent so far rather
+// than at its original position.
+type consoleFilter struct {
+	// isCollapsing is whether to collapse identical consecutive lines.
+	isCollapsing bool
+
+	// rateLimit limits how fast new lines are sent, or is nil.
+	rateLimit *rate.Limiter
+
+	// uploadLineNums maps recent console line numbers to filestream
+	// line numbers.
+	uploadLineNums map[int]int
+
+	// hiddenLines are the recent console lines that were collapsed or
+	// skipped, by line number.
+	hiddenLines map[int]hiddenLine
+
+	// nextConsoleLineNum is one more than the largest line number seen.
+	nextConsoleLineNum int
+
+	// nextUploadLineNum is the next unused filestream line number.
+	nextUploadLineNum int
+
+	// lastUploadLineNum is the filestream line number of the last line.
+	lastUploadLineNum int
+
+	// lastKey is the stream prefix and content of the last line,
+	// used to detect repeats.
+	lastKey string
+
+	// lastText is the formatted text of the last line.
+	lastText string
+
+	// repeats is how many times the last line was repeated.
+	repeats int
+
+	// skipped is the number of lines skipped since the last line sent.
+	skipped int
+
+	// skipNotices is the number of times skipped lines were reported.
+	skipNotices int
+}
+
+// hiddenLine is a console line that wasn't sent.
+type hiddenLine struct {
+	// key is the line's lineKey when it was hidden.
+	key string
+
+	// collapsedInto is the filestream line number of the line that this
+	// one repeated, or -1 if the line was skipped.
+	collapsedInto int
+
+	// skipNotice is the value of skipNotices when the line was skipped.
+	skipNotice int
+}
+
+// newConsoleFilter returns a filter that optionally collapses repeated
+// lines, and that limits new lines to maxLinesPerSecond if it's positive.
+func newConsoleFilter(
+	isCollapsing bool,
+	maxLinesPerSecond float64,
+) *consoleFilter {
+	var rateLimit *rate.Limiter
+	if maxLinesPerSecond > 0 {
+		rateLimit = rate.NewLimiter(
+			rate.Limit(maxLinesPerSecond),
+			max(1, int(maxLinesPerSecond)),
+		)
+	}
+
+	return &consoleFilter{
+		isCollapsing:   isCollapsing,
+		rateLimit:      rateLimit,
+		uploadLineNums: make(map[int]int),
+		hiddenLines:    make(map[int]hiddenLine),
+	}
+}
+
+// Filter returns the filestream lines to update for the changed lines.
+//
+// format turns a console line into the text to upload.
+func (f *consoleFilter) Filter(
+	changes sparselist.SparseList[*RunLogsLine],
+	format func(*RunLogsLine) string,
+) sparselist.SparseList[string] {
+	lineNums := make([]int, 0, changes.Len())
+	changes.ForEach(func(lineNum int, _ *RunLogsLine) {
+		lineNums = append(lineNums, lineNum)
+	})
+	slices.Sort(lineNums)
+
+	var result sparselist.SparseList[string]
+	for _, lineNum := range lineNums {
+		line, _ := changes.Get(lineNum)
+
+		if lineNum < f.nextConsoleLineNum {
+			f.filterChangedLine(&result, lineNum, line, format)
+		} else {
+			f.filterNewLine(&result, lineNum, line, format)
+		}
+	}
+
+	return result
+}
+
+// filterChangedLine handles a change to a line seen before.
+func (f *consoleFilter) filterChangedLine(
+	result *sparselist.SparseList[string],
+	lineNum int,
+	line *RunLogsLine,
+	format func(*RunLogsLine) string,
+) {
+	if hidden, ok := f.hiddenLines[lineNum]; ok {
+		f.unhide(result, lineNum, hidden, line, format)
+		return
+	}
+
+	uploadLineNum, ok := f.uploadLineNums[lineNum]
+	if !ok {
+		return
+	}
+
+	text := format(line)
+	if uploadLineNum == f.lastUploadLineNum {
+		f.lastKey = lineKey(line)
+		f.lastText = text
+		text = f.withRepeats(text)
+	}
+
+	result.Put(uploadLineNum, text)
+}
+
+// filterNewLine handles a line seen for the first time.
+func (f *consoleFilter) filterNewLine(
+	result *sparselist.SparseList[string],
+	lineNum int,
+	line *RunLogsLine,
+	format func(*RunLogsLine) string,
+) {
+	f.nextConsoleLineNum = lineNum + 1
+
+	// Lines older than this can no longer change.
+	delete(f.uploadLineNums, lineNum-maxTerminalLines)
+	delete(f.hiddenLines, lineNum-maxTerminalLines)
+
+	key := lineKey(line)
+	switch {
+	case f.isCollapsing && len(line.Content) > 0 && key == f.lastKey:
+		f.hiddenLines[lineNum] = hiddenLine{
+			key:           key,
+			collapsedInto: f.lastUploadLineNum,
+		}
+		f.repeats++
+		result.Put(f.lastUploadLineNum, f.withRepeats(f.lastText))
+		return
+
+	case f.rateLimit != nil && !f.rateLimit.Allow():
+		f.hiddenLines[lineNum] = hiddenLine{
+			key:           key,
+			collapsedInto: -1,
+			skipNotice:    f.skipNotices,
+		}
+		f.skipped++
+		return
+	}
+
+	f.send(result, lineNum, line, key, format)
+}
+
+// unhide sends a collapsed or skipped line whose content changed.
+//
+// It bypasses the rate limit, which only applies to new lines.
+func (f *consoleFilter) unhide(
+	result *sparselist.SparseList[string],
+	lineNum int,
+	hidden hiddenLine,
+	line *RunLogsLine,
+	format func(*RunLogsLine) string,
+) {
+	key := lineKey(line)
+	if key == hidden.key {
+		return
+	}
+	delete(f.hiddenLines, lineNum)
+
+	switch {
+	case hidden.collapsedInto < 0:
+		// Don't count the line if it's not reported as skipped yet.
+		if hidden.skipNotice == f.skipNotices {
+			f.skipped--
+		}
+
+	case hidden.collapsedInto == f.lastUploadLineNum && f.repeats > 0:
+		// Older lines keep their repeat counts, which may now be one
+		// too high.
+		f.repeats--
+		result.Put(f.lastUploadLineNum, f.withRepeats(f.lastText))
+	}
+
+	f.send(result, lineNum, line, key, format)
+}
+
+// send assigns the next filestream line number to a console line.
+//
+// If lines were skipped before it, a line saying so is sent first.
+func (f *consoleFilter) send(
+	result *sparselist.SparseList[string],
+	lineNum int,
+	line *RunLogsLine,
+	key string,
+	format func(*RunLogsLine) string,
+) {
+	if f.skipped > 0 {
+		result.Put(f.nextUploadLineNum, format(&RunLogsLine{
+			Timestamp: line.Timestamp,
+		})+fmt.Sprintf(
+			"[wandb: skipped %d lines of console output]",
+			f.skipped,
+		))
+		f.nextUploadLineNum++
+		f.skipped = 0
+		f.skipNotices++
+	}
+
+	text := format(line)
+	f.uploadLineNums[lineNum] = f.nextUploadLineNum
+	f.lastUploadLineNum = f.nextUploadLineNum
+	f.lastKey = key
+	f.lastText = text
+	f.repeats = 0
+	f.nextUploadLineNum++
+
+	result.Put(f.lastUploadLineNum, text)
+}
+
+// withRepeats adds the repeat count of the last line to its text.
+func (f *consoleFilter) withRepeats(text string) string {
+	if f.repeats == 0 {
+		return text
+	}
+
+	return fmt.Sprintf("%s [repeated %d more times]", text, f.repeats)
+}
+
+// lineKey returns a string identifying a line's stream and content,
+// ignoring its timestamp.
+func lineKey(line *RunLogsLine) string {
+	return line.StreamPrefix + string(line.Content)
+}
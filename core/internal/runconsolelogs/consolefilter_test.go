@@ -0,0 +1,76 @@
+package runconsolelogs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/sparselist"
+	"github.com/wandb/wandb/core/internal/terminalemulator"
+)
+
+// filterLines passes changed lines through the filter, formatting each
+// line as its content.
+func filterLines(
+	f *consoleFilter,
+	lines map[int]string,
+) []sparselist.Run[string] {
+	var changes sparselist.SparseList[*RunLogsLine]
+	for lineNum, content := range lines {
+		changes.Put(lineNum, &RunLogsLine{
+			LineContent: terminalemulator.LineContent{Content: []rune(content)},
+		})
+	}
+
+	result := f.Filter(changes, func(line *RunLogsLine) string {
+		return string(line.Content)
+	})
+	return result.ToRuns()
+}
+
+func TestConsoleFilter_NoRateLimitByDefault(t *testing.T) {
+	f := newConsoleFilter(false, 0)
+
+	runs := filterLines(f, map[int]string{0: "a", 1: "b", 2: "c"})
+
+	assert.Equal(t,
+		[]sparselist.Run[string]{{Start: 0, Items: []string{"a", "b", "c"}}},
+		runs)
+}
+
+func TestConsoleFilter_SendsChangedSkippedLine(t *testing.T) {
+	f := newConsoleFilter(false, 0.001)
+	filterLines(f, map[int]string{0: "one", 1: "progress 0%", 2: "two"})
+
+	runs := filterLines(f, map[int]string{1: "progress 100%"})
+
+	// "two" is still skipped, so it's reported before the progress line.
+	assert.Equal(t,
+		[]sparselist.Run[string]{{Start: 1, Items: []string{
+			"[wandb: skipped 1 lines of console output]",
+			"progress 100%",
+		}}},
+		runs)
+}
+
+func TestConsoleFilter_SendsChangedCollapsedLine(t *testing.T) {
+	f := newConsoleFilter(true, 0)
+	filterLines(f, map[int]string{0: "same", 1: "same"})
+
+	runs := filterLines(f, map[int]string{1: "different"})
+
+	assert.Equal(t,
+		[]sparselist.Run[string]{{Start: 0, Items: []string{
+			"same",
+			"different",
+		}}},
+		runs)
+}
+
+func TestConsoleFilter_IgnoresUnchangedHiddenLine(t *testing.T) {
+	f := newConsoleFilter(true, 0)
+	filterLines(f, map[int]string{0: "same", 1: "same"})
+
+	runs := filterLines(f, map[int]string{1: "same"})
+
+	assert.Empty(t, runs)
+}
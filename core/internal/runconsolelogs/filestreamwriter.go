@@ -11,6 +11,12 @@ import (
 // filestreamWriter sends modified console log lines to the filestream.
 type filestreamWriter struct {
 	FileStream filestream.FileStream
+
+	// Filter collapses and rate-limits lines, if set.
+	Filter *consoleFilter
+
+	// Redactor removes secrets from lines, if set.
+	Redactor *redactor
 }
 
 func (w *filestreamWriter) SendChanged(
@@ -19,17 +25,33 @@ func (w *filestreamWriter) SendChanged(
 	// Generate timestamps in the Python ISO format (microseconds without Z)
 	const rfc3339Micro = "2006-01-02T15:04:05.000000Z07:00"
 
-	lines := sparselist.Map(changes, func(line *RunLogsLine) string {
+	format := func(line *RunLogsLine) string {
 		timestamp := strings.TrimSuffix(
 			line.Timestamp.UTC().Format(rfc3339Micro), "Z")
 
+		content := string(line.Content)
+		if w.Redactor != nil {
+			content = w.Redactor.Redact(content)
+		}
+
 		return fmt.Sprintf(
 			"%s%s %s",
 			line.StreamPrefix,
 			timestamp,
-			string(line.Content),
+			content,
 		)
-	})
+	}
+
+	var lines sparselist.SparseList[string]
+	if w.Filter != nil {
+		lines = w.Filter.Filter(changes, format)
+	} else {
+		lines = sparselist.Map(changes, format)
+	}
+
+	if lines.Len() == 0 {
+		return
+	}
 
 	w.FileStream.StreamUpdate(&filestream.LogsUpdate{
 		Lines: lines,
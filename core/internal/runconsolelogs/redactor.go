@@ -0,0 +1,111 @@
+package runconsolelogs
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// redactedText replaces secrets in console lines.
+const redactedText = "[REDACTED]"
+
+// defaultRedactPatterns match common kinds of secrets.
+//
+// Patterns with a group named "secret" redact only that group, so that
+// the surrounding context such as a variable name remains readable.
+var defaultRedactPatterns = []string{
+	// Assignments like API_KEY=... or "auth_token": "...".
+	//
+	// Values must be long to avoid matching things like max_token=512.
+	`(?i)[\w-]*(?:api[_-]?key|access[_-]?key|token|secret)\b["']?\s*[:=]\s*["']?(?P<secret>[\w.~+/=-]{12,})`,
+
+	// Assignments like password=...
+	`(?i)[\w-]*(?:password|passwd)\b["']?\s*[:=]\s*["']?(?P<secret>[^\s"',]+)`,
+
+	// Authorization headers.
+	`(?i)\bbearer\s+(?P<secret>[\w.~+/-]{12,}=*)`,
+
+	// AWS access key IDs.
+	`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`,
+
+	// GitHub tokens.
+	`\bgh[pousr]_[A-Za-z0-9]{36,}\b`,
+
+	// Slack tokens.
+	`\bxox[abprs]-[A-Za-z0-9-]{10,}`,
+}
+
+// redactor removes secrets from console lines before they are uploaded.
+type redactor struct {
+	patterns []*regexp.Regexp
+}
+
+// newRedactor returns a redactor for the default patterns and the
+// given extra patterns.
+//
+// Invalid extra patterns are logged and ignored.
+func newRedactor(
+	extraPatterns []string,
+	logger *observability.CoreLogger,
+) *redactor {
+	r := &redactor{}
+
+	for _, pattern := range defaultRedactPatterns {
+		r.patterns = append(r.patterns, regexp.MustCompile(pattern))
+	}
+
+	for _, pattern := range extraPatterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			logger.CaptureError(
+				fmt.Errorf("runconsolelogs: invalid redact pattern: %v", err),
+				"pattern", pattern)
+			continue
+		}
+
+		r.patterns = append(r.patterns, re)
+	}
+
+	return r
+}
+
+// Redact returns the line with any secrets replaced.
+func (r *redactor) Redact(line string) string {
+	for _, re := range r.patterns {
+		line = redactMatches(re, line)
+	}
+
+	return line
+}
+
+// redactMatches replaces the matches of re in the line, or only their
+// "secret" group if re has one.
+func redactMatches(re *regexp.Regexp, line string) string {
+	matches := re.FindAllStringSubmatchIndex(line, -1)
+	if len(matches) == 0 {
+		return line
+	}
+
+	group := re.SubexpIndex("secret")
+
+	var result strings.Builder
+	end := 0
+	for _, match := range matches {
+		start, stop := match[0], match[1]
+		if group > 0 {
+			start, stop = match[2*group], match[2*group+1]
+		}
+		if start < 0 {
+			continue
+		}
+
+		result.WriteString(line[end:start])
+		result.WriteString(redactedText)
+		end = stop
+	}
+	result.WriteString(line[end:])
+
+	return result.String()
+}
@@ -74,7 +74,20 @@ func New(params Params) *Sender {
 
 	var fsWriter *filestreamWriter
 	if params.FileStreamOrNil != nil {
-		fsWriter = &filestreamWriter{FileStream: params.FileStreamOrNil}
+		fsWriter = &filestreamWriter{
+			FileStream: params.FileStreamOrNil,
+			Filter: newConsoleFilter(
+				!params.Settings.IsConsoleDedupeDisabled(),
+				params.Settings.GetConsoleMaxLinesPerSecond(),
+			),
+		}
+
+		if !params.Settings.IsConsoleRedactionDisabled() {
+			fsWriter.Redactor = newRedactor(
+				params.Settings.GetConsoleRedactPatterns(),
+				params.Logger,
+			)
+		}
 	}
 
 	fileWriter, err := NewOutputFileWriter(
@@ -106,21 +119,38 @@ func New(params Params) *Sender {
 			}
 		},
 	)
+	maxLineLength := int(params.Settings.GetConsoleMaxLineLength())
+	if maxLineLength <= 0 {
+		maxLineLength = maxTerminalLineLength
+	}
+
 	model := &RunLogsChangeModel{
 		maxLines:      maxTerminalLines,
-		maxLineLength: maxTerminalLineLength,
+		maxLineLength: maxLineLength,
 		onChange:      writer.OnChanged,
 		getNow:        params.GetNow,
 	}
 
+	var termOpts []terminalemulator.TerminalOption
+	if params.Settings.IsConsoleStripANSI() {
+		termOpts = append(termOpts,
+			terminalemulator.WithStripUnknownSequences())
+	}
+	if params.Settings.IsConsoleCarriageReturnNewline() {
+		termOpts = append(termOpts,
+			terminalemulator.WithCarriageReturnLineFeed())
+	}
+
 	return &Sender{
 		stdoutTerm: terminalemulator.NewTerminal(
 			model.LineSupplier(""),
 			maxTerminalLines,
+			termOpts...,
 		),
 		stderrTerm: terminalemulator.NewTerminal(
 			model.LineSupplier("ERROR "),
 			maxTerminalLineLength,
+			termOpts...,
 		),
 
 		consoleOutputFile: params.ConsoleOutputFile,
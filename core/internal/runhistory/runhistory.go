@@ -65,20 +65,11 @@ func (rh *RunHistory) ToRecords() ([]*service.HistoryItem, error) {
 func (rh *RunHistory) ForEachNumber(
 	fn func(path pathtree.TreePath, value float64) bool,
 ) {
-	rh.metrics.ForEachLeaf(func(path pathtree.TreePath, value any) bool {
-		switch x := value.(type) {
-
-		// Numeric metrics are always float64 or int64 because all our JSON
-		// libraries decode numbers as float64/int64 and because the only
-		// allowed setters are for float64/int64.
-		case float64:
+	rh.metrics.ForEachTypedLeaf(func(path pathtree.TreePath, leaf pathtree.Leaf) bool {
+		if x, ok := leaf.AsFloat(); ok {
 			return fn(path, x)
-		case int64:
-			return fn(path, float64(x))
-
-		default:
-			return true
 		}
+		return true
 	})
 }
 
@@ -99,19 +90,21 @@ func (rh *RunHistory) ForEach(
 	onInt func(path pathtree.TreePath, value int64) bool,
 	onOther func(path pathtree.TreePath, value any) bool,
 ) {
-	rh.metrics.ForEachLeaf(func(path pathtree.TreePath, value any) bool {
-		switch x := value.(type) {
-		case float64:
+	rh.metrics.ForEachTypedLeaf(func(path pathtree.TreePath, leaf pathtree.Leaf) bool {
+		switch leaf.Kind() {
+		case pathtree.KindFloat:
 			if onFloat != nil {
+				x, _ := leaf.AsFloat()
 				return onFloat(path, x)
 			}
-		case int64:
+		case pathtree.KindInt:
 			if onInt != nil {
+				x, _ := leaf.AsInt()
 				return onInt(path, x)
 			}
 		default:
 			if onOther != nil {
-				return onOther(path, x)
+				return onOther(path, leaf.Any())
 			}
 		}
 
@@ -133,19 +126,12 @@ func (rh *RunHistory) Contains(path pathtree.TreePath) bool {
 
 // GetNumber returns the value of a number-valued metric.
 func (rh *RunHistory) GetNumber(path pathtree.TreePath) (float64, bool) {
-	value, exists := rh.metrics.GetLeaf(path)
+	leaf, exists := rh.metrics.GetTypedLeaf(path)
 	if !exists {
 		return 0, false
 	}
 
-	switch x := value.(type) {
-	case int64:
-		return float64(x), true
-	case float64:
-		return x, true
-	default:
-		return 0, false
-	}
+	return leaf.AsFloat()
 }
 
 // SetFloat sets the value of a float-valued metric.
@@ -163,6 +149,11 @@ func (rh *RunHistory) SetString(path pathtree.TreePath, value string) {
 	rh.metrics.Set(path, value)
 }
 
+// Remove deletes a metric.
+func (rh *RunHistory) Remove(path pathtree.TreePath) {
+	rh.metrics.Remove(path)
+}
+
 // SetFromRecord records one or more metrics specified in a history proto.
 //
 // If the history item contains multiple metrics, such as if its ValueJson is
@@ -36,6 +36,10 @@ type definedMetric struct {
 
 	// MetricGoal is how to interpret the "best" summary type.
 	MetricGoal metricGoal
+
+	// SampleEvery is N if only every Nth value of the metric is kept in
+	// the history, or 0 to keep every value.
+	SampleEvery int32
 }
 
 // With returns this metric definition updated with the information
@@ -58,7 +62,7 @@ func (m definedMetric) With(
 
 		m.SummaryTypes = 0
 
-		// TODO: handle "best" and "copy" summary settings
+		// TODO: handle "copy" summary settings
 		if record.Summary.Min {
 			m.SummaryTypes |= runsummary.Min
 		}
@@ -71,6 +75,12 @@ func (m definedMetric) With(
 		if record.Summary.Last {
 			m.SummaryTypes |= runsummary.Latest
 		}
+		if record.Summary.First {
+			m.SummaryTypes |= runsummary.First
+		}
+		if record.Summary.Best {
+			m.SummaryTypes |= runsummary.Best
+		}
 	}
 
 	switch record.Goal {
@@ -80,6 +90,10 @@ func (m definedMetric) With(
 		m.MetricGoal = metricGoalMinimize
 	}
 
+	if record.XSampleEvery > 0 {
+		m.SampleEvery = record.XSampleEvery
+	}
+
 	if len(record.Name) > 0 {
 		m.IsExplicit = true
 	}
@@ -101,6 +115,8 @@ func (m definedMetric) ToRecord(name string) *service.MetricRecord {
 		// definedMetric is always a complete definition rather than
 		// a partial update.
 		XControl: &service.MetricControl{Overwrite: true},
+
+		XSampleEvery: m.SampleEvery,
 	}
 
 	rec.Summary = &service.MetricSummary{
@@ -118,6 +134,12 @@ func (m definedMetric) ToRecord(name string) *service.MetricRecord {
 	if m.SummaryTypes.HasAny(runsummary.Latest) {
 		rec.Summary.Last = true
 	}
+	if m.SummaryTypes.HasAny(runsummary.First) {
+		rec.Summary.First = true
+	}
+	if m.SummaryTypes.HasAny(runsummary.Best) {
+		rec.Summary.Best = true
+	}
 
 	switch m.MetricGoal {
 	case metricGoalMaximize:
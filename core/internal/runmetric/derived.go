@@ -0,0 +1,252 @@
+package runmetric
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"unicode"
+
+	"github.com/wandb/wandb/core/internal/runhistory"
+)
+
+// expression is a parsed derived metric expression.
+//
+// It returns false if a metric it references is not a number in the
+// history.
+type expression func(history *runhistory.RunHistory) (float64, bool)
+
+// parseExpression parses an arithmetic expression over metrics.
+//
+// Expressions contain numbers, metric names, the operators + - * / and
+// parentheses. A metric name is a letter or '_' followed by letters,
+// digits, '_' and '.', where dots separate nested keys as in
+// define_metric. Other names, like "train/loss" or `file\.txt`, are
+// quoted with backticks.
+func parseExpression(text string) (expression, error) {
+	p := &expressionParser{text: []rune(text)}
+
+	expr, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaces()
+	if p.pos < len(p.text) {
+		return nil, p.errorf("unexpected %q", p.text[p.pos])
+	}
+
+	return expr, nil
+}
+
+// expressionParser is a recursive descent parser for expressions.
+type expressionParser struct {
+	text []rune
+	pos  int
+}
+
+func (p *expressionParser) errorf(format string, args ...any) error {
+	return fmt.Errorf(
+		"runmetric: invalid expression %q at offset %d: %s",
+		string(p.text),
+		p.pos,
+		fmt.Sprintf(format, args...),
+	)
+}
+
+func (p *expressionParser) skipSpaces() {
+	for p.pos < len(p.text) && unicode.IsSpace(p.text[p.pos]) {
+		p.pos++
+	}
+}
+
+// consume skips spaces and then the given operator if it's next.
+func (p *expressionParser) consume(op rune) bool {
+	p.skipSpaces()
+	if p.pos < len(p.text) && p.text[p.pos] == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// parseSum parses terms separated by '+' and '-'.
+func (p *expressionParser) parseSum() (expression, error) {
+	expr, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		var op rune
+		switch {
+		case p.consume('+'):
+			op = '+'
+		case p.consume('-'):
+			op = '-'
+		default:
+			return expr, nil
+		}
+
+		rhs, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		expr = binaryExpression(op, expr, rhs)
+	}
+}
+
+// parseProduct parses factors separated by '*' and '/'.
+func (p *expressionParser) parseProduct() (expression, error) {
+	expr, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		var op rune
+		switch {
+		case p.consume('*'):
+			op = '*'
+		case p.consume('/'):
+			op = '/'
+		default:
+			return expr, nil
+		}
+
+		rhs, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		expr = binaryExpression(op, expr, rhs)
+	}
+}
+
+// parseFactor parses a number, a metric, a negation or a parenthesized
+// expression.
+func (p *expressionParser) parseFactor() (expression, error) {
+	switch {
+	case p.consume('-'):
+		operand, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return func(history *runhistory.RunHistory) (float64, bool) {
+			x, ok := operand(history)
+			return -x, ok
+		}, nil
+
+	case p.consume('('):
+		expr, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(')') {
+			return nil, p.errorf("expected ')'")
+		}
+		return expr, nil
+
+	case p.consume('`'):
+		start := p.pos
+		for p.pos < len(p.text) && p.text[p.pos] != '`' {
+			p.pos++
+		}
+		if p.pos == len(p.text) {
+			return nil, p.errorf("unterminated '`'")
+		}
+		name := string(p.text[start:p.pos])
+		p.pos++
+		return metricExpression(name), nil
+	}
+
+	if p.pos == len(p.text) {
+		return nil, p.errorf("unexpected end")
+	}
+
+	start := p.pos
+	switch r := p.text[p.pos]; {
+	case unicode.IsDigit(r) || r == '.':
+		for p.pos < len(p.text) &&
+			(unicode.IsDigit(p.text[p.pos]) || p.text[p.pos] == '.') {
+			p.pos++
+		}
+		x, err := strconv.ParseFloat(string(p.text[start:p.pos]), 64)
+		if err != nil {
+			return nil, p.errorf("bad number")
+		}
+		return func(*runhistory.RunHistory) (float64, bool) {
+			return x, true
+		}, nil
+
+	case unicode.IsLetter(r) || r == '_':
+		for p.pos < len(p.text) && isNameRune(p.text[p.pos]) {
+			p.pos++
+		}
+		return metricExpression(string(p.text[start:p.pos])), nil
+
+	default:
+		return nil, p.errorf("unexpected %q", r)
+	}
+}
+
+// isNameRune reports whether the rune can appear in an unquoted name.
+func isNameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.'
+}
+
+// metricExpression returns the value of a metric in the history.
+func metricExpression(name string) expression {
+	path := metricPath(name)
+
+	return func(history *runhistory.RunHistory) (float64, bool) {
+		return history.GetNumber(path)
+	}
+}
+
+// binaryExpression combines two expressions with an operator.
+func binaryExpression(op rune, lhs, rhs expression) expression {
+	return func(history *runhistory.RunHistory) (float64, bool) {
+		x, ok := lhs(history)
+		if !ok {
+			return 0, false
+		}
+		y, ok := rhs(history)
+		if !ok {
+			return 0, false
+		}
+
+		switch op {
+		case '+':
+			return x + y, true
+		case '-':
+			return x - y, true
+		case '*':
+			return x * y, true
+		default:
+			return x / y, true
+		}
+	}
+}
+
+// InsertDerivedMetrics sets the value of every derived metric whose
+// inputs are all numbers in the history.
+//
+// Derived metrics are evaluated in the order they were defined, so one
+// can use another defined before it. Values that are already in the
+// history and results that are NaN or infinite are skipped.
+func (mh *MetricHandler) InsertDerivedMetrics(
+	history *runhistory.RunHistory,
+) {
+	for _, name := range mh.derivedOrder {
+		path := metricPath(name)
+		if history.Contains(path) {
+			continue
+		}
+
+		value, ok := mh.derivedMetrics[name](history)
+		if !ok || math.IsNaN(value) || math.IsInf(value, 0) {
+			continue
+		}
+
+		history.SetFloat(path, value)
+	}
+}
@@ -0,0 +1,86 @@
+package runmetric
+
+import (
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/pathtree"
+)
+
+// metricNameEscape makes the next '.' or '\' in a metric name literal.
+const metricNameEscape = '\\'
+
+// metricPath returns the history path that a metric name refers to.
+//
+// Dots separate nested keys, so "train.loss" is the "loss" key inside
+// "train". A backslash escapes a literal dot or backslash, so
+// `file\.txt` is a single top-level key "file.txt". Slashes have no
+// special meaning: "train/loss" is one key.
+func metricPath(name string) pathtree.TreePath {
+	var labels []string
+	var label strings.Builder
+
+	escaped := false
+	for _, r := range name {
+		switch {
+		case escaped:
+			if r != '.' && r != metricNameEscape {
+				label.WriteRune(metricNameEscape)
+			}
+			label.WriteRune(r)
+			escaped = false
+		case r == metricNameEscape:
+			escaped = true
+		case r == '.':
+			labels = append(labels, label.String())
+			label.Reset()
+		default:
+			label.WriteRune(r)
+		}
+	}
+
+	if escaped {
+		label.WriteRune(metricNameEscape)
+	}
+	labels = append(labels, label.String())
+
+	return pathtree.PathOf(labels[0], labels[1:]...)
+}
+
+// metricName returns the metric name that refers to a history path.
+//
+// It is the inverse of metricPath.
+func metricName(path pathtree.TreePath) string {
+	labels := path.Labels()
+	escapedLabels := make([]string, len(labels))
+
+	for i, label := range labels {
+		escapedLabels[i] = escapeMetricLabel(label)
+	}
+
+	return strings.Join(escapedLabels, ".")
+}
+
+// escapeMetricLabel escapes dots and backslashes in a history key.
+func escapeMetricLabel(label string) string {
+	if !strings.ContainsAny(label, `.\`) {
+		return label
+	}
+
+	var escaped strings.Builder
+	for _, r := range label {
+		if r == '.' || r == metricNameEscape {
+			escaped.WriteRune(metricNameEscape)
+		}
+		escaped.WriteRune(r)
+	}
+	return escaped.String()
+}
+
+// displayName returns the metric name as the W&B UI writes it, with
+// nested keys joined by dots and no escaping.
+//
+// Distinct metrics can have the same display name, so it is only used
+// where the run is presented, such as the run config.
+func displayName(name string) string {
+	return strings.Join(metricPath(name).Labels(), ".")
+}
@@ -0,0 +1,150 @@
+package runmetric
+
+import (
+	"fmt"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// RedefinePolicy is how a metric definition combines with an earlier
+// definition of the same metric.
+//
+// A MetricRecord that sets the overwrite control flag always replaces
+// the earlier definition.
+type RedefinePolicy int
+
+const (
+	// RedefineMerge replaces the fields that the new definition sets and
+	// keeps the others.
+	RedefineMerge RedefinePolicy = iota
+
+	// RedefineOverwrite replaces the earlier definition entirely.
+	RedefineOverwrite
+
+	// RedefineKeepFirst keeps the fields that the earlier definition set
+	// and only takes fields that it left unset from the new definition.
+	RedefineKeepFirst
+)
+
+// ParseRedefinePolicy parses a policy name: "merge", "overwrite" or
+// "keep_first". The empty string is RedefineMerge.
+func ParseRedefinePolicy(name string) (RedefinePolicy, error) {
+	switch name {
+	case "", "merge":
+		return RedefineMerge, nil
+	case "overwrite":
+		return RedefineOverwrite, nil
+	case "keep_first":
+		return RedefineKeepFirst, nil
+	default:
+		return RedefineMerge, fmt.Errorf(
+			"runmetric: unknown redefine policy %q", name)
+	}
+}
+
+// RedefineConflict is a metric definition that disagrees with an
+// earlier definition of the same metric.
+type RedefineConflict struct {
+	// Name is the metric's name or glob.
+	Name string
+
+	// Fields are the MetricRecord fields that both definitions set to
+	// different values.
+	Fields []string
+}
+
+// conflictsWith returns the fields that both definitions set to
+// different values.
+func (m definedMetric) conflictsWith(other definedMetric) []string {
+	var fields []string
+
+	if m.Step != "" && other.Step != "" && m.Step != other.Step {
+		fields = append(fields, "step_metric")
+	}
+	if m.hasSummary() && other.hasSummary() &&
+		(m.NoSummary != other.NoSummary || m.SummaryTypes != other.SummaryTypes) {
+		fields = append(fields, "summary")
+	}
+	if m.MetricGoal != metricGoalUnset && other.MetricGoal != metricGoalUnset &&
+		m.MetricGoal != other.MetricGoal {
+		fields = append(fields, "goal")
+	}
+	if m.SampleEvery != 0 && other.SampleEvery != 0 &&
+		m.SampleEvery != other.SampleEvery {
+		fields = append(fields, "_sample_every")
+	}
+
+	return fields
+}
+
+// hasSummary returns whether the definition configures the summary.
+func (m definedMetric) hasSummary() bool {
+	return m.NoSummary || !m.SummaryTypes.IsEmpty()
+}
+
+// withUnsetFrom returns this definition with the fields that it leaves
+// unset taken from the other one.
+func (m definedMetric) withUnsetFrom(other definedMetric) definedMetric {
+	if m.Step == "" {
+		m.Step = other.Step
+	}
+	if !m.hasSummary() {
+		m.NoSummary = other.NoSummary
+		m.SummaryTypes = other.SummaryTypes
+	}
+	if m.MetricGoal == metricGoalUnset {
+		m.MetricGoal = other.MetricGoal
+	}
+	if m.SampleEvery == 0 {
+		m.SampleEvery = other.SampleEvery
+	}
+
+	m.SyncStep = m.SyncStep || other.SyncStep
+	m.IsHidden = m.IsHidden || other.IsHidden
+	m.IsExplicit = m.IsExplicit || other.IsExplicit
+
+	return m
+}
+
+// SetRedefinePolicy sets how a metric definition combines with an
+// earlier definition of the same metric.
+func (mh *MetricHandler) SetRedefinePolicy(policy RedefinePolicy) {
+	mh.redefinePolicy = policy
+}
+
+// SetConflictCallback sets a function to call when a definition
+// disagrees with an earlier definition of the same metric.
+func (mh *MetricHandler) SetConflictCallback(
+	onConflict func(RedefineConflict),
+) {
+	mh.onConflict = onConflict
+}
+
+// redefine combines a new definition of a metric with its earlier
+// definition, if any, according to the redefine policy.
+func (mh *MetricHandler) redefine(
+	name string,
+	prev definedMetric,
+	hasPrev bool,
+	record *service.MetricRecord,
+) definedMetric {
+	incoming := definedMetric{}.With(record)
+	if !hasPrev {
+		return incoming
+	}
+
+	if fields := prev.conflictsWith(incoming); len(fields) > 0 &&
+		mh.onConflict != nil {
+		mh.onConflict(RedefineConflict{Name: name, Fields: fields})
+	}
+
+	switch {
+	case record.GetXControl().GetOverwrite() ||
+		mh.redefinePolicy == RedefineOverwrite:
+		return incoming
+	case mh.redefinePolicy == RedefineKeepFirst:
+		return prev.withUnsetFrom(incoming)
+	default:
+		return prev.With(record)
+	}
+}
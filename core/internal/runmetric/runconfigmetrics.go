@@ -17,6 +17,20 @@ func NewRunConfigMetrics() *RunConfigMetrics {
 	}
 }
 
+// SetRedefinePolicy sets how a metric definition combines with an
+// earlier definition of the same metric.
+func (rcm *RunConfigMetrics) SetRedefinePolicy(policy RedefinePolicy) {
+	rcm.handler.SetRedefinePolicy(policy)
+}
+
+// SetConflictCallback sets a function to call when a definition
+// disagrees with an earlier definition of the same metric.
+func (rcm *RunConfigMetrics) SetConflictCallback(
+	onConflict func(RedefineConflict),
+) {
+	rcm.handler.SetConflictCallback(onConflict)
+}
+
 // ProcessRecord updates metric definitions.
 func (rcm *RunConfigMetrics) ProcessRecord(record *service.MetricRecord) error {
 	return rcm.handler.ProcessRecord(record)
@@ -61,7 +75,7 @@ func (rcm *RunConfigMetrics) encodeToRunConfigData(
 	// fully built it at the end of the method.
 	encodedMetrics = append(encodedMetrics, nil)
 
-	record := metric.ToRecord(name)
+	record := metric.ToRecord(displayName(name))
 	defer func() {
 		encodedMetrics[index] = corelib.ProtoEncodeToDict(record)
 	}()
@@ -30,3 +30,100 @@ func TestMetricSelfStep(t *testing.T) {
 	assert.Equal(t, config[xidx]["5"], 1+int64(yidx))
 	assert.Equal(t, config[yidx]["5"], 1+int64(xidx))
 }
+
+func TestMetricHidden(t *testing.T) {
+	rcm := runmetric.NewRunConfigMetrics()
+	_ = rcm.ProcessRecord(&service.MetricRecord{
+		Name:    "x",
+		Options: &service.MetricOptions{Hidden: true},
+	})
+
+	config := rcm.ToRunConfigData()
+
+	// Field 6 is "options"; bool messages are encoded as a list of
+	// the numbers of their true fields, and 2 is "hidden".
+	assert.Len(t, config, 1)
+	assert.Contains(t, config[0]["6"], int64(2))
+}
+
+func TestRedefinePolicy(t *testing.T) {
+	first := &service.MetricRecord{
+		Name:    "x",
+		Options: &service.MetricOptions{Hidden: true},
+		Summary: &service.MetricSummary{Min: true},
+	}
+	second := &service.MetricRecord{
+		Name:    "x",
+		Summary: &service.MetricSummary{Max: true},
+	}
+
+	testCases := []struct {
+		name    string
+		policy  runmetric.RedefinePolicy
+		options []int64
+		summary []int64
+	}{
+		// Field numbers: "hidden" and "defined" are 2 and 3 in
+		// MetricOptions, and "min" and "max" are 1 and 2 in MetricSummary.
+		{"merge", runmetric.RedefineMerge, []int64{2, 3}, []int64{2}},
+		{"overwrite", runmetric.RedefineOverwrite, []int64{3}, []int64{2}},
+		{"keep first", runmetric.RedefineKeepFirst, []int64{2, 3}, []int64{1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var conflicts []runmetric.RedefineConflict
+			rcm := runmetric.NewRunConfigMetrics()
+			rcm.SetRedefinePolicy(tc.policy)
+			rcm.SetConflictCallback(func(c runmetric.RedefineConflict) {
+				conflicts = append(conflicts, c)
+			})
+
+			_ = rcm.ProcessRecord(first)
+			_ = rcm.ProcessRecord(second)
+			config := rcm.ToRunConfigData()
+
+			assert.Equal(t,
+				[]runmetric.RedefineConflict{{Name: "x", Fields: []string{"summary"}}},
+				conflicts)
+			assert.Len(t, config, 1)
+			assert.ElementsMatch(t, tc.options, config[0]["6"])
+			assert.ElementsMatch(t, tc.summary, config[0]["7"])
+		})
+	}
+}
+
+func TestParseRedefinePolicy(t *testing.T) {
+	policy, err := runmetric.ParseRedefinePolicy("keep_first")
+	assert.NoError(t, err)
+	assert.Equal(t, runmetric.RedefineKeepFirst, policy)
+
+	_, err = runmetric.ParseRedefinePolicy("last")
+	assert.ErrorContains(t, err, `unknown redefine policy "last"`)
+}
+
+func TestMetricEscapedName(t *testing.T) {
+	rcm := runmetric.NewRunConfigMetrics()
+	_ = rcm.ProcessRecord(&service.MetricRecord{Name: `file\.txt`})
+
+	config := rcm.ToRunConfigData()
+
+	// The UI names metrics by their dot-joined history keys.
+	assert.Len(t, config, 1)
+	assert.Equal(t, "file.txt", config[0]["1"])
+}
+
+func TestMetricRemoved(t *testing.T) {
+	rcm := runmetric.NewRunConfigMetrics()
+	_ = rcm.ProcessRecord(&service.MetricRecord{Name: "x"})
+	_ = rcm.ProcessRecord(&service.MetricRecord{Name: "y"})
+	_ = rcm.ProcessRecord(&service.MetricRecord{
+		Name:     "x",
+		XControl: &service.MetricControl{Remove: true},
+	})
+
+	config := rcm.ToRunConfigData()
+
+	assert.Len(t, config, 1)
+	assert.Equal(t, "y", config[0]["1"])
+}
@@ -2,7 +2,7 @@ package runmetric
 
 import (
 	"errors"
-	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/wandb/wandb/core/internal/pathtree"
@@ -15,8 +15,36 @@ type MetricHandler struct {
 	definedMetrics map[string]definedMetric
 	globMetrics    map[string]definedMetric
 
+	// globOrder lists the keys of globMetrics in the order they were
+	// first defined, which is the order in which they are matched.
+	globOrder []string
+
 	// latestStep tracks the latest value of every step metric.
 	latestStep map[string]float64
+
+	// loggedSteps are the step metrics that have appeared in the history.
+	loggedSteps map[string]bool
+
+	// syntheticSteps is the next value to insert for each step metric
+	// that is synthesized because it was never logged.
+	syntheticSteps map[string]int64
+
+	// sampledValues counts the values of each downsampled metric that
+	// have appeared in the history.
+	sampledValues map[string]int64
+
+	// redefinePolicy is how definitions of the same metric combine.
+	redefinePolicy RedefinePolicy
+
+	// onConflict, if set, is called when definitions of a metric disagree.
+	onConflict func(RedefineConflict)
+
+	// derivedMetrics are the expressions of derived metrics by name.
+	derivedMetrics map[string]expression
+
+	// derivedOrder lists the keys of derivedMetrics in the order they
+	// were first defined, which is the order they're evaluated in.
+	derivedOrder []string
 }
 
 func New() *MetricHandler {
@@ -24,6 +52,10 @@ func New() *MetricHandler {
 		definedMetrics: make(map[string]definedMetric),
 		globMetrics:    make(map[string]definedMetric),
 		latestStep:     make(map[string]float64),
+		loggedSteps:    make(map[string]bool),
+		syntheticSteps: make(map[string]int64),
+		sampledValues:  make(map[string]int64),
+		derivedMetrics: make(map[string]expression),
 	}
 }
 
@@ -35,6 +67,19 @@ func (mh *MetricHandler) Exists(key string) bool {
 
 // ProcessRecord updates metric definitions.
 func (mh *MetricHandler) ProcessRecord(record *service.MetricRecord) error {
+	var derived expression
+	if len(record.XExpression) > 0 {
+		if len(record.Name) == 0 {
+			return errors.New("runmetric: only a named metric can have an expression")
+		}
+
+		var err error
+		derived, err = parseExpression(record.XExpression)
+		if err != nil {
+			return err
+		}
+	}
+
 	if len(record.StepMetric) > 0 {
 		if _, ok := mh.latestStep[record.StepMetric]; !ok {
 			mh.latestStep[record.StepMetric] = 0
@@ -50,6 +95,10 @@ func (mh *MetricHandler) ProcessRecord(record *service.MetricRecord) error {
 	case len(record.GlobName) > 0:
 		metricByKey = mh.globMetrics
 		key = record.GlobName
+
+		if _, exists := mh.globMetrics[key]; !exists {
+			mh.globOrder = append(mh.globOrder, key)
+		}
 	case len(record.StepMetric) > 0:
 		// This is an explicit X axis; nothing to do.
 		return nil
@@ -57,36 +106,103 @@ func (mh *MetricHandler) ProcessRecord(record *service.MetricRecord) error {
 		return errors.New("runmetric: name, glob_name or step_metric must be set")
 	}
 
-	var prev definedMetric
-	if !record.GetXControl().GetOverwrite() {
-		prev = metricByKey[key]
+	if record.GetXControl().GetRemove() {
+		mh.remove(record)
+		return nil
 	}
 
-	updated := prev.With(record)
-	metricByKey[key] = updated
+	prev, hasPrev := metricByKey[key]
+	metricByKey[key] = mh.redefine(key, prev, hasPrev, record)
+
+	if len(record.Name) > 0 {
+		mh.updateDerivedMetric(
+			record.Name,
+			derived,
+			record.GetXControl().GetOverwrite(),
+		)
+	}
 
 	return nil
 }
 
+// remove deletes the definition of a metric or glob.
+//
+// Metrics that were already created by matching a removed glob keep
+// their definitions.
+func (mh *MetricHandler) remove(record *service.MetricRecord) {
+	if len(record.Name) > 0 {
+		delete(mh.definedMetrics, record.Name)
+		delete(mh.sampledValues, record.Name)
+		mh.updateDerivedMetric(record.Name, nil, true)
+		return
+	}
+
+	delete(mh.globMetrics, record.GlobName)
+	mh.globOrder = slices.DeleteFunc(
+		mh.globOrder,
+		func(other string) bool { return other == record.GlobName },
+	)
+}
+
+// updateDerivedMetric sets or clears the expression of a metric.
+//
+// A nil expression leaves an existing one unless overwrite is true.
+func (mh *MetricHandler) updateDerivedMetric(
+	name string,
+	derived expression,
+	overwrite bool,
+) {
+	_, exists := mh.derivedMetrics[name]
+
+	switch {
+	case derived != nil:
+		if !exists {
+			mh.derivedOrder = append(mh.derivedOrder, name)
+		}
+		mh.derivedMetrics[name] = derived
+
+	case overwrite && exists:
+		delete(mh.derivedMetrics, name)
+		mh.derivedOrder = slices.DeleteFunc(
+			mh.derivedOrder,
+			func(other string) bool { return other == name },
+		)
+	}
+}
+
 // UpdateSummary updates the statistics tracked in the run summary
 // for the given metric.
+//
+// If the metric is not defined, its summary is reset to the default of
+// tracking the latest value.
 func (mh *MetricHandler) UpdateSummary(
 	name string,
 	summary *runsummary.RunSummary,
 ) {
-	metric, ok := mh.definedMetrics[name]
-
-	if !ok {
+	if len(name) == 0 {
 		return
 	}
+	path := metricPath(name)
 
-	if len(name) == 0 {
+	metric, ok := mh.definedMetrics[name]
+	if !ok {
+		summary.ConfigureMetric(path, false, runsummary.Unset)
+		summary.ConfigureBest(path, false, nil)
 		return
 	}
-	parts := strings.Split(name, ".")
-	path := pathtree.PathOf(parts[0], parts[1:]...)
 
 	summary.ConfigureMetric(path, metric.NoSummary, metric.SummaryTypes)
+
+	var stepPath *pathtree.TreePath
+	if len(metric.Step) > 0 {
+		step := metricPath(metric.Step)
+		stepPath = &step
+	}
+	summary.ConfigureBest(
+		path,
+		metric.MetricGoal == metricGoalMaximize,
+		stepPath,
+	)
 }
 
 // UpdateMetrics creates new metric definitions from globs that
@@ -101,15 +217,13 @@ func (mh *MetricHandler) UpdateMetrics(
 		if len(key) == 0 {
 			continue
 		}
-		keyLabels := strings.Split(key, ".")
-		keyPath := pathtree.PathOf(keyLabels[0], keyLabels[1:]...)
-
-		latest, ok := history.GetNumber(keyPath)
+		latest, ok := history.GetNumber(metricPath(key))
 		if !ok {
 			continue
 		}
 
 		mh.latestStep[key] = latest
+		mh.loggedSteps[key] = true
 	}
 
 	return mh.createGlobMetrics(history)
@@ -117,31 +231,50 @@ func (mh *MetricHandler) UpdateMetrics(
 
 // InsertStepMetrics inserts an automatic step metric for every defined
 // metric with step_sync set to true.
+//
+// If a step metric has never been logged, a synthetic value is inserted
+// for every metric that uses it, whether or not step_sync is set, so
+// that the metric has an x-axis. The synthetic value is a counter that
+// increases by one for each history row that needs it. Step metrics that
+// start with an underscore are internal and never synthesized.
+//
+// Returns the step metrics that were synthesized for the first time.
 func (mh *MetricHandler) InsertStepMetrics(
 	history *runhistory.RunHistory,
-) {
+) []string {
+	var newSyntheticSteps []string
+
 	history.ForEachKey(func(path pathtree.TreePath) bool {
-		key := strings.Join(path.Labels(), ".")
+		key := metricName(path)
 		metricDef, ok := mh.definedMetrics[key]
-		if !ok {
+		if !ok || metricDef.Step == "" {
 			return true
 		}
 
-		// Skip any metrics that do not need to be synced.
-		if metricDef.Step == "" || !metricDef.SyncStep {
+		stepMetricPath := metricPath(metricDef.Step)
+
+		// Skip if the step is already set.
+		if history.Contains(stepMetricPath) {
 			return true
 		}
 
-		stepMetricLabels := strings.Split(metricDef.Step, ".")
-		stepMetricPath := pathtree.PathOf(
-			stepMetricLabels[0],
-			stepMetricLabels[1:]...,
-		)
+		if !mh.loggedSteps[metricDef.Step] &&
+			!strings.HasPrefix(metricDef.Step, "_") {
+			next, exists := mh.syntheticSteps[metricDef.Step]
+			if !exists {
+				newSyntheticSteps = append(newSyntheticSteps, metricDef.Step)
+			}
 
-		// Skip if the step is already set.
-		if history.Contains(stepMetricPath) {
+			history.SetInt(stepMetricPath, next)
+			mh.syntheticSteps[metricDef.Step] = next + 1
+			return true
+		}
+
+		// Skip any metrics that do not need to be synced.
+		if !metricDef.SyncStep {
 			return true
 		}
+
 		latest, ok := mh.latestStep[metricDef.Step]
 		// This should never happen, but we'll skip the metric if it does.
 		if !ok {
@@ -150,6 +283,37 @@ func (mh *MetricHandler) InsertStepMetrics(
 		history.SetFloat(stepMetricPath, latest)
 		return true
 	})
+
+	return newSyntheticSteps
+}
+
+// DownsampleHistory removes values of metrics with a sampling hint that
+// are not kept.
+//
+// A metric that keeps every Nth value keeps its 1st, (N+1)th, (2N+1)th
+// and so on.
+func (mh *MetricHandler) DownsampleHistory(history *runhistory.RunHistory) {
+	var dropped []pathtree.TreePath
+
+	history.ForEachKey(func(path pathtree.TreePath) bool {
+		key := metricName(path)
+		metricDef, ok := mh.definedMetrics[key]
+		if !ok || metricDef.SampleEvery <= 1 {
+			return true
+		}
+
+		count := mh.sampledValues[key]
+		mh.sampledValues[key] = count + 1
+
+		if count%int64(metricDef.SampleEvery) != 0 {
+			dropped = append(dropped, path)
+		}
+		return true
+	})
+
+	for _, path := range dropped {
+		history.Remove(path)
+	}
 }
 
 // createGlobMetrics returns new metric definitions created by matching
@@ -160,7 +324,7 @@ func (mh *MetricHandler) createGlobMetrics(
 	var newMetrics []*service.MetricRecord
 
 	history.ForEachKey(func(path pathtree.TreePath) bool {
-		key := strings.Join(path.Labels(), ".")
+		key := metricName(path)
 
 		// Skip metrics prefixed by an underscore, which are internal to W&B.
 		if strings.HasPrefix(key, "_") {
@@ -185,17 +349,51 @@ func (mh *MetricHandler) createGlobMetrics(
 }
 
 // matchGlobMetric returns a new metric definition if the key matches
-// a glob metric, and otherwise returns nil.
+// a glob metric.
+//
+// If several globs match, the one defined first is used.
 func (mh *MetricHandler) matchGlobMetric(key string) (definedMetric, bool) {
-	for glob, metric := range mh.globMetrics {
-		match, err := filepath.Match(glob, key)
-
-		if err != nil || !match {
-			continue
+	for _, glob := range mh.globOrder {
+		if matchGlob(glob, key) {
+			return mh.globMetrics[glob], true
 		}
-
-		return metric, true
 	}
 
 	return definedMetric{}, false
 }
+
+// matchGlob reports whether the key matches the glob.
+//
+// A '*' matches any sequence of characters, including '/' and '.', so
+// that "val/*" matches every metric under "val/". A '?' matches any
+// single character. Other characters match themselves.
+func matchGlob(glob, key string) bool {
+	globRunes := []rune(glob)
+	keyRunes := []rune(key)
+
+	// On a mismatch, backtrack to the last '*' and let it match one more
+	// character.
+	var g, k int
+	starG, starK := -1, 0
+	for k < len(keyRunes) {
+		switch {
+		case g < len(globRunes) && globRunes[g] == '*':
+			starG, starK = g, k
+			g++
+		case g < len(globRunes) &&
+			(globRunes[g] == '?' || globRunes[g] == keyRunes[k]):
+			g++
+			k++
+		case starG >= 0:
+			starK++
+			g, k = starG+1, starK
+		default:
+			return false
+		}
+	}
+
+	for g < len(globRunes) && globRunes[g] == '*' {
+		g++
+	}
+	return g == len(globRunes)
+}
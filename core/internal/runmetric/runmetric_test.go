@@ -0,0 +1,268 @@
+package runmetric_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/pathtree"
+	"github.com/wandb/wandb/core/internal/runhistory"
+	"github.com/wandb/wandb/core/internal/runmetric"
+	"github.com/wandb/wandb/core/internal/runsummary"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// historyWith returns a history containing the given keys.
+func historyWith(keys ...string) *runhistory.RunHistory {
+	history := runhistory.New()
+	for _, key := range keys {
+		history.SetFloat(pathtree.PathOf(key), 1)
+	}
+	return history
+}
+
+func TestGlobMetric_MatchesNestedNames(t *testing.T) {
+	mh := runmetric.New()
+	_ = mh.ProcessRecord(&service.MetricRecord{
+		GlobName:   "val/*",
+		StepMetric: "epoch",
+	})
+
+	newMetrics := mh.UpdateMetrics(historyWith("val/a/loss", "train/loss"))
+
+	assert.Len(t, newMetrics, 1)
+	assert.Equal(t, "val/a/loss", newMetrics[0].Name)
+	assert.Equal(t, "epoch", newMetrics[0].StepMetric)
+	assert.True(t, mh.Exists("val/a/loss"))
+	assert.False(t, mh.Exists("train/loss"))
+}
+
+func TestGlobMetric_FirstDefinedGlobWins(t *testing.T) {
+	mh := runmetric.New()
+	_ = mh.ProcessRecord(&service.MetricRecord{
+		GlobName:   "val/*",
+		StepMetric: "epoch",
+	})
+	_ = mh.ProcessRecord(&service.MetricRecord{
+		GlobName:   "*",
+		StepMetric: "step",
+	})
+
+	newMetrics := mh.UpdateMetrics(historyWith("val/loss", "acc"))
+
+	steps := make(map[string]string)
+	for _, metric := range newMetrics {
+		steps[metric.Name] = metric.StepMetric
+	}
+	assert.Equal(t, map[string]string{"val/loss": "epoch", "acc": "step"}, steps)
+}
+
+func TestGlobMetric_OnlyNewKeys(t *testing.T) {
+	mh := runmetric.New()
+	_ = mh.ProcessRecord(&service.MetricRecord{GlobName: "x?"})
+	_ = mh.UpdateMetrics(historyWith("x1"))
+
+	newMetrics := mh.UpdateMetrics(historyWith("x1", "x2", "x10", "_x3"))
+
+	assert.Len(t, newMetrics, 1)
+	assert.Equal(t, "x2", newMetrics[0].Name)
+}
+
+func TestDerivedMetric(t *testing.T) {
+	mh := runmetric.New()
+	err := mh.ProcessRecord(&service.MetricRecord{
+		Name:        "throughput",
+		XExpression: "samples / (`time/delta` * 2) - -1",
+	})
+	require.NoError(t, err)
+
+	history := runhistory.New()
+	history.SetInt(pathtree.PathOf("samples"), 100)
+	history.SetFloat(pathtree.PathOf("time/delta"), 5)
+	mh.InsertDerivedMetrics(history)
+
+	value, ok := history.GetNumber(pathtree.PathOf("throughput"))
+	assert.True(t, ok)
+	assert.Equal(t, 11.0, value)
+}
+
+func TestDerivedMetric_SkippedWithoutInputs(t *testing.T) {
+	mh := runmetric.New()
+	_ = mh.ProcessRecord(&service.MetricRecord{
+		Name:        "ratio",
+		XExpression: "a.b / c",
+	})
+
+	missing := historyWith("c")
+	zero := runhistory.New()
+	zero.SetFloat(pathtree.PathOf("a", "b"), 1)
+	zero.SetFloat(pathtree.PathOf("c"), 0)
+	mh.InsertDerivedMetrics(missing)
+	mh.InsertDerivedMetrics(zero)
+
+	assert.False(t, missing.Contains(pathtree.PathOf("ratio")))
+	assert.False(t, zero.Contains(pathtree.PathOf("ratio")))
+}
+
+func TestDerivedMetric_InvalidExpression(t *testing.T) {
+	mh := runmetric.New()
+
+	errSyntax := mh.ProcessRecord(&service.MetricRecord{
+		Name:        "x",
+		XExpression: "a +",
+	})
+	errGlob := mh.ProcessRecord(&service.MetricRecord{
+		GlobName:    "x*",
+		XExpression: "a",
+	})
+
+	assert.ErrorContains(t, errSyntax, "invalid expression")
+	assert.ErrorContains(t, errGlob, "only a named metric")
+	assert.False(t, mh.Exists("x"))
+}
+
+func TestInsertStepMetrics_SynthesizesUnloggedStep(t *testing.T) {
+	mh := runmetric.New()
+	_ = mh.ProcessRecord(&service.MetricRecord{Name: "x", StepMetric: "epoch"})
+	_ = mh.ProcessRecord(&service.MetricRecord{Name: "y", StepMetric: "epoch"})
+
+	var steps []float64
+	var newSteps [][]string
+	for range 2 {
+		history := historyWith("x", "y")
+		mh.UpdateMetrics(history)
+		newSteps = append(newSteps, mh.InsertStepMetrics(history))
+
+		step, _ := history.GetNumber(pathtree.PathOf("epoch"))
+		steps = append(steps, step)
+	}
+
+	assert.Equal(t, []float64{0, 1}, steps)
+	assert.Equal(t, [][]string{{"epoch"}, nil}, newSteps)
+}
+
+func TestInsertStepMetrics_StopsSynthesizingOnceLogged(t *testing.T) {
+	mh := runmetric.New()
+	_ = mh.ProcessRecord(&service.MetricRecord{
+		Name:       "x",
+		StepMetric: "epoch",
+		Options:    &service.MetricOptions{StepSync: true},
+	})
+	mh.UpdateMetrics(historyWith("epoch"))
+
+	history := historyWith("x")
+	mh.UpdateMetrics(history)
+	newSteps := mh.InsertStepMetrics(history)
+
+	step, _ := history.GetNumber(pathtree.PathOf("epoch"))
+	assert.Empty(t, newSteps)
+	assert.Equal(t, 1.0, step)
+}
+
+func TestMetricNames_EscapedDotIsLiteral(t *testing.T) {
+	mh := runmetric.New()
+	_ = mh.ProcessRecord(&service.MetricRecord{Name: `a\.b`, StepMetric: "s1"})
+	_ = mh.ProcessRecord(&service.MetricRecord{Name: "a.b", StepMetric: "s2"})
+
+	literal := historyWith("a.b")
+	mh.InsertStepMetrics(literal)
+	nested := runhistory.New()
+	nested.SetFloat(pathtree.PathOf("a", "b"), 1)
+	mh.InsertStepMetrics(nested)
+
+	assert.True(t, literal.Contains(pathtree.PathOf("s1")))
+	assert.False(t, literal.Contains(pathtree.PathOf("s2")))
+	assert.True(t, nested.Contains(pathtree.PathOf("s2")))
+	assert.False(t, nested.Contains(pathtree.PathOf("s1")))
+}
+
+func TestGlobMetric_EscapesLiteralDots(t *testing.T) {
+	mh := runmetric.New()
+	_ = mh.ProcessRecord(&service.MetricRecord{GlobName: "*"})
+
+	newMetrics := mh.UpdateMetrics(historyWith(`file.txt`, `back\slash`))
+
+	names := make([]string, 0, len(newMetrics))
+	for _, metric := range newMetrics {
+		names = append(names, metric.Name)
+	}
+	assert.ElementsMatch(t, []string{`file\.txt`, `back\\slash`}, names)
+}
+
+func TestDownsampleHistory_KeepsEveryNth(t *testing.T) {
+	mh := runmetric.New()
+	_ = mh.ProcessRecord(&service.MetricRecord{Name: "x", XSampleEvery: 3})
+
+	var kept []bool
+	for range 7 {
+		history := historyWith("x", "y")
+		mh.DownsampleHistory(history)
+
+		assert.True(t, history.Contains(pathtree.PathOf("y")))
+		kept = append(kept, history.Contains(pathtree.PathOf("x")))
+	}
+
+	assert.Equal(t,
+		[]bool{true, false, false, true, false, false, true},
+		kept)
+}
+
+func TestDownsampleHistory_GlobMetric(t *testing.T) {
+	mh := runmetric.New()
+	_ = mh.ProcessRecord(&service.MetricRecord{
+		GlobName:     "grad/*",
+		XSampleEvery: 2,
+	})
+
+	var kept []bool
+	for range 3 {
+		history := historyWith("grad/w")
+		for _, metric := range mh.UpdateMetrics(history) {
+			assert.EqualValues(t, 2, metric.XSampleEvery)
+		}
+		mh.DownsampleHistory(history)
+
+		kept = append(kept, history.Contains(pathtree.PathOf("grad/w")))
+	}
+
+	assert.Equal(t, []bool{true, false, true}, kept)
+}
+
+func TestRemoveMetric_ResetsDefinition(t *testing.T) {
+	mh := runmetric.New()
+	summary := runsummary.New()
+	_ = mh.ProcessRecord(&service.MetricRecord{
+		Name:       "x",
+		StepMetric: "epoch",
+		Summary:    &service.MetricSummary{Max: true},
+	})
+	mh.UpdateSummary("x", summary)
+
+	err := mh.ProcessRecord(&service.MetricRecord{
+		Name:     "x",
+		XControl: &service.MetricControl{Remove: true},
+	})
+	mh.UpdateSummary("x", summary)
+
+	history := historyWith("x")
+	mh.InsertStepMetrics(history)
+	_, _ = summary.UpdateSummaries(history)
+
+	require.NoError(t, err)
+	assert.False(t, mh.Exists("x"))
+	assert.False(t, history.Contains(pathtree.PathOf("epoch")))
+	assert.Equal(t, map[string]any{"x": 1.0}, summary.ToNestedMaps())
+}
+
+func TestRemoveMetric_Glob(t *testing.T) {
+	mh := runmetric.New()
+	_ = mh.ProcessRecord(&service.MetricRecord{GlobName: "val/*"})
+	_ = mh.ProcessRecord(&service.MetricRecord{
+		GlobName: "val/*",
+		XControl: &service.MetricControl{Remove: true},
+	})
+
+	newMetrics := mh.UpdateMetrics(historyWith("val/loss"))
+
+	assert.Empty(t, newMetrics)
+}
@@ -1,11 +1,19 @@
 package runsummary
 
-import "github.com/wandb/simplejsonext"
+import (
+	"math"
+
+	"github.com/wandb/simplejsonext"
+	"github.com/wandb/wandb/core/internal/nonfinite"
+	"github.com/wandb/wandb/core/internal/pathtree"
+	"github.com/wandb/wandb/core/internal/runhistory"
+)
 
 // metricSummary is the summary value of a single metric.
 //
 // The zero value is an empty summary.
 type metricSummary struct {
+	first  any
 	latest any
 	min    float64
 	max    float64
@@ -21,13 +29,32 @@ type metricSummary struct {
 	// noSummary disables any summary output for the metric at all.
 	noSummary bool
 
+	// best is the best value so far, if hasBest is true.
+	best       any
+	bestNumber float64
+	hasBest    bool
+
+	// bestStep is the value of the step metric when best was logged,
+	// or nil if the step was not in the history.
+	bestStep any
+
+	// isMaximize is whether larger values are better.
+	isMaximize bool
+
+	// stepPath is the metric's step metric, or nil to use "_step".
+	stepPath *pathtree.TreePath
+
 	// hasData is whether any summary data has been accumulated.
 	hasData bool
 }
 
 func (ms *metricSummary) Clear() {
+	ms.first = nil
 	ms.latest = nil
 	ms.hasData = false
+	ms.best = nil
+	ms.bestStep = nil
+	ms.hasBest = false
 }
 
 // SetExplicit sets an explicit summary value for the metric.
@@ -42,8 +69,7 @@ func (ms *metricSummary) SetExplicit(value any) {
 // UpdateFloat updates the metric's summary with the latest value
 // when it is a float.
 func (ms *metricSummary) UpdateFloat(value float64) {
-	ms.latest = value
-	ms.hasData = true
+	ms.updateLatest(value)
 
 	if ms.count > 0 {
 		ms.min = min(ms.min, value)
@@ -59,8 +85,7 @@ func (ms *metricSummary) UpdateFloat(value float64) {
 // UpdateInt updates the metric's summary with the latest value
 // when it is an integer.
 func (ms *metricSummary) UpdateInt(value int64) {
-	ms.latest = value
-	ms.hasData = true
+	ms.updateLatest(value)
 
 	if ms.count > 0 {
 		ms.min = min(ms.min, float64(value))
@@ -73,9 +98,62 @@ func (ms *metricSummary) UpdateInt(value int64) {
 	ms.count++
 }
 
+// UpdateBest replaces the best value if the new number is better.
+//
+// The step is read from the history that the value came from. NaN is
+// never the best value.
+func (ms *metricSummary) UpdateBest(
+	value any,
+	number float64,
+	history *runhistory.RunHistory,
+) {
+	if !ms.track.HasAny(Best) || math.IsNaN(number) {
+		return
+	}
+
+	if ms.hasBest {
+		isBetter := number < ms.bestNumber
+		if ms.isMaximize {
+			isBetter = number > ms.bestNumber
+		}
+
+		if !isBetter {
+			return
+		}
+	}
+
+	ms.best = value
+	ms.bestNumber = number
+	ms.hasBest = true
+
+	stepPath := pathtree.PathOf("_step")
+	if ms.stepPath != nil {
+		stepPath = *ms.stepPath
+	}
+
+	ms.bestStep = nil
+	if step, ok := history.GetNumber(stepPath); ok {
+		if step == math.Trunc(step) && math.Abs(step) < 1<<53 {
+			ms.bestStep = int64(step)
+		} else {
+			ms.bestStep = step
+		}
+	}
+}
+
 // UpdateOther updates the metric's summary with the latest value
 // when it's not a number.
 func (ms *metricSummary) UpdateOther(value any) {
+	ms.updateLatest(value)
+}
+
+// updateLatest records the latest value, and the first value if it's
+// the first since the summary was created or cleared.
+func (ms *metricSummary) updateLatest(value any) {
+	if !ms.hasData {
+		ms.first = value
+	}
+
 	ms.latest = value
 	ms.hasData = true
 }
@@ -94,6 +172,9 @@ func (ms *metricSummary) ToMarshallableValue() any {
 	}
 
 	summary := make(map[string]any)
+	if ms.track.HasAny(First) {
+		summary["first"] = ms.first
+	}
 	if ms.track.HasAny(Latest) {
 		summary["last"] = ms.latest
 	}
@@ -106,20 +187,45 @@ func (ms *metricSummary) ToMarshallableValue() any {
 	if ms.track.HasAny(Mean) {
 		summary["mean"] = ms.total / float64(ms.count)
 	}
+	if ms.track.HasAny(Best) && ms.hasBest {
+		summary["best"] = ms.best
+		if ms.bestStep != nil {
+			summary["best_step"] = ms.bestStep
+		}
+	}
 
 	return summary
 }
 
 // ToExtendedJSON serializes the summary to a JSON string supporting
-// +-Infinity and NaN.
+// +-Infinity and NaN, which are first handled according to the policy.
 //
 // Returns an empty string if the metric should have no summary.
-func (ms *metricSummary) ToExtendedJSON() (string, error) {
-	jsonSummary := ms.ToMarshallableValue()
+func (ms *metricSummary) ToExtendedJSON(
+	policy nonfinite.Policy,
+) (string, error) {
+	jsonSummary, err := ms.toPolicyValue(policy)
 
-	if jsonSummary == nil {
-		return "", nil
+	if err != nil || jsonSummary == nil {
+		return "", err
 	} else {
 		return simplejsonext.MarshalToString(jsonSummary)
 	}
 }
+
+// toPolicyValue returns ToMarshallableValue with non-finite floats
+// handled according to the policy.
+//
+// Returns nil if there is no value.
+func (ms *metricSummary) toPolicyValue(policy nonfinite.Policy) (any, error) {
+	value, keep, err := nonfinite.Apply(ms.ToMarshallableValue(), policy)
+
+	switch {
+	case err != nil:
+		return nil, err
+	case !keep:
+		return nil, nil
+	default:
+		return value, nil
+	}
+}
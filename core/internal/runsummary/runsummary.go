@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/wandb/simplejsonext"
+	"github.com/wandb/wandb/core/internal/nonfinite"
 	"github.com/wandb/wandb/core/internal/pathtree"
 	"github.com/wandb/wandb/core/internal/runhistory"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -14,21 +15,38 @@ import (
 type RunSummary struct {
 	// summaries maps metrics to metricSummary objects.
 	summaries *pathtree.PathTree
+
+	// nonFinitePolicy is how to handle NaN and +-Infinity values.
+	nonFinitePolicy nonfinite.Policy
 }
 
 func New() *RunSummary {
 	return &RunSummary{summaries: pathtree.New()}
 }
 
+// SetNonFinitePolicy sets how to handle NaN and +-Infinity values.
+func (rs *RunSummary) SetNonFinitePolicy(policy nonfinite.Policy) {
+	rs.nonFinitePolicy = policy
+}
+
 // SetFromRecord explicitly sets the summary value of a metric.
 //
-// Returns an error if the item is not valid.
+// Returns an error if the item is not valid, including if it contains
+// NaN or +-Infinity under the Error policy.
 func (rs *RunSummary) SetFromRecord(record *service.SummaryItem) error {
 	value, err := simplejsonext.UnmarshalString(record.ValueJson)
 	if err != nil {
 		return fmt.Errorf("runsummary: invalid summary JSON: %v", err)
 	}
 
+	value, keep, err := nonfinite.Apply(value, rs.nonFinitePolicy)
+	switch {
+	case err != nil:
+		return fmt.Errorf("runsummary: %v", err)
+	case !keep:
+		return nil
+	}
+
 	rs.getOrMakeSummary(keyPath(record)).SetExplicit(value)
 
 	return nil
@@ -71,6 +89,7 @@ func (rs *RunSummary) UpdateSummaries(
 		func(path pathtree.TreePath, value float64) bool {
 			update, err := rs.updateSummary(path, func(ms *metricSummary) {
 				ms.UpdateFloat(value)
+				ms.UpdateBest(value, value, history)
 			})
 
 			if err != nil {
@@ -85,6 +104,7 @@ func (rs *RunSummary) UpdateSummaries(
 		func(path pathtree.TreePath, value int64) bool {
 			update, err := rs.updateSummary(path, func(ms *metricSummary) {
 				ms.UpdateInt(value)
+				ms.UpdateBest(value, float64(value), history)
 			})
 
 			if err != nil {
@@ -122,7 +142,7 @@ func (rs *RunSummary) updateSummary(
 	summary := rs.getOrMakeSummary(path)
 
 	update(summary)
-	json, err := summary.ToExtendedJSON()
+	json, err := summary.ToExtendedJSON(rs.nonFinitePolicy)
 
 	switch {
 	case err != nil:
@@ -150,6 +170,22 @@ func (rs *RunSummary) ConfigureMetric(
 	summary.track = track
 }
 
+// ConfigureBest sets how to choose the metric's "best" summary value,
+// which is tracked if ConfigureMetric includes Best.
+//
+// Smaller values are better unless isMaximize is true. The step at which
+// the best value was logged is read from stepPath, or from "_step" if
+// stepPath is nil.
+func (rs *RunSummary) ConfigureBest(
+	path pathtree.TreePath,
+	isMaximize bool,
+	stepPath *pathtree.TreePath,
+) {
+	summary := rs.getOrMakeSummary(path)
+	summary.isMaximize = isMaximize
+	summary.stepPath = stepPath
+}
+
 // ToRecords returns this summary as a list of SummaryItem protos.
 //
 // It may return a non-empty list even on error, in which case some
@@ -161,7 +197,7 @@ func (rs *RunSummary) ToRecords() ([]*service.SummaryItem, error) {
 	rs.summaries.ForEachLeaf(
 		func(path pathtree.TreePath, value any) bool {
 			summary := value.(*metricSummary)
-			encoded, err := summary.ToExtendedJSON()
+			encoded, err := summary.ToExtendedJSON(rs.nonFinitePolicy)
 
 			if err != nil {
 				errs = append(errs, err)
@@ -192,7 +228,9 @@ func (rs *RunSummary) toSummaryTree() *pathtree.PathTree {
 		func(path pathtree.TreePath, value any) bool {
 			summary := value.(*metricSummary)
 
-			if jsonSummary := summary.ToMarshallableValue(); jsonSummary != nil {
+			// Errors are reported by UpdateSummaries and ToRecords.
+			jsonSummary, err := summary.toPolicyValue(rs.nonFinitePolicy)
+			if err == nil && jsonSummary != nil {
 				jsonTree.Set(path, jsonSummary)
 			}
 
@@ -1,10 +1,12 @@
 package runsummary_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/nonfinite"
 	"github.com/wandb/wandb/core/internal/pathtree"
 	"github.com/wandb/wandb/core/internal/runhistory"
 	"github.com/wandb/wandb/core/internal/runsummary"
@@ -38,6 +40,29 @@ func TestExplicitSummary(t *testing.T) {
 		string(encoded))
 }
 
+func TestNonFinitePolicy(t *testing.T) {
+	rs := runsummary.New()
+	rs.SetNonFinitePolicy(nonfinite.Stringify)
+	rh := runhistory.New()
+	rh.SetFloat(pathtree.PathOf("loss"), math.Inf(1))
+
+	updates, err := rs.UpdateSummaries(rh)
+	require.NoError(t, err)
+	err = rs.SetFromRecord(&service.SummaryItem{
+		Key:       "acc",
+		ValueJson: "NaN",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, updates, 1)
+	assert.Equal(t, `"Infinity"`, updates[0].ValueJson)
+	encoded, err := rs.Serialize()
+	require.NoError(t, err)
+	assert.JSONEq(t,
+		`{"loss": "Infinity", "acc": "NaN"}`,
+		string(encoded))
+}
+
 func TestSummaryTypes(t *testing.T) {
 	rs := runsummary.New()
 	rh1 := runhistory.New()
@@ -49,7 +74,8 @@ func TestSummaryTypes(t *testing.T) {
 
 	rs.ConfigureMetric(
 		pathtree.PathOf("x"), false,
-		runsummary.Min|runsummary.Max|runsummary.Mean|runsummary.Latest,
+		runsummary.Min|runsummary.Max|runsummary.Mean|
+			runsummary.First|runsummary.Latest,
 	)
 	_, _ = rs.UpdateSummaries(rh1)
 	_, _ = rs.UpdateSummaries(rh2)
@@ -63,12 +89,53 @@ func TestSummaryTypes(t *testing.T) {
 				"min": 1,
 				"max": 3.0,
 				"mean": 2.1,
+				"first": 1,
 				"last": 2.3
 			}
 		}`,
 		string(encoded))
 }
 
+func TestBestSummary(t *testing.T) {
+	epoch := pathtree.PathOf("epoch")
+	testCases := []struct {
+		name       string
+		isMaximize bool
+		stepPath   *pathtree.TreePath
+		expected   map[string]any
+	}{
+		{"minimize", false, nil,
+			map[string]any{"best": int64(1), "best_step": int64(0)}},
+		{"maximize", true, nil,
+			map[string]any{"best": 3.5, "best_step": int64(1)}},
+		{"custom step", true, &epoch,
+			map[string]any{"best": 3.5, "best_step": int64(20)}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rs := runsummary.New()
+			rs.ConfigureMetric(pathtree.PathOf("x"), false, runsummary.Best)
+			rs.ConfigureBest(pathtree.PathOf("x"), tc.isMaximize, tc.stepPath)
+
+			for step, value := range []any{int64(1), 3.5, 2.0} {
+				rh := runhistory.New()
+				rh.SetInt(pathtree.PathOf("_step"), int64(step))
+				rh.SetInt(pathtree.PathOf("epoch"), int64(step*10+10))
+				switch x := value.(type) {
+				case int64:
+					rh.SetInt(pathtree.PathOf("x"), x)
+				case float64:
+					rh.SetFloat(pathtree.PathOf("x"), x)
+				}
+				_, _ = rs.UpdateSummaries(rh)
+			}
+
+			assert.Equal(t, tc.expected, rs.ToNestedMaps()["x"])
+		})
+	}
+}
+
 func TestNestedKey(t *testing.T) {
 	rs := runsummary.New()
 	rh := runhistory.New()
@@ -8,6 +8,8 @@ const (
 	Min
 	Max
 	Mean
+	First
+	Best
 )
 
 func (f SummaryTypeFlags) IsEmpty() bool {
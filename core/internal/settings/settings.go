@@ -131,11 +131,26 @@ func (s *Settings) GetInternalLogFile() string {
 	return s.Proto.LogInternal.GetValue()
 }
 
+// The run's directory, containing its transaction log.
+func (s *Settings) GetSyncDir() string {
+	return s.Proto.SyncDir.GetValue()
+}
+
+// The path to the run's transaction log.
+func (s *Settings) GetSyncFile() string {
+	return s.Proto.SyncFile.GetValue()
+}
+
 // The local directory where the run's files are stored.
 func (s *Settings) GetFilesDir() string {
 	return s.Proto.FilesDir.GetValue()
 }
 
+// The run's directory for temporary files.
+func (s *Settings) GetTmpDir() string {
+	return s.Proto.TmpDir.GetValue()
+}
+
 // Unix glob patterns relative to `files_dir` to not upload.
 func (s *Settings) GetIgnoreGlobs() []string {
 	return s.Proto.IgnoreGlobs.GetValue()
@@ -146,6 +161,203 @@ func (s *Settings) GetFileStreamMaxBytes() int32 {
 	return s.Proto.XFileStreamMaxBytes.GetValue()
 }
 
+// Maximum length of a line in a filestream file, or 0 if unset.
+func (s *Settings) GetFileStreamMaxLineBytes() int32 {
+	return s.Proto.XFileStreamMaxLineBytes.GetValue()
+}
+
+// Amount of unsent filestream data above which to warn the user.
+func (s *Settings) GetFileStreamBacklogBytes() int32 {
+	return s.Proto.XFileStreamBacklogBytes.GetValue()
+}
+
+// Whether to block history updates while the filestream backlog is too large.
+func (s *Settings) IsFileStreamBacklogBlocking() bool {
+	return s.Proto.XFileStreamBacklogBlock.GetValue()
+}
+
+// Amount of buffered filestream data at which to send a request early.
+func (s *Settings) GetFileStreamFlushBytes() int32 {
+	return s.Proto.XFileStreamFlushBytes.GetValue()
+}
+
+// Number of buffered filestream lines at which to send a request early.
+func (s *Settings) GetFileStreamFlushLines() int32 {
+	return s.Proto.XFileStreamFlushLines.GetValue()
+}
+
+// Maximum time between filestream requests, or 0 if unset.
+func (s *Settings) GetFileStreamHeartbeatInterval() time.Duration {
+	return time.Duration(
+		float64(time.Second) *
+			s.Proto.XFileStreamHeartbeatSeconds.GetValue())
+}
+
+// Minimum time to collect filestream updates before sending a request.
+func (s *Settings) GetFileStreamMinBatchDelay() time.Duration {
+	return time.Duration(
+		float64(time.Second) *
+			s.Proto.XFileStreamMinBatchDelaySeconds.GetValue())
+}
+
+// Minimum time between filestream requests, or 0 if unset.
+func (s *Settings) GetFileStreamTransmitInterval() time.Duration {
+	return time.Duration(
+		float64(time.Second) *
+			s.Proto.XFileStreamTransmitIntervalSeconds.GetValue())
+}
+
+// File to write filestream requests to instead of sending them, if set.
+func (s *Settings) GetFileStreamDryRunPath() string {
+	return s.Proto.XFileStreamDryRunPath.GetValue()
+}
+
+// Maximum size of a filestream request body, or 0 if unset.
+func (s *Settings) GetFileStreamMaxRequestBytes() int32 {
+	return s.Proto.XFileStreamMaxRequestBytes.GetValue()
+}
+
+// How long to keep idle filestream connections open, or 0 if unset.
+func (s *Settings) GetFileStreamIdleConnTimeout() time.Duration {
+	return time.Duration(
+		float64(time.Second) *
+			s.Proto.XFileStreamIdleConnTimeoutSeconds.GetValue())
+}
+
+// Maximum idle filestream connections per host, or 0 if unset.
+func (s *Settings) GetFileStreamMaxIdleConnsPerHost() int32 {
+	return s.Proto.XFileStreamMaxIdleConnsPerHost.GetValue()
+}
+
+// Whether to reuse connections between filestream requests.
+//
+// This is true unless disabled, since the filestreams in a process share
+// their connections.
+func (s *Settings) IsFileStreamKeepAlive() bool {
+	keepAlive := s.Proto.XFileStreamKeepAlive
+	return keepAlive == nil || keepAlive.GetValue()
+}
+
+// Whether to use only HTTP/1.1 for filestream requests.
+func (s *Settings) IsFileStreamHTTP2Disabled() bool {
+	return s.Proto.XFileStreamDisableHttp2.GetValue()
+}
+
+// Whether to stop redacting secrets from console lines sent to the filestream.
+func (s *Settings) IsConsoleRedactionDisabled() bool {
+	return s.Proto.XConsoleDisableRedaction.GetValue()
+}
+
+// Extra regular expressions for secrets to redact from console lines.
+func (s *Settings) GetConsoleRedactPatterns() []string {
+	return s.Proto.XConsoleRedactPatterns.GetValue()
+}
+
+// Maximum length of a captured console line, or 0 if unset.
+func (s *Settings) GetConsoleMaxLineLength() int32 {
+	return s.Proto.XConsoleMaxLineLength.GetValue()
+}
+
+// Whether to remove unsupported ANSI escape sequences from console output.
+func (s *Settings) IsConsoleStripANSI() bool {
+	return s.Proto.XConsoleStripAnsi.GetValue()
+}
+
+// Whether a carriage return in console output starts a new line.
+func (s *Settings) IsConsoleCarriageReturnNewline() bool {
+	return s.Proto.XConsoleCarriageReturnNewline.GetValue()
+}
+
+// Maximum size of the run config in bytes, or 0 if unset.
+func (s *Settings) GetConfigMaxBytes() int32 {
+	return s.Proto.XConfigMaxBytes.GetValue()
+}
+
+// Maximum size of a single config value in bytes, or 0 if unset.
+func (s *Settings) GetConfigMaxValueBytes() int32 {
+	return s.Proto.XConfigMaxValueBytes.GetValue()
+}
+
+// Patterns for the only config keys to upload.
+func (s *Settings) GetConfigIncludeKeys() []string {
+	return s.Proto.XConfigIncludeKeys.GetValue()
+}
+
+// Patterns for config keys not to upload.
+func (s *Settings) GetConfigExcludeKeys() []string {
+	return s.Proto.XConfigExcludeKeys.GetValue()
+}
+
+// How config updates combine dicts: "merge", "replace", or "" if unset.
+func (s *Settings) GetConfigDictMerge() string {
+	return s.Proto.XConfigDictMerge.GetValue()
+}
+
+// How config updates combine lists: "replace", "append", or "" if unset.
+func (s *Settings) GetConfigListMerge() string {
+	return s.Proto.XConfigListMerge.GetValue()
+}
+
+// How to handle NaN and +-Infinity in config and summary values.
+func (s *Settings) GetJSONNonFinitePolicy() string {
+	return s.Proto.XJsonNonFinitePolicy.GetValue()
+}
+
+// Whether to serialize config keys in insertion order rather than sorted.
+func (s *Settings) IsConfigPreserveOrder() bool {
+	return s.Proto.XConfigPreserveOrder.GetValue()
+}
+
+// Whether to reject config values with types that can't be serialized.
+func (s *Settings) IsConfigStrictTypes() bool {
+	return s.Proto.XConfigStrictTypes.GetValue()
+}
+
+// Whether to split config keys on dots.
+func (s *Settings) IsConfigDottedKeys() bool {
+	return s.Proto.XConfigDottedKeys.GetValue()
+}
+
+// The character that escapes dots in dotted config keys, if set.
+func (s *Settings) GetConfigKeyEscape() string {
+	return s.Proto.XConfigKeyEscape.GetValue()
+}
+
+// Whether to keep an audit trail of config changes.
+func (s *Settings) IsConfigAudit() bool {
+	return s.Proto.XConfigAudit.GetValue()
+}
+
+// How metric definitions combine with earlier definitions of the same
+// metric: "merge", "overwrite", "keep_first", or "" if unset.
+func (s *Settings) GetMetricRedefinePolicy() string {
+	return s.Proto.XMetricRedefinePolicy.GetValue()
+}
+
+// Maximum time to spend finishing the filestream, or 0 if unlimited.
+func (s *Settings) GetFileStreamDrainTimeout() time.Duration {
+	return time.Duration(
+		float64(time.Second) *
+			s.Proto.XFileStreamDrainTimeoutSeconds.GetValue())
+}
+
+// What to do with unsent filestream data after the drain timeout.
+func (s *Settings) GetFileStreamDrainTimeoutPolicy() string {
+	return s.Proto.XFileStreamDrainTimeoutPolicy.GetValue()
+}
+
+// Whether to stop collapsing repeated console lines sent to the filestream.
+func (s *Settings) IsConsoleDedupeDisabled() bool {
+	return s.Proto.XConsoleDisableDedupe.GetValue()
+}
+
+// Maximum rate of new console lines to send to the filestream.
+//
+// Not positive if unlimited.
+func (s *Settings) GetConsoleMaxLinesPerSecond() float64 {
+	return s.Proto.XConsoleMaxLinesPerSecond.GetValue()
+}
+
 // Additional headers to add to all outgoing HTTP requests.
 func (s *Settings) GetExtraHTTPHeaders() map[string]string {
 	return s.Proto.XExtraHttpHeaders.GetValue()
@@ -197,6 +409,103 @@ func (s *Settings) GetFileTransferTimeout() time.Duration {
 		s.Proto.XFileTransferTimeoutSeconds.GetValue())
 }
 
+// Deadline for each file upload, including retries.
+func (s *Settings) GetFileTransferUploadTimeout() time.Duration {
+	return time.Duration(
+		s.Proto.XFileTransferUploadTimeoutSeconds.GetValue() * float64(time.Second))
+}
+
+// Deadline for each file download, including retries.
+func (s *Settings) GetFileTransferDownloadTimeout() time.Duration {
+	return time.Duration(
+		s.Proto.XFileTransferDownloadTimeoutSeconds.GetValue() * float64(time.Second))
+}
+
+// Whether to skip uploads of contents that were already uploaded.
+func (s *Settings) IsFileTransferUploadDedupe() bool {
+	return s.Proto.XFileTransferUploadDedupe.GetValue()
+}
+
+// Whether to copy files before uploading them.
+func (s *Settings) IsFileTransferSnapshotUploads() bool {
+	return s.Proto.XFileTransferSnapshotUploads.GetValue()
+}
+
+// Number of byte ranges of a large file downloaded concurrently.
+func (s *Settings) GetFileTransferDownloadConcurrency() int32 {
+	return s.Proto.XFileTransferDownloadConcurrency.GetValue()
+}
+
+// Size of each byte range in a concurrent download.
+func (s *Settings) GetFileTransferDownloadChunkSize() int64 {
+	return s.Proto.XFileTransferDownloadChunkSizeBytes.GetValue()
+}
+
+// Maximum number of file uploads/downloads in progress at once.
+func (s *Settings) GetFileTransferMaxConcurrency() int32 {
+	return s.Proto.XFileTransferMaxConcurrency.GetValue()
+}
+
+// Maximum number of file uploads/downloads to a single host at once.
+func (s *Settings) GetFileTransferMaxConcurrencyPerHost() int32 {
+	return s.Proto.XFileTransferMaxConcurrencyPerHost.GetValue()
+}
+
+// How symbolic links in downloaded directories are handled.
+func (s *Settings) GetFileTransferSymlinkPolicy() string {
+	return s.Proto.XFileTransferSymlinkPolicy.GetValue()
+}
+
+// Glob patterns for names of files to gzip before upload.
+func (s *Settings) GetFileTransferCompressGlobs() []string {
+	return s.Proto.XFileTransferCompressGlobs.GetValue()
+}
+
+// Glob patterns for files to download when a reference expands to many files.
+func (s *Settings) GetFileTransferReferenceIncludeGlobs() []string {
+	return s.Proto.XFileTransferReferenceIncludeGlobs.GetValue()
+}
+
+// Glob patterns for files to skip when a reference expands to many files.
+func (s *Settings) GetFileTransferReferenceExcludeGlobs() []string {
+	return s.Proto.XFileTransferReferenceExcludeGlobs.GetValue()
+}
+
+// How file:// references are downloaded.
+func (s *Settings) GetFileTransferLocalReferencePolicy() string {
+	return s.Proto.XFileTransferLocalReferencePolicy.GetValue()
+}
+
+// Additional headers to send when downloading http(s):// references.
+func (s *Settings) GetHTTPReferenceHeaders() map[string]string {
+	return s.Proto.XHttpReferenceHeaders.GetValue()
+}
+
+// Bearer token to send when downloading http(s):// references.
+func (s *Settings) GetHTTPReferenceBearerToken() string {
+	return s.Proto.XHttpReferenceBearerToken.GetValue()
+}
+
+// User name for webdav:// and webdavs:// transfers.
+func (s *Settings) GetWebDAVUsername() string {
+	return s.Proto.XWebdavUsername.GetValue()
+}
+
+// Password for webdav:// and webdavs:// transfers.
+func (s *Settings) GetWebDAVPassword() string {
+	return s.Proto.XWebdavPassword.GetValue()
+}
+
+// User name for OCI registries.
+func (s *Settings) GetOCIUsername() string {
+	return s.Proto.XOciUsername.GetValue()
+}
+
+// Password or access token for OCI registries.
+func (s *Settings) GetOCIPassword() string {
+	return s.Proto.XOciPassword.GetValue()
+}
+
 // Maximum number of retries for GraphQL operations.
 func (s *Settings) GetGraphQLMaxRetries() int32 {
 	return s.Proto.XGraphqlRetryMax.GetValue()
@@ -11,7 +11,15 @@
 // https://gpanders.com/blog/state-of-the-terminal/
 package terminalemulator
 
-import "slices"
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// maxEscapeSequenceLength bounds how much of an unterminated escape
+// sequence is kept before it is discarded.
+const maxEscapeSequenceLength = 4096
 
 // Terminal is a text buffer that processes escape sequences.
 //
@@ -39,18 +47,59 @@ type Terminal struct {
 	//
 	// This is the empty string if we're not parsing an escape sequence.
 	escapeSequence string
+
+	// stripUnknownSequences is whether to discard escape sequences that
+	// aren't supported instead of printing them.
+	stripUnknownSequences bool
+
+	// isCarriageReturnLineFeed is whether '\r' starts a new line instead
+	// of returning to the start of the current one.
+	isCarriageReturnLineFeed bool
+
+	// afterCarriageReturn is whether the last character was a '\r'
+	// treated as a line feed, so that a following '\n' is ignored.
+	afterCarriageReturn bool
+}
+
+type TerminalOption func(t *Terminal)
+
+// WithStripUnknownSequences makes the terminal discard escape sequences
+// it doesn't support, such as colors and hyperlinks, instead of
+// printing them.
+func WithStripUnknownSequences() TerminalOption {
+	return func(t *Terminal) {
+		t.stripUnknownSequences = true
+	}
+}
+
+// WithCarriageReturnLineFeed makes '\r' start a new line.
+//
+// By default, '\r' returns to the start of the line so that progress
+// bars overwrite themselves. With this option, each update is kept on
+// its own line. A "\r\n" sequence still produces a single line break.
+func WithCarriageReturnLineFeed() TerminalOption {
+	return func(t *Terminal) {
+		t.isCarriageReturnLineFeed = true
+	}
 }
 
 // NewTerminal returns an empty terminal.
 func NewTerminal(
 	lineSupplier LineSupplier,
 	height int,
+	opts ...TerminalOption,
 ) *Terminal {
-	return &Terminal{
+	t := &Terminal{
 		lineSupplier: lineSupplier,
 		height:       height,
 		view:         make([]Line, 0),
 	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	return t
 }
 
 // Write sends input to the terminal.
@@ -58,42 +107,123 @@ func (t *Terminal) Write(input string) {
 	for _, char := range input {
 		switch {
 		case len(t.escapeSequence) == 0:
-			switch char {
-			default:
-				t.putChar(char)
-			case '\r':
-				t.carriageReturn()
-			case '\n':
-				t.lineFeed()
-			case '\x1b':
-				t.escapeSequence = string(char)
-			}
+			t.writeChar(char)
 
 		case t.escapeSequence == "\x1b":
-			switch char {
-			case '[':
+			switch {
+			case char == '[':
 				t.escapeSequence = "\x1b["
+			case char == ']' && t.stripUnknownSequences:
+				t.escapeSequence = "\x1b]"
 			default:
-				t.printEscapeSequence()
-				t.putChar(char)
+				t.unknownEscapeSequence(char)
 			}
 
-		case t.escapeSequence == "\x1b[":
-			switch char {
-			case 'A':
+		case strings.HasPrefix(t.escapeSequence, "\x1b["):
+			t.writeControlSequence(char)
+
+		case strings.HasPrefix(t.escapeSequence, "\x1b]"):
+			t.writeOperatingSystemCommand(char)
+		}
+	}
+}
+
+// writeChar handles a character outside of an escape sequence.
+func (t *Terminal) writeChar(char rune) {
+	afterCarriageReturn := t.afterCarriageReturn
+	t.afterCarriageReturn = false
+
+	switch char {
+	default:
+		t.putChar(char)
+	case '\r':
+		if t.isCarriageReturnLineFeed {
+			t.lineFeed()
+			t.afterCarriageReturn = true
+		} else {
+			t.carriageReturn()
+		}
+	case '\n':
+		if !afterCarriageReturn {
+			t.lineFeed()
+		}
+	case '\x1b':
+		t.escapeSequence = string(char)
+	}
+}
+
+// writeControlSequence continues a sequence starting with "ESC [".
+//
+// Parameter bytes are accumulated until a final byte ends the sequence.
+// Unless unknown sequences are stripped, only numeric parameters are
+// accepted, and anything else is printed right away.
+func (t *Terminal) writeControlSequence(char rune) {
+	isNumericParam := (char >= '0' && char <= '9') || char == ';'
+	isParam := char >= 0x20 && char <= 0x3f
+
+	switch {
+	case isNumericParam || (isParam && t.stripUnknownSequences):
+		t.escapeSequence += string(char)
+		if len(t.escapeSequence) > maxEscapeSequenceLength {
+			t.unknownEscapeSequence(char)
+		}
+
+	case char >= 0x40 && char <= 0x7e:
+		params := t.escapeSequence[2:]
+		count, isCount := parseCount(params)
+
+		switch {
+		case char == 'A' && isCount:
+			for range count {
 				t.cursorUp()
-				t.escapeSequence = ""
-			case 'B':
+			}
+			t.escapeSequence = ""
+		case char == 'B' && isCount:
+			for range count {
 				t.cursorDown()
-				t.escapeSequence = ""
-			default:
-				t.printEscapeSequence()
-				t.putChar(char)
 			}
+			t.escapeSequence = ""
+		default:
+			t.unknownEscapeSequence(char)
 		}
+
+	default:
+		t.unknownEscapeSequence(char)
 	}
 }
 
+// writeOperatingSystemCommand continues a sequence starting with "ESC ]",
+// such as a hyperlink, which ends with BEL or "ESC \".
+//
+// These are only parsed when stripping unknown sequences.
+func (t *Terminal) writeOperatingSystemCommand(char rune) {
+	switch {
+	case char == '\a':
+		t.escapeSequence = ""
+	case char == '\\' && strings.HasSuffix(t.escapeSequence, "\x1b"):
+		t.escapeSequence = ""
+	case len(t.escapeSequence) >= maxEscapeSequenceLength:
+		t.escapeSequence = ""
+	default:
+		t.escapeSequence += string(char)
+	}
+}
+
+// unknownEscapeSequence ends an unsupported escape sequence whose last
+// character is char.
+//
+// The sequence is either discarded or printed out, depending on the
+// terminal's options.
+func (t *Terminal) unknownEscapeSequence(char rune) {
+	if t.stripUnknownSequences {
+		t.escapeSequence = ""
+		return
+	}
+
+	t.printEscapeSequence()
+	t.putChar(char)
+}
+
 // printEscapeSequence prints out and resets the accumulated escape sequence.
 //
 // This is used for unknown escape sequences.
@@ -105,6 +235,23 @@ func (t *Terminal) printEscapeSequence() {
 	t.escapeSequence = ""
 }
 
+// parseCount parses the parameter of a cursor movement sequence.
+//
+// An empty parameter means 1. Returns false if the parameter is not
+// a number.
+func parseCount(params string) (int, bool) {
+	if params == "" {
+		return 1, true
+	}
+
+	count, err := strconv.Atoi(params)
+	if err != nil || count < 0 {
+		return 0, false
+	}
+
+	return max(count, 1), true
+}
+
 // putChar writes a character to the terminal and shifts the cursor.
 func (t *Terminal) putChar(char rune) {
 	// Create empty lines until we reach the current line.
@@ -85,3 +85,47 @@ func TestUnknownEscapeSequences(t *testing.T) {
 
 	assert.Equal(t, "\x1b?\x1b[?", string(lines.Lines[0].Content))
 }
+
+func TestCursorMotionWithCount(t *testing.T) {
+	lines := &TestLineSupplier{}
+	term := terminalemulator.NewTerminal(lines, 10)
+
+	term.Write("one\ntwo\nsix")
+	term.Write("\x1b[2Arous")
+
+	assert.Equal(t, "onerous", string(lines.Lines[0].Content))
+}
+
+func TestStripUnknownSequences(t *testing.T) {
+	lines := &TestLineSupplier{}
+	term := terminalemulator.NewTerminal(lines, 10,
+		terminalemulator.WithStripUnknownSequences())
+
+	term.Write("\x1b[?25l\x1b[1;31mred\x1b[0m ")
+	term.Write("\x1b]8;;https://wandb.ai\x1b\\link\x1b]8;;\x07")
+
+	assert.Equal(t, "red link", string(lines.Lines[0].Content))
+}
+
+func TestUnknownSequencesPrintedByDefault(t *testing.T) {
+	lines := &TestLineSupplier{}
+	term := terminalemulator.NewTerminal(lines, 10)
+
+	term.Write("\x1b[31mred")
+
+	assert.Equal(t, "\x1b[31mred", string(lines.Lines[0].Content))
+}
+
+func TestCarriageReturnLineFeed(t *testing.T) {
+	lines := &TestLineSupplier{}
+	term := terminalemulator.NewTerminal(lines, 10,
+		terminalemulator.WithCarriageReturnLineFeed())
+
+	term.Write("10%\r50%\r100%\r\ndone\n")
+
+	assert.Len(t, lines.Lines, 4)
+	assert.Equal(t, "10%", string(lines.Lines[0].Content))
+	assert.Equal(t, "50%", string(lines.Lines[1].Content))
+	assert.Equal(t, "100%", string(lines.Lines[2].Content))
+	assert.Equal(t, "done", string(lines.Lines[3].Content))
+}
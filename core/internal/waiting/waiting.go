@@ -36,10 +36,16 @@ type Stopwatch interface {
 	//
 	// The channel stays open for as long as the stopwatch gets Reset.
 	Wait() <-chan struct{}
+
+	// SetDuration changes the stopwatch's starting time.
+	//
+	// The new duration is counted from the last Reset.
+	SetDuration(duration time.Duration)
 }
 
 func NewStopwatch(duration time.Duration) Stopwatch {
-	s := &realStopwatch{duration, &atomic.Int64{}}
+	s := &realStopwatch{&atomic.Int64{}, &atomic.Int64{}}
+	s.SetDuration(duration)
 	s.Reset()
 	return s
 }
@@ -72,13 +78,21 @@ func completedDelay() <-chan struct{} {
 }
 
 type realStopwatch struct {
-	duration        time.Duration
+	durationNanos   *atomic.Int64
 	startTimeMicros *atomic.Int64
 }
 
+func (s *realStopwatch) duration() time.Duration {
+	return time.Duration(s.durationNanos.Load())
+}
+
 func (s *realStopwatch) IsDone() bool {
 	startTime := time.UnixMicro(s.startTimeMicros.Load())
-	return time.Now().After(startTime.Add(s.duration))
+	return time.Now().After(startTime.Add(s.duration()))
+}
+
+func (s *realStopwatch) SetDuration(duration time.Duration) {
+	s.durationNanos.Store(int64(duration))
 }
 
 func (s *realStopwatch) Reset() {
@@ -94,7 +108,7 @@ func (s *realStopwatch) Wait() <-chan struct{} {
 			originalStart := time.UnixMicro(s.startTimeMicros.Load())
 			durationElapsed := time.Since(originalStart)
 
-			time.Sleep(s.duration - durationElapsed)
+			time.Sleep(s.duration() - durationElapsed)
 
 			if s.IsDone() {
 				break
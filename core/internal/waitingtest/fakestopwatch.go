@@ -2,6 +2,7 @@ package waitingtest
 
 import (
 	"sync"
+	"time"
 
 	"github.com/wandb/wandb/core/internal/waiting"
 )
@@ -13,6 +14,7 @@ type FakeStopwatch struct {
 	waitChan    chan struct{}
 	done        bool
 	doneForever bool
+	duration    time.Duration
 }
 
 func NewFakeStopwatch() *FakeStopwatch {
@@ -58,6 +60,19 @@ func (fs *FakeStopwatch) Reset() {
 	fs.done = false
 }
 
+// Duration returns the value last passed to SetDuration.
+func (fs *FakeStopwatch) Duration() time.Duration {
+	fs.Lock()
+	defer fs.Unlock()
+	return fs.duration
+}
+
+func (fs *FakeStopwatch) SetDuration(duration time.Duration) {
+	fs.Lock()
+	defer fs.Unlock()
+	fs.duration = duration
+}
+
 func (fs *FakeStopwatch) Wait() <-chan struct{} {
 	if fs.IsDone() {
 		return completedDelay()
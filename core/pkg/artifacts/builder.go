@@ -4,7 +4,6 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
-	"io"
 	"os"
 	"sort"
 
@@ -59,25 +58,17 @@ func (b *ArtifactBuilder) AddData(name string, data any) error {
 }
 
 func (b *ArtifactBuilder) AddFile(path string, name string) error {
-
-	file, err := os.Open(path)
+	stat, err := os.Stat(path)
 	if err != nil {
 		return err
 	}
+	size := stat.Size()
 
-	// file size:
-	var size int64
-	if stat, err := file.Stat(); err == nil { // if NO error
-		size = stat.Size()
-	}
-
-	defer file.Close()
-
-	data, err := io.ReadAll(file)
+	// Hash the file without reading it into memory all at once.
+	digest, err := utils.ComputeFileB64MD5(path)
 	if err != nil {
 		return err
 	}
-	digest := utils.ComputeB64MD5(data)
 	b.artifactRecord.Manifest.Contents = append(b.artifactRecord.Manifest.Contents,
 		&service.ArtifactManifestEntry{
 			Path:      name,
@@ -0,0 +1,111 @@
+package artifacts
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/wandb/wandb/core/internal/gql"
+)
+
+// DefaultDigestConcurrency is the default number of file parts hashed
+// at once.
+var DefaultDigestConcurrency = runtime.NumCPU()
+
+// multiPartRequest returns the parts to upload for files at least
+// `threshold` bytes large, or nil if the file should be uploaded whole.
+func multiPartRequest(path string, threshold int64) ([]gql.UploadPartsInput, error) {
+	partsByPath, err := multiPartRequests(
+		[]string{path}, threshold, DefaultDigestConcurrency)
+	if err != nil {
+		return nil, err
+	}
+	return partsByPath[path], nil
+}
+
+// multiPartRequests returns the parts to upload for each file at least
+// `threshold` bytes large, by path.
+//
+// Parts are hashed by up to `concurrency` workers. Because every part is
+// hashed separately, a single large file is hashed in parallel too.
+func multiPartRequests(
+	paths []string,
+	threshold int64,
+	concurrency int,
+) (map[string][]gql.UploadPartsInput, error) {
+	partsByPath := make(map[string][]gql.UploadPartsInput)
+	g := &errgroup.Group{}
+	g.SetLimit(max(concurrency, 1))
+
+	for _, path := range paths {
+		fileInfo, err := os.Stat(path)
+		if err != nil {
+			// Don't leave parts of earlier files hashing in the background.
+			_ = g.Wait()
+			return nil, fmt.Errorf("failed to get file size for path %s: %w", path, err)
+		}
+		fileSize := fileInfo.Size()
+
+		if fileSize < threshold {
+			// We don't need to use multipart for small files.
+			continue
+		}
+		if fileSize > S3MaxMultiUploadSize {
+			_ = g.Wait()
+			return nil, fmt.Errorf("file size exceeds maximum S3 object size: %v", fileSize)
+		}
+		if _, ok := partsByPath[path]; ok {
+			continue
+		}
+
+		chunkSize := getChunkSize(fileSize)
+		numParts := (fileSize + chunkSize - 1) / chunkSize
+		parts := make([]gql.UploadPartsInput, numParts)
+		partsByPath[path] = parts
+
+		for i := range parts {
+			part := &parts[i]
+			part.PartNumber = int64(i + 1)
+			offset := int64(i) * chunkSize
+			size := min(chunkSize, fileSize-offset)
+
+			g.Go(func() error {
+				hexMD5, err := hexMD5OfSection(path, offset, size)
+				part.HexMD5 = hexMD5
+				return err
+			})
+		}
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return partsByPath, nil
+}
+
+// hexMD5OfSection returns the hex-encoded MD5 of a section of a file.
+//
+// The section is read in small buffers, so hashing many large parts at
+// once doesn't need much memory.
+func hexMD5OfSection(path string, offset int64, size int64) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hasher := md5.New()
+	n, err := io.Copy(hasher, io.NewSectionReader(file, offset, size))
+	if err != nil {
+		return "", err
+	}
+	if n != size {
+		return "", fmt.Errorf("file %s changed while hashing", path)
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
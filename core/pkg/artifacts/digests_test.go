@@ -0,0 +1,71 @@
+package artifacts
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+func TestMultiPartRequests(t *testing.T) {
+	dir := t.TempDir()
+	contents := map[string][]byte{
+		"small": make([]byte, 512),
+		"a":     []byte(string(make([]byte, 1024)) + "a"),
+		"b":     []byte(string(make([]byte, 2048)) + "b"),
+	}
+	var paths []string
+	for name, content := range contents {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, content, 0o644))
+		paths = append(paths, path)
+	}
+
+	partsByPath, err := multiPartRequests(paths, 1024, 2)
+
+	require.NoError(t, err)
+	assert.Len(t, partsByPath, 2)
+	assert.NotContains(t, partsByPath, filepath.Join(dir, "small"))
+	for _, name := range []string{"a", "b"} {
+		parts := partsByPath[filepath.Join(dir, name)]
+		require.Len(t, parts, 1)
+		assert.Equal(t, int64(1), parts[0].PartNumber)
+		assert.Equal(t, utils.ComputeHexMD5(contents[name]), parts[0].HexMD5)
+	}
+}
+
+func TestMultiPartRequests_MissingFile(t *testing.T) {
+	_, err := multiPartRequests(
+		[]string{filepath.Join(t.TempDir(), "missing")}, 1024, 2)
+
+	assert.ErrorContains(t, err, "failed to get file size")
+}
+
+func TestMultiPartRequests_MissingFileAfterHashing(t *testing.T) {
+	dir := t.TempDir()
+	large := filepath.Join(dir, "large")
+	require.NoError(t, os.WriteFile(large, make([]byte, 4096), 0o644))
+
+	_, err := multiPartRequests(
+		[]string{large, filepath.Join(dir, "missing")}, 1024, 2)
+	require.ErrorContains(t, err, "failed to get file size")
+
+	// The large file's parts are no longer being hashed, so it can be
+	// removed right away (which fails on Windows while a file is open).
+	assert.NoError(t, os.Remove(large))
+}
+
+func TestHexMD5OfSection(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(path, []byte("0123456789"), 0o644))
+
+	hexMD5, err := hexMD5OfSection(path, 2, 5)
+	require.NoError(t, err)
+	assert.Equal(t, utils.ComputeHexMD5([]byte("23456")), hexMD5)
+
+	_, err = hexMD5OfSection(path, 8, 5)
+	assert.ErrorContains(t, err, "changed while hashing")
+}
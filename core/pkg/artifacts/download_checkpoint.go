@@ -0,0 +1,91 @@
+package artifacts
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// downloadCheckpointName is the name of the file in an artifact's
+// download root that records the download's progress.
+const downloadCheckpointName = ".wandb-artifact-download"
+
+// downloadCheckpoint is the persisted progress of an artifact download.
+//
+// Files are downloaded in batches in the order of their names. Once a
+// batch is complete, the checkpoint is updated so that an interrupted
+// download can resume without checking the files of completed batches.
+// The checkpoint is removed when the download finishes.
+type downloadCheckpoint struct {
+	// path is where the checkpoint is persisted.
+	path string
+
+	// ArtifactID is the artifact being downloaded.
+	ArtifactID string `json:"artifactID"`
+
+	// NumFiles is the number of files to download.
+	NumFiles int `json:"numFiles"`
+
+	// BatchSize is the number of files in each batch.
+	BatchSize int `json:"batchSize"`
+
+	// Completed is the number of leading batches that are on disk.
+	Completed int `json:"completed"`
+}
+
+// loadDownloadCheckpoint returns the persisted progress of a download,
+// or a new checkpoint if there is none for the same files and batches.
+func loadDownloadCheckpoint(
+	downloadRoot string,
+	artifactID string,
+	numFiles int,
+	batchSize int,
+) *downloadCheckpoint {
+	fresh := &downloadCheckpoint{
+		path:       filepath.Join(downloadRoot, downloadCheckpointName),
+		ArtifactID: artifactID,
+		NumFiles:   numFiles,
+		BatchSize:  batchSize,
+	}
+
+	data, err := os.ReadFile(fresh.path)
+	if err != nil {
+		return fresh
+	}
+	saved := &downloadCheckpoint{path: fresh.path}
+	if err := json.Unmarshal(data, saved); err != nil ||
+		saved.ArtifactID != artifactID ||
+		saved.NumFiles != numFiles ||
+		saved.BatchSize != batchSize {
+		return fresh
+	}
+	return saved
+}
+
+// save persists the checkpoint.
+func (c *downloadCheckpoint) save() error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), os.ModePerm); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that a crash can't leave a
+	// truncated checkpoint behind.
+	tmpPath := c.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, c.path)
+}
+
+// remove deletes the persisted checkpoint.
+func (c *downloadCheckpoint) remove() error {
+	err := os.Remove(c.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -2,9 +2,12 @@ package artifacts
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"log/slog"
-	"path/filepath"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
@@ -13,7 +16,14 @@ import (
 )
 
 const BATCH_SIZE int = 10000
-const MAX_BACKLOG int = 10000
+
+// DefaultDownloadBatchSize is the number of files downloaded between
+// checkpoints of an artifact download.
+const DefaultDownloadBatchSize = 1000
+
+// urlRefreshInterval is the minimum time between fetches of fresh
+// download URLs for all files in an artifact.
+const urlRefreshInterval = time.Minute
 
 type ArtifactDownloader struct {
 	// Resources
@@ -24,9 +34,53 @@ type ArtifactDownloader struct {
 	// Input
 	ArtifactID             string
 	DownloadRoot           string
-	AllowMissingReferences bool   // Currently unused
+	AllowMissingReferences bool
 	SkipCache              bool   // Currently unused
 	PathPrefix             string // Currently unused
+
+	// cacheMaxSize is the size the cache is reduced to after a download,
+	// or 0 if it's unbounded.
+	cacheMaxSize int64
+
+	// cacheHardLinks is whether to hard link files from the cache.
+	cacheHardLinks bool
+
+	// batchSize is the number of files downloaded between checkpoints.
+	batchSize int
+}
+
+type ArtifactDownloaderOption func(ad *ArtifactDownloader)
+
+// WithCacheMaxSize limits the size of the artifacts cache.
+//
+// Least recently used files are removed after each download if the cache
+// is larger. Non-positive values leave the cache unbounded.
+func WithCacheMaxSize(maxSize int64) ArtifactDownloaderOption {
+	return func(ad *ArtifactDownloader) {
+		ad.cacheMaxSize = max(maxSize, 0)
+	}
+}
+
+// WithCacheHardLinks makes files restored from the cache hard links
+// instead of copies.
+func WithCacheHardLinks(hardLinks bool) ArtifactDownloaderOption {
+	return func(ad *ArtifactDownloader) {
+		ad.cacheHardLinks = hardLinks
+	}
+}
+
+// WithDownloadBatchSize sets the number of files downloaded between
+// checkpoints.
+//
+// Smaller batches make resuming an interrupted download skip more
+// files, at the cost of waiting for the slowest file of each batch.
+// Non-positive values are ignored.
+func WithDownloadBatchSize(batchSize int) ArtifactDownloaderOption {
+	return func(ad *ArtifactDownloader) {
+		if batchSize > 0 {
+			ad.batchSize = batchSize
+		}
+	}
 }
 
 func NewArtifactDownloader(
@@ -38,12 +92,9 @@ func NewArtifactDownloader(
 	allowMissingReferences bool,
 	skipCache bool,
 	pathPrefix string,
+	opts ...ArtifactDownloaderOption,
 ) *ArtifactDownloader {
-	fileCache := NewHashOnlyCache()
-	if !skipCache {
-		fileCache = NewFileCache(UserCacheDir())
-	}
-	return &ArtifactDownloader{
+	ad := &ArtifactDownloader{
 		Ctx:                    ctx,
 		GraphqlClient:          graphQLClient,
 		DownloadManager:        downloadManager,
@@ -52,8 +103,20 @@ func NewArtifactDownloader(
 		AllowMissingReferences: allowMissingReferences,
 		SkipCache:              skipCache,
 		PathPrefix:             pathPrefix,
-		FileCache:              fileCache,
+		batchSize:              DefaultDownloadBatchSize,
+	}
+	for _, opt := range opts {
+		opt(ad)
+	}
+
+	ad.FileCache = NewHashOnlyCache()
+	if !skipCache {
+		ad.FileCache = NewFileCache(
+			UserCacheDir(),
+			WithHardLinks(ad.cacheHardLinks),
+		)
 	}
+	return ad
 }
 
 func (ad *ArtifactDownloader) getArtifactManifest(artifactID string) (manifest Manifest, rerr error) {
@@ -84,115 +147,232 @@ func (ad *ArtifactDownloader) getArtifactManifest(artifactID string) (manifest M
 }
 
 func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest) error {
-	// retrieve from "WANDB_ARTIFACT_FETCH_FILE_URL_BATCH_SIZE"?
-	batchSize := BATCH_SIZE
-
-	type TaskResult struct {
-		Task *filetransfer.Task
-		Name string
-	}
-
-	// Fetch URLs and download files in batches
-	manifestEntries := manifest.Contents
-	numInProgress, numDone := 0, 0
-	nameToScheduledTime := map[string]time.Time{}
-	taskResultsChan := make(chan TaskResult)
-	manifestEntriesBatch := make([]ManifestEntry, 0, batchSize)
-
-	for numDone < len(manifestEntries) {
-		var cursor *string
-		hasNextPage := true
-		for hasNextPage {
-			// Prepare a batch
-			now := time.Now()
-			manifestEntriesBatch = manifestEntriesBatch[:0]
-			response, err := gql.ArtifactFileURLs(
-				ad.Ctx,
-				ad.GraphqlClient,
-				artifactID,
-				cursor,
-				&batchSize,
-			)
-			if err != nil {
-				return err
-			}
-			hasNextPage = response.Artifact.Files.PageInfo.HasNextPage
-			cursor = response.Artifact.Files.PageInfo.EndCursor
-			for _, edge := range response.GetArtifact().GetFiles().Edges {
-				filePath := edge.GetNode().Name
-				entry, err := manifest.GetManifestEntryFromArtifactFilePath(filePath)
-				if err != nil {
-					return err
-				}
-				if _, ok := nameToScheduledTime[filePath]; ok {
-					continue
-				}
-				// Reference artifacts will temporarily be handled by the python user process
-				if entry.Ref != nil {
-					numDone++
-					continue
-				}
-				node := edge.GetNode()
-				if node == nil {
-					return fmt.Errorf("error reading entry from fetched file urls")
-				}
-				entry.DownloadURL = &node.DirectUrl
-				entry.LocalPath = &filePath
-				nameToScheduledTime[*entry.LocalPath] = now
-				manifestEntriesBatch = append(manifestEntriesBatch, entry)
+	// References to storage without a file transfer here, like s3:// and
+	// gs://, are downloaded by the Python user process.
+	names := make([]string, 0, len(manifest.Contents))
+	for name, entry := range manifest.Contents {
+		if entry.Ref == nil || filetransfer.SupportsReference(*entry.Ref) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	refresher := &fileURLRefresher{
+		fetch: func() (map[string]string, error) {
+			return ad.fetchFileURLs(artifactID, BATCH_SIZE)
+		},
+	}
+
+	checkpoint := loadDownloadCheckpoint(
+		ad.DownloadRoot, artifactID, len(names), ad.batchSize)
+	for start := checkpoint.Completed * ad.batchSize; start < len(names); start += ad.batchSize {
+		batch := names[start:min(start+ad.batchSize, len(names))]
+		if err := ad.downloadBatch(manifest, batch, refresher); err != nil {
+			return err
+		}
+
+		checkpoint.Completed++
+		if err := checkpoint.save(); err != nil {
+			slog.Error("Error saving artifact download checkpoint", "err", err)
+		}
+	}
+
+	if err := checkpoint.remove(); err != nil {
+		slog.Error("Error removing artifact download checkpoint", "err", err)
+	}
+	return nil
+}
+
+// downloadBatch downloads the named files, returning once all of them
+// are on disk.
+func (ad *ArtifactDownloader) downloadBatch(
+	manifest Manifest,
+	names []string,
+	refresher *fileURLRefresher,
+) error {
+	results := make(chan *filetransfer.Task, len(names))
+	numInProgress := 0
+
+	for _, name := range names {
+		entry := manifest.Contents[name]
+		downloadLocalPath, err := filetransfer.LocalPathUnder(ad.DownloadRoot, name)
+		if err != nil {
+			return err
+		}
+
+		// Reference digests aren't MD5s, so references aren't cached.
+		if entry.Ref != nil {
+			task := &filetransfer.Task{
+				FileKind:  filetransfer.RunFileKindArtifact,
+				Type:      filetransfer.DownloadTask,
+				Path:      downloadLocalPath,
+				Name:      name,
+				Url:       *entry.Ref,
+				Digest:    entry.Digest,
+				Reference: true,
 			}
+			task.SetCompletionCallback(func(t *filetransfer.Task) {
+				results <- t
+			})
+			numInProgress++
+			ad.DownloadManager.AddTask(task)
+			continue
+		}
 
-			// Schedule downloads
-			if len(manifestEntriesBatch) > 0 {
-				for _, entry := range manifestEntriesBatch {
-					// Add function that returns download path?
-					downloadLocalPath := filepath.Join(ad.DownloadRoot, *entry.LocalPath)
-					// If we're skipping the cache, the HashOnlyCache still checks the destination
-					// and returns true if the file is there and has the correct hash.
-					if success := ad.FileCache.RestoreTo(entry, downloadLocalPath); success {
-						numDone++
-						continue
-					}
-					task := &filetransfer.Task{
-						FileKind: filetransfer.RunFileKindArtifact,
-						Type:     filetransfer.DownloadTask,
-						Path:     downloadLocalPath,
-						Url:      *entry.DownloadURL,
-					}
-					task.SetCompletionCallback(
-						func(t *filetransfer.Task) {
-							taskResultsChan <- TaskResult{t, *entry.LocalPath}
-						},
-					)
-					numInProgress++
-					ad.DownloadManager.AddTask(task)
-				}
+		// If we're skipping the cache, the HashOnlyCache still checks the destination
+		// and returns true if the file is there and has the correct hash.
+		if success := ad.FileCache.RestoreTo(entry, downloadLocalPath); success {
+			continue
+		}
+
+		// URLs are only fetched once a file needs downloading, so resuming
+		// a download with few missing files is quick.
+		url, err := refresher.url(name)
+		if err != nil {
+			return err
+		}
+		task := &filetransfer.Task{
+			FileKind: filetransfer.RunFileKindArtifact,
+			Type:     filetransfer.DownloadTask,
+			Path:     downloadLocalPath,
+			Name:     name,
+			Url:      url,
+			Digest:   entry.Digest,
+		}
+		task.RefreshURL = func() (string, error) {
+			return refresher.get(name)
+		}
+		task.SetCompletionCallback(func(t *filetransfer.Task) {
+			results <- t
+		})
+		numInProgress++
+		ad.DownloadManager.AddTask(task)
+	}
+
+	var firstErr error
+	for ; numInProgress > 0; numInProgress-- {
+		task := <-results
+		if task.Err != nil {
+			if ad.isAllowedMissingReference(task) {
+				slog.Warn(
+					"Skipping missing reference",
+					"name", task.Name,
+					"ref", task.Url,
+				)
+				continue
 			}
-			// Wait for downloader to catch up. If there's nothing more to schedule, wait for all in progress tasks.
-			for numInProgress > MAX_BACKLOG || (len(manifestEntriesBatch) == 0 && numInProgress > 0) {
-				numInProgress--
-				result := <-taskResultsChan
-				if result.Task.Err != nil {
-					// We want to retry when the signed URL expires. However, distinguishing that error from others is not
-					// trivial. As a heuristic, we retry if the request failed more than an hour after we fetched the URL.
-					if time.Since(nameToScheduledTime[result.Name]) < 1*time.Hour {
-						return result.Task.Err
-					}
-					delete(nameToScheduledTime, result.Name) // retry
-					continue
-				}
-				numDone++
-				digest := manifest.Contents[result.Name].Digest
-				go func() {
-					err := ad.FileCache.AddFileAndCheckDigest(result.Task.Path, digest)
-					if err != nil {
-						slog.Error("Error adding file to cache", "err", err)
-					}
-				}()
+			if firstErr == nil {
+				firstErr = task.Err
 			}
+			continue
+		}
+		if task.Reference {
+			continue
 		}
+		digest := manifest.Contents[task.Name].Digest
+		go func() {
+			err := ad.FileCache.AddFileAndCheckDigest(task.Path, digest)
+			if err != nil {
+				slog.Error("Error adding file to cache", "err", err)
+			}
+		}()
 	}
-	return nil
+	return firstErr
+}
+
+// isAllowedMissingReference returns whether a failed task is for a
+// reference that doesn't exist and may be skipped.
+func (ad *ArtifactDownloader) isAllowedMissingReference(task *filetransfer.Task) bool {
+	if !task.Reference || !ad.AllowMissingReferences {
+		return false
+	}
+	// Missing file:// references fail with a local "not exist" error.
+	kind, _ := filetransfer.ClassifyError(task.Err)
+	return kind == filetransfer.ErrorKindNotFound ||
+		errors.Is(task.Err, fs.ErrNotExist)
+}
+
+// fetchFileURLs returns the download URL of every file in an artifact.
+func (ad *ArtifactDownloader) fetchFileURLs(
+	artifactID string,
+	batchSize int,
+) (map[string]string, error) {
+	urls := make(map[string]string)
+
+	var cursor *string
+	hasNextPage := true
+	for hasNextPage {
+		response, err := gql.ArtifactFileURLs(
+			ad.Ctx,
+			ad.GraphqlClient,
+			artifactID,
+			cursor,
+			&batchSize,
+		)
+		if err != nil {
+			return nil, err
+		}
+		hasNextPage = response.Artifact.Files.PageInfo.HasNextPage
+		cursor = response.Artifact.Files.PageInfo.EndCursor
+		for _, edge := range response.GetArtifact().GetFiles().Edges {
+			if node := edge.GetNode(); node != nil {
+				urls[node.Name] = node.DirectUrl
+			}
+		}
+	}
+
+	return urls, nil
+}
+
+// fileURLRefresher replaces expired download URLs.
+//
+// Presigned URLs for all files in an artifact expire around the same
+// time, so all URLs are fetched at once and reused by the other files.
+type fileURLRefresher struct {
+	mu        sync.Mutex
+	fetchedAt time.Time
+	urls      map[string]string
+
+	// fetch returns fresh URLs for all files by name.
+	fetch func() (map[string]string, error)
+}
+
+// url returns a download URL for the named file, fetching URLs only if
+// none were fetched before.
+func (r *fileURLRefresher) url(name string) (string, error) {
+	r.mu.Lock()
+	urls := r.urls
+	r.mu.Unlock()
+
+	if urls == nil {
+		return r.get(name)
+	}
+	url, ok := urls[name]
+	if !ok {
+		return "", fmt.Errorf("no download URL for %s", name)
+	}
+	return url, nil
+}
+
+// get returns a fresh download URL for the named file.
+func (r *fileURLRefresher) get(name string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.urls == nil || time.Since(r.fetchedAt) > urlRefreshInterval {
+		urls, err := r.fetch()
+		if err != nil {
+			return "", err
+		}
+		r.urls = urls
+		r.fetchedAt = time.Now()
+	}
+
+	url, ok := r.urls[name]
+	if !ok {
+		return "", fmt.Errorf("no download URL for %s", name)
+	}
+	return url, nil
 }
 
 func (ad *ArtifactDownloader) Download() (rerr error) {
@@ -204,5 +384,11 @@ func (ad *ArtifactDownloader) Download() (rerr error) {
 	if err := ad.downloadFiles(ad.ArtifactID, artifactManifest); err != nil {
 		return err
 	}
+
+	if ad.cacheMaxSize > 0 {
+		if _, err := ad.FileCache.Cleanup(ad.cacheMaxSize); err != nil {
+			slog.Error("Error cleaning up artifacts cache", "err", err)
+		}
+	}
 	return nil
 }
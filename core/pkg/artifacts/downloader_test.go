@@ -0,0 +1,210 @@
+package artifacts
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// fakeDownloadManager writes the name of each downloaded file as its
+// contents, failing downloads of some files.
+type fakeDownloadManager struct {
+	mu         sync.Mutex
+	downloaded []string
+	tasks      []*filetransfer.Task
+	fail       map[string]bool
+	missing    map[string]bool
+}
+
+func (m *fakeDownloadManager) AddTask(task *filetransfer.Task) {
+	m.mu.Lock()
+	m.downloaded = append(m.downloaded, task.Name)
+	m.tasks = append(m.tasks, task)
+	m.mu.Unlock()
+
+	switch {
+	case m.fail[task.Name]:
+		task.Err = errors.New("download failed")
+	case m.missing[task.Name]:
+		task.Err = &filetransfer.StatusError{
+			StatusCode: 404,
+			Status:     "404 Not Found",
+		}
+	default:
+		task.Err = os.WriteFile(task.Path, []byte(task.Name), 0o644)
+	}
+	task.CompletionCallback(task)
+}
+
+func (m *fakeDownloadManager) DownloadStream(
+	task *filetransfer.Task,
+) (io.ReadCloser, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *fakeDownloadManager) Close() {}
+
+func artifactFileURLsResponse(names []string) string {
+	var edges []string
+	for _, name := range names {
+		edges = append(edges,
+			`{"node": {"name": "`+name+`", "directUrl": "https://example.com/`+name+`"}}`)
+	}
+	return `{"artifact": {"files": {
+		"pageInfo": {"hasNextPage": false, "endCursor": null},
+		"edges": [` + strings.Join(edges, ",") + `]}}}`
+}
+
+func TestDownloadFiles_ResumesFromCheckpoint(t *testing.T) {
+	names := []string{"e.txt", "d.txt", "c.txt", "b.txt", "a.txt"}
+	manifest := Manifest{Contents: map[string]ManifestEntry{}}
+	for _, name := range names {
+		manifest.Contents[name] = ManifestEntry{Digest: utils.ComputeB64MD5([]byte(name))}
+	}
+	root := t.TempDir()
+	newDownloader := func(manager *fakeDownloadManager) *ArtifactDownloader {
+		mockGQL := gqlmock.NewMockClient()
+		mockGQL.StubAnyOnce(artifactFileURLsResponse(names))
+		return NewArtifactDownloader(
+			context.Background(),
+			mockGQL,
+			manager,
+			"artifact-id",
+			root,
+			false,
+			true,
+			"",
+			WithDownloadBatchSize(2),
+		)
+	}
+
+	// The second batch fails, so only the first is checkpointed.
+	interrupted := &fakeDownloadManager{fail: map[string]bool{"c.txt": true}}
+	err := newDownloader(interrupted).downloadFiles("artifact-id", manifest)
+	require.Error(t, err)
+	checkpoint := loadDownloadCheckpoint(root, "artifact-id", len(names), 2)
+	assert.Equal(t, 1, checkpoint.Completed)
+
+	resumed := &fakeDownloadManager{}
+	err = newDownloader(resumed).downloadFiles("artifact-id", manifest)
+	require.NoError(t, err)
+
+	// d.txt was downloaded before the interruption and is kept, though
+	// its batch wasn't checkpointed.
+	assert.ElementsMatch(t, []string{"c.txt", "e.txt"}, resumed.downloaded)
+	for _, name := range names {
+		assert.FileExists(t, filepath.Join(root, name))
+	}
+	assert.NoFileExists(t, filepath.Join(root, downloadCheckpointName))
+}
+
+func TestDownloadFiles_References(t *testing.T) {
+	ref := func(ref string) ManifestEntry {
+		return ManifestEntry{Digest: "etag", Ref: &ref}
+	}
+	manifest := Manifest{Contents: map[string]ManifestEntry{
+		"file.txt": {Digest: utils.ComputeB64MD5([]byte("file.txt"))},
+		"hdfs.txt": ref("hdfs://namenode/data/hdfs.txt"),
+		"http.txt": ref("https://data.example.com/http.txt"),
+		"s3.txt":   ref("s3://bucket/s3.txt"),
+		"azure.txt": ref(
+			"https://account.blob.core.windows.net/container/azure.txt"),
+	}}
+	mockGQL := gqlmock.NewMockClient()
+	mockGQL.StubAnyOnce(artifactFileURLsResponse([]string{"file.txt"}))
+	manager := &fakeDownloadManager{}
+	downloader := NewArtifactDownloader(
+		context.Background(),
+		mockGQL,
+		manager,
+		"artifact-id",
+		t.TempDir(),
+		false,
+		true,
+		"",
+	)
+
+	err := downloader.downloadFiles("artifact-id", manifest)
+
+	require.NoError(t, err)
+	urls := make(map[string]string)
+	for _, task := range manager.tasks {
+		assert.Equal(t, task.Name != "file.txt", task.Reference)
+		urls[task.Name] = task.Url
+	}
+	assert.Equal(t,
+		map[string]string{
+			"file.txt": "https://example.com/file.txt",
+			"hdfs.txt": "hdfs://namenode/data/hdfs.txt",
+			"http.txt": "https://data.example.com/http.txt",
+		},
+		urls)
+}
+
+func TestDownloadFiles_AllowMissingReferences(t *testing.T) {
+	ref := "https://data.example.com/missing.txt"
+	manifest := Manifest{Contents: map[string]ManifestEntry{
+		"missing.txt": {Digest: "etag", Ref: &ref},
+	}}
+
+	for _, allowMissing := range []bool{false, true} {
+		downloader := NewArtifactDownloader(
+			context.Background(),
+			gqlmock.NewMockClient(),
+			&fakeDownloadManager{missing: map[string]bool{"missing.txt": true}},
+			"artifact-id",
+			t.TempDir(),
+			allowMissing,
+			true,
+			"",
+		)
+
+		err := downloader.downloadFiles("artifact-id", manifest)
+
+		if allowMissing {
+			assert.NoError(t, err)
+		} else {
+			assert.ErrorContains(t, err, "404")
+		}
+	}
+}
+
+func TestLoadDownloadCheckpoint(t *testing.T) {
+	root := t.TempDir()
+	saved := loadDownloadCheckpoint(root, "artifact-id", 10, 2)
+	saved.Completed = 3
+	require.NoError(t, saved.save())
+
+	testCases := []struct {
+		name       string
+		artifactID string
+		numFiles   int
+		batchSize  int
+		completed  int
+	}{
+		{"same download", "artifact-id", 10, 2, 3},
+		{"other artifact", "other-id", 10, 2, 0},
+		{"other files", "artifact-id", 11, 2, 0},
+		{"other batches", "artifact-id", 10, 3, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkpoint := loadDownloadCheckpoint(
+				root, tc.artifactID, tc.numFiles, tc.batchSize)
+
+			assert.Equal(t, tc.completed, checkpoint.Completed)
+		})
+	}
+}
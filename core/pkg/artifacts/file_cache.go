@@ -9,6 +9,8 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
+	"time"
 
 	"github.com/wandb/wandb/core/pkg/utils"
 )
@@ -20,17 +22,37 @@ type Cache interface {
 	AddFileAndCheckDigest(path string, digest string) error
 	RestoreTo(entry ManifestEntry, dst string) bool
 	Write(src io.Reader) (string, error)
+	Cleanup(targetSize int64) (int64, error)
 }
 
 type FileCache struct {
 	root string
+
+	// hardLinks is whether to restore files as hard links to the cache.
+	hardLinks bool
+}
+
+type FileCacheOption func(c *FileCache)
+
+// WithHardLinks makes RestoreTo hard link files from the cache instead of
+// copying them, when the cache and the destination are on one filesystem.
+//
+// Modifying a restored file in place then also modifies the cached copy.
+func WithHardLinks(hardLinks bool) FileCacheOption {
+	return func(c *FileCache) {
+		c.hardLinks = hardLinks
+	}
 }
 
 // HashOnlyCache never writes data but still computes and compares hashes.
 type HashOnlyCache struct{}
 
-func NewFileCache(cacheDir string) Cache {
-	return &FileCache{root: filepath.Join(cacheDir, "artifacts")}
+func NewFileCache(cacheDir string, opts ...FileCacheOption) Cache {
+	c := &FileCache{root: filepath.Join(cacheDir, "artifacts")}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 func NewHashOnlyCache() Cache {
@@ -135,9 +157,93 @@ func (c *FileCache) RestoreTo(entry ManifestEntry, dst string) bool {
 			return false
 		}
 	}
+
+	if !c.restore(cachePath, dst) {
+		return false
+	}
+
+	// Mark the file as recently used so that Cleanup keeps it.
+	now := time.Now()
+	_ = os.Chtimes(cachePath, now, now)
+	return true
+}
+
+// restore copies or hard links a cached file to the destination.
+func (c *FileCache) restore(cachePath, dst string) bool {
+	if c.hardLinks {
+		if exists, _ := utils.FileExists(cachePath); !exists {
+			return false
+		}
+		if err := os.MkdirAll(filepath.Dir(dst), defaultDirPermissions); err != nil {
+			return false
+		}
+		_ = os.Remove(dst)
+		if os.Link(cachePath, dst) == nil {
+			return true
+		}
+		// Fall back to copying, e.g. across filesystems.
+	}
 	return utils.CopyFile(cachePath, dst) == nil
 }
 
+// Cleanup removes the least recently used files from the cache until it
+// is at most targetSize bytes, and returns the number of bytes removed.
+func (c *FileCache) Cleanup(targetSize int64) (int64, error) {
+	type cachedFile struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+
+	var files []cachedFile
+	var totalSize int64
+	err := filepath.WalkDir(
+		filepath.Join(c.root, "obj"),
+		func(path string, d os.DirEntry, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+			if !d.Type().IsRegular() {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			files = append(files, cachedFile{path, info.Size(), info.ModTime()})
+			totalSize += info.Size()
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	slices.SortFunc(files, func(a, b cachedFile) int {
+		return a.modTime.Compare(b.modTime)
+	})
+
+	var removed int64
+	for _, file := range files {
+		if totalSize-removed <= targetSize {
+			break
+		}
+		if err := os.Remove(file.path); err != nil {
+			return removed, err
+		}
+		removed += file.size
+	}
+	return removed, nil
+}
+
+// Cleanup does nothing, since the HashOnlyCache doesn't store files.
+func (c *HashOnlyCache) Cleanup(targetSize int64) (int64, error) {
+	return 0, nil
+}
+
 // RestoreTo returns true if the file exists at the destination and its hash matches the digest.
 //
 // This is the same behavior as FileCache.RestoreTo if the cache is empty, since the
@@ -193,6 +299,8 @@ func (c *FileCache) Write(src io.Reader) (string, error) {
 		return "", err
 	}
 	if exists, _ := utils.FileExists(dstPath); exists {
+		now := time.Now()
+		_ = os.Chtimes(dstPath, now, now)
 		return b64md5, nil
 	}
 	if err := os.MkdirAll(filepath.Dir(dstPath), defaultDirPermissions); err != nil {
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -229,3 +230,61 @@ func TestFileCache_RestoreToReference(t *testing.T) {
 	noOpCache := NewHashOnlyCache()
 	assert.False(t, noOpCache.RestoreTo(manifestEntry, localPath))
 }
+
+func TestFileCache_RestoreToHardLink(t *testing.T) {
+	cache, cleanup := setupTestEnvironment(t)
+	defer cleanup()
+	WithHardLinks(true)(cache)
+
+	cacheKey, err := cache.Write(bytes.NewReader([]byte("linked data")))
+	require.NoError(t, err)
+	localPath := filepath.Join(t.TempDir(), "dir", "restore_target.test")
+
+	assert.True(t, cache.RestoreTo(ManifestEntry{Digest: cacheKey}, localPath))
+
+	internalPath, err := cache.md5Path(cacheKey)
+	require.NoError(t, err)
+	cacheInfo, err := os.Stat(internalPath)
+	require.NoError(t, err)
+	restoredInfo, err := os.Stat(localPath)
+	require.NoError(t, err)
+	assert.True(t, os.SameFile(cacheInfo, restoredInfo))
+}
+
+func TestFileCache_Cleanup(t *testing.T) {
+	cache, cleanup := setupTestEnvironment(t)
+	defer cleanup()
+
+	// Write three 10-byte files, used from oldest to newest.
+	var keys []string
+	for i, data := range []string{"aaaaaaaaaa", "bbbbbbbbbb", "cccccccccc"} {
+		key, err := cache.Write(bytes.NewReader([]byte(data)))
+		require.NoError(t, err)
+		path, err := cache.md5Path(key)
+		require.NoError(t, err)
+		usedAt := time.Now().Add(time.Duration(i-3) * time.Hour)
+		require.NoError(t, os.Chtimes(path, usedAt, usedAt))
+		keys = append(keys, key)
+	}
+
+	removed, err := cache.Cleanup(20)
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 10, removed)
+	for i, key := range keys {
+		path, err := cache.md5Path(key)
+		require.NoError(t, err)
+		exists, _ := utils.FileExists(path)
+		assert.Equal(t, i > 0, exists)
+	}
+}
+
+func TestFileCache_CleanupEmpty(t *testing.T) {
+	cache, cleanup := setupTestEnvironment(t)
+	defer cleanup()
+
+	removed, err := cache.Cleanup(0)
+
+	require.NoError(t, err)
+	assert.Zero(t, removed)
+}
@@ -3,13 +3,12 @@ package artifacts
 import (
 	"context"
 	"fmt"
-	"io"
+	"maps"
 	"math"
 	"net/url"
 	"os"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
@@ -36,6 +35,73 @@ type ArtifactSaver struct {
 	numTotal         int
 	numDone          int
 	startTime        time.Time
+
+	// multipartThreshold is the minimum size of files uploaded in parts.
+	multipartThreshold int64
+
+	// maxPartRetries is the number of times a failed part is retried
+	// before the whole file is retried.
+	maxPartRetries int
+
+	// digestConcurrency is the number of file parts hashed at once.
+	digestConcurrency int
+
+	// maxFailures is the number of files that may fail to upload without
+	// failing the save.
+	maxFailures int
+
+	// failures are the files that failed to upload.
+	failures []FileFailure
+}
+
+type ArtifactSaverOption func(as *ArtifactSaver)
+
+// WithMultipartThreshold sets the minimum size of files uploaded in parts.
+//
+// Non-positive values are ignored.
+func WithMultipartThreshold(threshold int64) ArtifactSaverOption {
+	return func(as *ArtifactSaver) {
+		if threshold > 0 {
+			as.multipartThreshold = threshold
+		}
+	}
+}
+
+// WithMaxPartRetries sets the number of times a failed part of a multipart
+// upload is retried.
+//
+// Negative values are ignored.
+func WithMaxPartRetries(retries int) ArtifactSaverOption {
+	return func(as *ArtifactSaver) {
+		if retries >= 0 {
+			as.maxPartRetries = retries
+		}
+	}
+}
+
+// WithDigestConcurrency sets the number of file parts hashed at once
+// before a multipart upload.
+//
+// Non-positive values are ignored.
+func WithDigestConcurrency(concurrency int) ArtifactSaverOption {
+	return func(as *ArtifactSaver) {
+		if concurrency > 0 {
+			as.digestConcurrency = concurrency
+		}
+	}
+}
+
+// WithMaxFailures sets the number of files that may fail to upload
+// without failing the save.
+//
+// Files that fail are left out of the artifact's manifest. Negative
+// values are ignored.
+func WithMaxFailures(n int) ArtifactSaverOption {
+	return func(as *ArtifactSaver) {
+		if n >= 0 {
+			as.maxFailures = n
+		}
+	}
 }
 
 type multipartUploadInfo = []gql.CreateArtifactFilesCreateArtifactFilesCreateArtifactFilesPayloadFilesFileConnectionEdgesFileEdgeNodeFileUploadMultipartUrlsUploadUrlPartsUploadUrlPart
@@ -66,8 +132,9 @@ func NewArtifactSaver(
 	artifact *service.ArtifactRecord,
 	historyStep int64,
 	stagingDir string,
+	opts ...ArtifactSaverOption,
 ) ArtifactSaver {
-	return ArtifactSaver{
+	as := ArtifactSaver{
 		Ctx:                 ctx,
 		Logger:              logger,
 		GraphqlClient:       graphQLClient,
@@ -77,10 +144,19 @@ func NewArtifactSaver(
 		HistoryStep:         historyStep,
 		StagingDir:          stagingDir,
 		maxActiveBatches:    5,
+		multipartThreshold:  S3MinMultiUploadSize,
+		maxPartRetries:      DefaultMaxPartRetries,
+		digestConcurrency:   DefaultDigestConcurrency,
+	}
+
+	for _, opt := range opts {
+		opt(&as)
 	}
+
+	return as
 }
 
-func (as *ArtifactSaver) createArtifact() (
+func (as *ArtifactSaver) createArtifact(digest string) (
 	attrs gql.CreateArtifactCreateArtifactCreateArtifactPayloadArtifact,
 	rerr error,
 ) {
@@ -107,7 +183,7 @@ func (as *ArtifactSaver) createArtifact() (
 		as.Artifact.Type,
 		as.Artifact.Name,
 		runId,
-		as.Artifact.Digest,
+		digest,
 		utils.NilIfZero(as.Artifact.Description),
 		aliases,
 		utils.NilIfZero(as.Artifact.Metadata),
@@ -198,16 +274,26 @@ func (as *ArtifactSaver) uploadFiles(
 	manifest *Manifest,
 	manifestID string,
 ) error {
+	// Hash the parts of large files, which is slow enough to do in parallel.
+	var paths []string
+	for _, entry := range manifest.Contents {
+		if entry.LocalPath != nil {
+			paths = append(paths, *entry.LocalPath)
+		}
+	}
+	partsByPath, err := multiPartRequests(
+		paths, as.multipartThreshold, as.digestConcurrency)
+	if err != nil {
+		return err
+	}
+
 	// Prepare GQL input for files that (might) need to be uploaded.
 	namedFileSpecs := map[string]gql.CreateArtifactFileSpecInput{}
 	for name, entry := range manifest.Contents {
 		if entry.LocalPath == nil {
 			continue
 		}
-		parts, err := multiPartRequest(*entry.LocalPath)
-		if err != nil {
-			return err
-		}
+		parts := partsByPath[*entry.LocalPath]
 		fileSpec := gql.CreateArtifactFileSpecInput{
 			ArtifactID:         artifactID,
 			Name:               name,
@@ -217,15 +303,27 @@ func (as *ArtifactSaver) uploadFiles(
 		}
 		namedFileSpecs[name] = fileSpec
 	}
-	as.numTotal = len(namedFileSpecs)
+	// Files that failed in an earlier attempt count as finished.
+	as.numTotal = len(as.failures) + len(namedFileSpecs)
+	as.numDone = 0
 
 	as.startTime = time.Now()
-	var err error
 	for len(namedFileSpecs) > 0 {
 		numNeedUploading := len(namedFileSpecs)
-		if namedFileSpecs, err = as.processFiles(manifest, namedFileSpecs); err != nil {
+		mustRetry, errs, err := as.processFiles(manifest, namedFileSpecs)
+		if err != nil {
 			return err
 		}
+
+		// Retrying can't fix some errors, like missing files.
+		for name, err := range errs {
+			if _, retryable := filetransfer.ClassifyError(err); !retryable {
+				as.addFailure(name, err)
+				delete(mustRetry, name)
+			}
+		}
+		namedFileSpecs = mustRetry
+
 		// If more than half of the remaining files uploaded we'll keep retrying.
 		// We shouldn't ordinarily need to retry at all: our internal client handles
 		// retryable errors, and the only failure this retry loop is for is when signed
@@ -233,27 +331,34 @@ func (as *ArtifactSaver) uploadFiles(
 		// Still, as long as more than half of them succeed in each iteration this will
 		// eventually terminate, so we're generous with our retry policy.
 		if len(namedFileSpecs) > numNeedUploading/2 {
-			return fmt.Errorf(
-				"most remaining uploads (%d/%d) have failed, giving up",
-				len(namedFileSpecs), numNeedUploading,
+			as.Logger.Warn(
+				"most remaining uploads have failed, giving up",
+				"failed", len(namedFileSpecs),
+				"remaining", numNeedUploading,
 			)
+			for name := range namedFileSpecs {
+				as.addFailure(name, errs[name])
+			}
+			break
 		}
 		if len(namedFileSpecs) > 0 {
 			as.Logger.Warn("some files failed to upload, retrying", "count", len(namedFileSpecs))
 		}
 	}
-	return nil
+	return as.checkFailures(manifest)
 }
 
+// processFiles uploads files, returning those that failed and why.
 func (as *ArtifactSaver) processFiles(
 	manifest *Manifest, namedFileSpecs map[string]gql.CreateArtifactFileSpecInput,
-) (map[string]gql.CreateArtifactFileSpecInput, error) {
+) (map[string]gql.CreateArtifactFileSpecInput, map[string]error, error) {
 	// Channels to get responses from the batch url retrievers.
 	readyChan := make(chan serverFileResponse)
 	errorChan := make(chan error)
 
 	doneChan := make(chan uploadResult, len(namedFileSpecs))
 	mustRetry := map[string]gql.CreateArtifactFileSpecInput{}
+	errs := map[string]error{}
 
 	numActive := 0
 
@@ -263,7 +368,7 @@ func (as *ArtifactSaver) processFiles(
 		fileSpecs = append(fileSpecs, spec)
 	}
 
-	for as.numDone+len(mustRetry) < as.numTotal {
+	for as.numDone+len(as.failures)+len(mustRetry) < as.numTotal {
 		// Start new batches until we get to the desired number of active uploads.
 		for len(fileSpecs) > 0 && numActive <= (as.maxActiveBatches-1)*as.batchSize() {
 			batch, fileSpecs = as.nextBatch(fileSpecs)
@@ -300,15 +405,16 @@ func (as *ArtifactSaver) processFiles(
 			numActive--
 			if result.err != nil {
 				mustRetry[result.name] = namedFileSpecs[result.name]
+				errs[result.name] = result.err
 			} else {
 				as.numDone++
 			}
 		// Check for errors.
 		case err := <-errorChan:
-			return nil, err
+			return nil, nil, err
 		}
 	}
-	return mustRetry, nil
+	return mustRetry, errs, nil
 }
 
 // batchFileDataRetriever takes a batch of file specs, requests upload URLs for each file,
@@ -383,49 +489,18 @@ const (
 	S3MaxMultiUploadSize = 5 << 40   // 5 TiB, maximum possible object size
 	S3DefaultChunkSize   = 100 << 20 // 1 MiB
 	S3MaxParts           = 10000
-)
-
-func multiPartRequest(path string) ([]gql.UploadPartsInput, error) {
-	fileInfo, err := os.Stat(path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get file size for path %s: %w", path, err)
-	}
-	fileSize := fileInfo.Size()
-
-	if fileSize < S3MinMultiUploadSize {
-		// We don't need to use multipart for small files.
-		return nil, nil
-	}
-	if fileSize > S3MaxMultiUploadSize {
-		return nil, fmt.Errorf("file size exceeds maximum S3 object size: %v", fileSize)
-	}
-
-	file, err := os.Open(path)
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
 
-	partsInfo := []gql.UploadPartsInput{}
-	partNumber := int64(1)
-	buffer := make([]byte, getChunkSize(fileSize))
-	for {
-		bytesRead, err := file.Read(buffer)
-		if err != nil && err != io.EOF {
-			return nil, err
-		}
-		if bytesRead == 0 {
-			break
-		}
-		partsInfo = append(partsInfo, gql.UploadPartsInput{
-			PartNumber: partNumber,
-			HexMD5:     utils.ComputeHexMD5(buffer[:bytesRead]),
-		})
-		partNumber++
-	}
-	return partsInfo, nil
-}
+	// DefaultMaxPartRetries is the default number of times a failed part
+	// of a multipart upload is retried.
+	DefaultMaxPartRetries = 3
+)
 
+// uploadMultipart uploads a file in parts using the presigned part URLs
+// returned by CreateArtifactFiles.
+//
+// Failed parts are retried individually. An interrupted multipart upload
+// is not resumed: CreateArtifactFiles has no way to continue an existing
+// upload ID, so every call starts a new upload with new part URLs.
 func (as *ArtifactSaver) uploadMultipart(
 	path string,
 	fileInfo serverFileResponse,
@@ -438,11 +513,13 @@ func (as *ArtifactSaver) uploadMultipart(
 	chunkSize := getChunkSize(statInfo.Size())
 
 	type partResponse struct {
+		index      int
 		partNumber int64
 		task       *filetransfer.Task
 	}
 
-	wg := sync.WaitGroup{}
+	// Each part has at most one task in flight, so sends never block
+	// even if we return early.
 	partResponses := make(chan partResponse, len(partData))
 	// TODO: add mid-upload cancel.
 
@@ -452,15 +529,15 @@ func (as *ArtifactSaver) uploadMultipart(
 	}
 
 	partInfo := fileInfo.multipartUploadInfo
-	for i, part := range partInfo {
+	addPartTask := func(i int) error {
 		task := newUploadTask(fileInfo, path)
-		task.Url = part.UploadUrl
+		task.Url = partInfo[i].UploadUrl
 		task.Offset = int64(i) * chunkSize
 		remainingSize := statInfo.Size() - task.Offset
 		task.Size = min(remainingSize, chunkSize)
 		b64md5, err := utils.HexToB64(partData[i].HexMD5)
 		if err != nil {
-			return uploadResult{name: fileInfo.name, err: err}
+			return err
 		}
 		task.Headers = []string{
 			"Content-Md5:" + b64md5,
@@ -468,22 +545,47 @@ func (as *ArtifactSaver) uploadMultipart(
 			"Content-Type:" + contentType,
 		}
 		task.SetCompletionCallback(func(t *filetransfer.Task) {
-			partResponses <- partResponse{partNumber: partData[i].PartNumber, task: t}
-			wg.Done()
+			partResponses <- partResponse{
+				index:      i,
+				partNumber: partData[i].PartNumber,
+				task:       t,
+			}
 		})
-		wg.Add(1)
 		as.FileTransferManager.AddTask(task)
+		return nil
 	}
 
-	go func() {
-		wg.Wait()
-		close(partResponses)
-	}()
+	numPending := 0
+	for i := range partInfo {
+		if err := addPartTask(i); err != nil {
+			return uploadResult{name: fileInfo.name, err: err}
+		}
+		numPending++
+	}
 
 	partEtags := make([]gql.UploadPartsInput, len(partData))
+	partRetries := make([]int, len(partData))
+
+	for numPending > 0 {
+		t := <-partResponses
+		numPending--
 
-	for t := range partResponses {
 		err := t.task.Err
+		if err != nil && partRetries[t.index] < as.maxPartRetries {
+			partRetries[t.index]++
+			as.Logger.Warn(
+				"artifacts: retrying failed part upload",
+				"name", fileInfo.name,
+				"part", t.partNumber,
+				"attempt", partRetries[t.index],
+				"error", err,
+			)
+			if err := addPartTask(t.index); err != nil {
+				return uploadResult{name: fileInfo.name, err: err}
+			}
+			numPending++
+			continue
+		}
 		if err != nil {
 			return uploadResult{name: fileInfo.name, err: err}
 		}
@@ -630,15 +732,32 @@ func (as *ArtifactSaver) deleteStagingFiles(manifest *Manifest) {
 	}
 }
 
+// Failures returns the files that failed to upload during Save,
+// including those that were tolerated.
+func (as *ArtifactSaver) Failures() []FileFailure {
+	return as.failures
+}
+
 func (as *ArtifactSaver) Save() (artifactID string, rerr error) {
 	manifest, err := NewManifestFromProto(as.Artifact.Manifest)
 	if err != nil {
 		return "", err
 	}
 
-	defer as.deleteStagingFiles(&manifest)
+	// Files that fail to upload are left out of the manifest, but their
+	// staging copies are still deleted.
+	defer as.deleteStagingFiles(&Manifest{Contents: maps.Clone(manifest.Contents)})
+
+	return as.save(&manifest, as.Artifact.Digest)
+}
 
-	artifactAttrs, err := as.createArtifact()
+// save creates an artifact with the given digest and uploads the
+// manifest's files to it.
+func (as *ArtifactSaver) save(
+	manifest *Manifest,
+	digest string,
+) (artifactID string, rerr error) {
+	artifactAttrs, err := as.createArtifact(digest)
 	if err != nil {
 		return "", fmt.Errorf("ArtifactSaver.createArtifact: %w", err)
 	}
@@ -677,12 +796,28 @@ func (as *ArtifactSaver) Save() (artifactID string, rerr error) {
 		return "", fmt.Errorf("ArtifactSaver.createManifest: %w", err)
 	}
 
-	err = as.uploadFiles(artifactID, &manifest, manifestAttrs.Id)
+	err = as.uploadFiles(artifactID, manifest, manifestAttrs.Id)
 	if err != nil {
 		return "", fmt.Errorf("ArtifactSaver.uploadFiles: %w", err)
 	}
 
-	err = as.resolveClientIDReferences(&manifest)
+	// Tolerated failures are left out of the manifest, so it no longer
+	// matches the digest the artifact was created with. The server
+	// deduplicates artifacts by digest, so committing this one would make
+	// a later save of all the files resolve to it. Instead, the remaining
+	// files are saved as an artifact with the digest of what they contain.
+	if len(as.failures) > 0 {
+		if reducedDigest := computeManifestDigest(manifest); reducedDigest != digest {
+			as.Logger.Warn(
+				"artifacts: saving artifact without the files that failed to upload",
+				"failed", len(as.failures),
+				"digest", reducedDigest,
+			)
+			return as.save(manifest, reducedDigest)
+		}
+	}
+
+	err = as.resolveClientIDReferences(manifest)
 	if err != nil {
 		return "", fmt.Errorf("ArtifactSaver.resolveClientIDReferences: %w", err)
 	}
@@ -2,9 +2,12 @@ package artifacts
 
 import (
 	"math"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetChunkSize(t *testing.T) {
@@ -37,3 +40,17 @@ func TestGetChunkSize(t *testing.T) {
 		}
 	}
 }
+
+func TestMultiPartRequest_Threshold(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(path, make([]byte, 1024), 0o644))
+
+	parts, err := multiPartRequest(path, 2048)
+	require.NoError(t, err)
+	assert.Nil(t, parts)
+
+	parts, err = multiPartRequest(path, 1024)
+	require.NoError(t, err)
+	assert.Len(t, parts, 1)
+	assert.Equal(t, int64(1), parts[0].PartNumber)
+}
@@ -0,0 +1,105 @@
+package artifacts
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// maxListedFailures is the number of failures described in an
+// UploadError's message.
+const maxListedFailures = 3
+
+// FileFailure describes a file in an artifact that failed to upload.
+type FileFailure struct {
+	// Name is the file's path in the artifact.
+	Name string
+
+	// Kind is the kind of error the upload failed with.
+	Kind filetransfer.ErrorKind
+
+	// Retryable is whether uploading the file again might succeed.
+	Retryable bool
+
+	// Err is the error the upload failed with.
+	Err error
+}
+
+// ToProto converts the failure to its protobuf representation.
+func (f FileFailure) ToProto() *service.FileTransferFailure {
+	return &service.FileTransferFailure{
+		Name:         f.Name,
+		ErrorKind:    string(f.Kind),
+		Retryable:    f.Retryable,
+		ErrorMessage: f.Err.Error(),
+	}
+}
+
+// UploadError is returned when more files fail to upload than are
+// tolerated.
+type UploadError struct {
+	// Failures are the files that failed to upload, sorted by name.
+	Failures []FileFailure
+
+	// Total is the number of files that were uploaded.
+	Total int
+}
+
+func (e *UploadError) Error() string {
+	var descriptions []string
+	for _, failure := range e.Failures[:min(len(e.Failures), maxListedFailures)] {
+		descriptions = append(descriptions,
+			fmt.Sprintf("%s (%s): %v", failure.Name, failure.Kind, failure.Err))
+	}
+	if more := len(e.Failures) - maxListedFailures; more > 0 {
+		descriptions = append(descriptions, fmt.Sprintf("and %d more", more))
+	}
+
+	return fmt.Sprintf(
+		"%d of %d files failed to upload: %s",
+		len(e.Failures), e.Total, strings.Join(descriptions, "; "),
+	)
+}
+
+// addFailure records a file that failed to upload.
+func (as *ArtifactSaver) addFailure(name string, err error) {
+	if err == nil {
+		err = fmt.Errorf("upload failed")
+	}
+	kind, retryable := filetransfer.ClassifyError(err)
+	as.failures = append(as.failures, FileFailure{
+		Name:      name,
+		Kind:      kind,
+		Retryable: retryable,
+		Err:       err,
+	})
+}
+
+// checkFailures returns an UploadError if too many files failed to
+// upload, and otherwise removes the failed files from the manifest.
+func (as *ArtifactSaver) checkFailures(manifest *Manifest) error {
+	if len(as.failures) == 0 {
+		return nil
+	}
+	sort.Slice(as.failures, func(i, j int) bool {
+		return as.failures[i].Name < as.failures[j].Name
+	})
+
+	if len(as.failures) > as.maxFailures {
+		return &UploadError{Failures: as.failures, Total: as.numTotal}
+	}
+
+	for _, failure := range as.failures {
+		as.Logger.Warn(
+			"artifacts: leaving out file that failed to upload",
+			"name", failure.Name,
+			"kind", failure.Kind,
+			"error", failure.Err,
+		)
+		delete(manifest.Contents, failure.Name)
+	}
+	return nil
+}
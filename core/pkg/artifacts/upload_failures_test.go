@@ -0,0 +1,220 @@
+package artifacts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/internal/gqlmock"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// failingFileTransferManager fails uploads of some files.
+type failingFileTransferManager struct {
+	errs map[string]error
+
+	// uploads are the contents of the files uploaded to each URL.
+	uploads map[string][]byte
+}
+
+func (m *failingFileTransferManager) AddTask(task *filetransfer.Task) {
+	task.Err = m.errs[task.Name]
+	if task.Err == nil && m.uploads != nil {
+		m.uploads[task.Url], task.Err = os.ReadFile(task.Path)
+	}
+	go task.CompletionCallback(task)
+}
+
+func (m *failingFileTransferManager) DownloadStream(
+	task *filetransfer.Task,
+) (io.ReadCloser, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *failingFileTransferManager) Close() {}
+
+func createArtifactFilesResponse(numFiles int) string {
+	edge := `{"node": {"uploadUrl": "https://example.com/upload", "artifact": {"id": "artifact-id"}}}`
+	edges := strings.TrimSuffix(strings.Repeat(edge+",", numFiles), ",")
+	return `{"createArtifactFiles": {"files": {"edges": [` + edges + `]}}}`
+}
+
+func TestUploadFiles_Failures(t *testing.T) {
+	dir := t.TempDir()
+	manifest := &Manifest{Contents: map[string]ManifestEntry{}}
+	for _, name := range []string{"a.txt", "b.txt", "c.txt"} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(name), 0o644))
+		manifest.Contents[name] = ManifestEntry{LocalPath: &path, SkipCache: true}
+	}
+	notFound := &filetransfer.StatusError{StatusCode: 404, Status: "404 Not Found"}
+	fileTransferManager := &failingFileTransferManager{
+		errs: map[string]error{"b.txt": notFound},
+	}
+
+	t.Run("tolerated", func(t *testing.T) {
+		manifest := &Manifest{Contents: maps.Clone(manifest.Contents)}
+		mockGQL := gqlmock.NewMockClient()
+		mockGQL.StubAnyOnce(createArtifactFilesResponse(3))
+		saver := NewArtifactSaver(
+			context.Background(),
+			observability.NewNoOpLogger(),
+			mockGQL,
+			fileTransferManager,
+			&service.ArtifactRecord{},
+			0,
+			"",
+			WithMaxFailures(1),
+		)
+
+		err := saver.uploadFiles("artifact-id", manifest, "manifest-id")
+
+		require.NoError(t, err)
+		assert.NotContains(t, manifest.Contents, "b.txt")
+		assert.Len(t, manifest.Contents, 2)
+		require.Len(t, saver.Failures(), 1)
+		failure := saver.Failures()[0]
+		assert.Equal(t, "b.txt", failure.Name)
+		assert.Equal(t, filetransfer.ErrorKindNotFound, failure.Kind)
+		assert.False(t, failure.Retryable)
+	})
+
+	t.Run("not tolerated", func(t *testing.T) {
+		manifest := &Manifest{Contents: maps.Clone(manifest.Contents)}
+		mockGQL := gqlmock.NewMockClient()
+		mockGQL.StubAnyOnce(createArtifactFilesResponse(3))
+		saver := NewArtifactSaver(
+			context.Background(),
+			observability.NewNoOpLogger(),
+			mockGQL,
+			fileTransferManager,
+			&service.ArtifactRecord{},
+			0,
+			"",
+		)
+
+		err := saver.uploadFiles("artifact-id", manifest, "manifest-id")
+
+		var uploadErr *UploadError
+		require.ErrorAs(t, err, &uploadErr)
+		assert.Equal(t, 3, uploadErr.Total)
+		assert.Equal(t,
+			"1 of 3 files failed to upload: b.txt (not_found): 404 Not Found",
+			err.Error())
+		assert.Contains(t, manifest.Contents, "b.txt")
+	})
+}
+
+func TestSave_ToleratedFailures_CommitReducedManifest(t *testing.T) {
+	dir := t.TempDir()
+	var entries []*service.ArtifactManifestEntry
+	for _, name := range []string{"a.txt", "b.txt", "c.txt"} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(name), 0o644))
+		entries = append(entries, &service.ArtifactManifestEntry{
+			Path:      name,
+			Digest:    name + "-digest",
+			LocalPath: path,
+			SkipCache: true,
+		})
+	}
+	record := &service.ArtifactRecord{
+		Manifest: &service.ArtifactManifest{Version: 1, Contents: entries},
+		Finalize: true,
+	}
+	fullManifest, err := NewManifestFromProto(record.Manifest)
+	require.NoError(t, err)
+	record.Digest = computeManifestDigest(&fullManifest)
+	reducedManifest := Manifest{Contents: maps.Clone(fullManifest.Contents)}
+	delete(reducedManifest.Contents, "b.txt")
+	reducedDigest := computeManifestDigest(&reducedManifest)
+
+	fileTransferManager := &failingFileTransferManager{
+		errs: map[string]error{
+			"b.txt": &filetransfer.StatusError{StatusCode: 404, Status: "404 Not Found"},
+		},
+		uploads: map[string][]byte{},
+	}
+	mockGQL := gqlmock.NewMockClient()
+	stubCreateArtifact := func(digest, id string) {
+		mockGQL.StubMatchOnce(
+			gomock.All(
+				gqlmock.WithOpName("CreateArtifact"),
+				gqlmock.WithVariables(gqlmock.GQLVar("digest", gomock.Eq(digest))),
+			),
+			`{"createArtifact": {"artifact": {
+				"id": "`+id+`",
+				"state": "PENDING",
+				"artifactSequence": {"latestArtifact": null}
+			}}}`,
+		)
+	}
+	stubCreateManifest := func(uploadURL string) {
+		mockGQL.StubMatchOnce(
+			gqlmock.WithOpName("CreateArtifactManifest"),
+			`{"createArtifactManifest": {"artifactManifest": {
+				"id": "manifest-id",
+				"file": {"id": "file-id", "uploadUrl": "`+uploadURL+`"}
+			}}}`,
+		)
+	}
+	stubCreateArtifact(record.Digest, "full-artifact-id")
+	stubCreateManifest("")
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("CreateArtifactFiles"),
+		createArtifactFilesResponse(3),
+	)
+	stubCreateArtifact(reducedDigest, "reduced-artifact-id")
+	stubCreateManifest("")
+	mockGQL.StubMatchOnce(
+		gqlmock.WithOpName("CreateArtifactFiles"),
+		createArtifactFilesResponse(2),
+	)
+	stubCreateManifest("https://example.com/manifest")
+	mockGQL.StubMatchOnce(
+		gomock.All(
+			gqlmock.WithOpName("CommitArtifact"),
+			gqlmock.WithVariables(
+				gqlmock.GQLVar("artifactID", gomock.Eq("reduced-artifact-id"))),
+		),
+		`{"commitArtifact": {"artifact": {"id": "reduced-artifact-id", "digest": "`+
+			reducedDigest+`"}}}`,
+	)
+	saver := NewArtifactSaver(
+		context.Background(),
+		observability.NewNoOpLogger(),
+		mockGQL,
+		fileTransferManager,
+		record,
+		0,
+		"",
+		WithMaxFailures(1),
+	)
+
+	artifactID, err := saver.Save()
+
+	require.NoError(t, err)
+	assert.True(t, mockGQL.AllStubsUsed())
+	assert.Equal(t, "reduced-artifact-id", artifactID)
+	require.Len(t, saver.Failures(), 1)
+	assert.Equal(t, "b.txt", saver.Failures()[0].Name)
+	var committedManifest Manifest
+	require.NoError(t, json.Unmarshal(
+		fileTransferManager.uploads["https://example.com/manifest"],
+		&committedManifest,
+	))
+	assert.Len(t, committedManifest.Contents, 2)
+	assert.NotContains(t, committedManifest.Contents, "b.txt")
+	assert.Equal(t, reducedDigest, computeManifestDigest(&committedManifest))
+}
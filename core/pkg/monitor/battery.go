@@ -0,0 +1,105 @@
+package monitor
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// errNoBattery indicates that the machine has no system battery.
+var errNoBattery = errors.New("no battery found")
+
+// powerSupply is a snapshot of the machine's battery and power source.
+type powerSupply struct {
+	// Battery charge in percent
+	batteryPercent float64
+
+	// Whether the battery is being charged
+	charging bool
+
+	// Whether the machine is connected to AC power
+	onACPower bool
+}
+
+// Battery reports the battery charge and power source of laptops.
+//
+// Local runs often throttle on battery power, so these metrics help
+// explain otherwise mysterious slowdowns.
+type Battery struct {
+	name        string
+	metrics     map[string][]float64
+	mutex       sync.RWMutex
+	isAvailable bool
+}
+
+func NewBattery() *Battery {
+	b := &Battery{
+		name:    "battery",
+		metrics: map[string][]float64{},
+	}
+
+	if _, err := readPowerSupply(); err == nil {
+		b.isAvailable = true
+	}
+
+	return b
+}
+
+func (b *Battery) Name() string { return b.name }
+
+func (b *Battery) SampleMetrics() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	state, err := readPowerSupply()
+	if err != nil {
+		return err
+	}
+
+	b.metrics["battery.percent"] = append(
+		b.metrics["battery.percent"],
+		state.batteryPercent,
+	)
+	b.metrics["battery.charging"] = append(
+		b.metrics["battery.charging"],
+		boolToFloat(state.charging),
+	)
+	b.metrics["battery.onACPower"] = append(
+		b.metrics["battery.onACPower"],
+		boolToFloat(state.onACPower),
+	)
+
+	return nil
+}
+
+func (b *Battery) AggregateMetrics() map[string]float64 {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	aggregates := make(map[string]float64)
+	for metric, samples := range b.metrics {
+		if len(samples) > 0 {
+			aggregates[metric] = Average(samples)
+		}
+	}
+	return aggregates
+}
+
+func (b *Battery) ClearMetrics() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	clear(b.metrics)
+}
+
+func (b *Battery) IsAvailable() bool { return b.isAvailable }
+
+func (b *Battery) Probe() *service.MetadataRequest { return nil }
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
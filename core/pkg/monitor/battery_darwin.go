@@ -0,0 +1,43 @@
+//go:build darwin
+
+package monitor
+
+import (
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// pmsetBatteryRe matches the battery line of `pmset -g batt`, e.g.
+//
+//	-InternalBattery-0 (id=1234)	95%; charging; 0:40 remaining present: true
+var pmsetBatteryRe = regexp.MustCompile(`(\d+)%;\s*([^;]+);`)
+
+func readPowerSupply() (powerSupply, error) {
+	output, err := exec.Command("pmset", "-g", "batt").Output()
+	if err != nil {
+		return powerSupply{}, err
+	}
+	return parsePmsetOutput(string(output))
+}
+
+// parsePmsetOutput parses the output of `pmset -g batt`.
+func parsePmsetOutput(output string) (powerSupply, error) {
+	match := pmsetBatteryRe.FindStringSubmatch(output)
+	if match == nil {
+		return powerSupply{}, errNoBattery
+	}
+
+	percent, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return powerSupply{}, err
+	}
+
+	status := strings.TrimSpace(match[2])
+	return powerSupply{
+		batteryPercent: percent,
+		charging:       status == "charging" || status == "finishing charge",
+		onACPower:      strings.Contains(output, "'AC Power'"),
+	}, nil
+}
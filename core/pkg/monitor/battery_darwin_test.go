@@ -0,0 +1,58 @@
+//go:build darwin
+
+package monitor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePmsetOutput(t *testing.T) {
+	testCases := []struct {
+		name     string
+		output   string
+		expected powerSupply
+	}{
+		{
+			name: "charging",
+			output: "Now drawing from 'AC Power'\n" +
+				" -InternalBattery-0 (id=1234)\t95%; charging; 0:40 remaining present: true\n",
+			expected: powerSupply{batteryPercent: 95, charging: true, onACPower: true},
+		},
+		{
+			name: "finishing charge",
+			output: "Now drawing from 'AC Power'\n" +
+				" -InternalBattery-0 (id=1234)\t99%; finishing charge; 0:05 remaining present: true\n",
+			expected: powerSupply{batteryPercent: 99, charging: true, onACPower: true},
+		},
+		{
+			name: "charged on AC power",
+			output: "Now drawing from 'AC Power'\n" +
+				" -InternalBattery-0 (id=1234)\t100%; charged; 0:00 remaining present: true\n",
+			expected: powerSupply{batteryPercent: 100, onACPower: true},
+		},
+		{
+			name: "discharging",
+			output: "Now drawing from 'Battery Power'\n" +
+				" -InternalBattery-0 (id=1234)\t42%; discharging; 3:12 remaining present: true\n",
+			expected: powerSupply{batteryPercent: 42},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state, err := parsePmsetOutput(tc.output)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, state)
+		})
+	}
+}
+
+func TestParsePmsetOutput_NoBattery(t *testing.T) {
+	_, err := parsePmsetOutput("Now drawing from 'AC Power'\n")
+
+	assert.ErrorIs(t, err, errNoBattery)
+}
@@ -0,0 +1,82 @@
+//go:build linux
+
+package monitor
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const sysfsPowerSupplyPath = "/sys/class/power_supply"
+
+func readPowerSupply() (powerSupply, error) {
+	return readSysfsPowerSupply(sysfsPowerSupplyPath)
+}
+
+// readSysfsPowerSupply reads the power supply state from the sysfs
+// power_supply class directory.
+//
+// Batteries of peripheral devices, such as wireless mice, are ignored.
+func readSysfsPowerSupply(root string) (powerSupply, error) {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return powerSupply{}, err
+	}
+
+	var state powerSupply
+	var batteries int
+	var hasMains bool
+	var capacity float64
+
+	for _, entry := range entries {
+		dir := filepath.Join(root, entry.Name())
+
+		switch readSysfsAttr(dir, "type") {
+		case "Battery":
+			if readSysfsAttr(dir, "scope") == "Device" {
+				continue
+			}
+
+			percent, err := strconv.ParseFloat(readSysfsAttr(dir, "capacity"), 64)
+			if err != nil {
+				continue
+			}
+			batteries++
+			capacity += percent
+
+			if readSysfsAttr(dir, "status") == "Charging" {
+				state.charging = true
+			}
+
+		case "Mains", "USB", "USB_C":
+			hasMains = true
+			if readSysfsAttr(dir, "online") == "1" {
+				state.onACPower = true
+			}
+		}
+	}
+
+	if batteries == 0 {
+		return powerSupply{}, errNoBattery
+	}
+	state.batteryPercent = capacity / float64(batteries)
+
+	// Some machines don't expose their AC adapter.
+	if !hasMains {
+		state.onACPower = state.charging
+	}
+
+	return state, nil
+}
+
+// readSysfsAttr returns the trimmed contents of a sysfs attribute file,
+// or an empty string if it cannot be read.
+func readSysfsAttr(dir string, name string) string {
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
@@ -0,0 +1,74 @@
+//go:build linux
+
+package monitor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePowerSupply(t *testing.T, root, name string, attrs map[string]string) {
+	dir := filepath.Join(root, name)
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	for attr, value := range attrs {
+		require.NoError(t,
+			os.WriteFile(filepath.Join(dir, attr), []byte(value+"\n"), 0o644))
+	}
+}
+
+func TestReadSysfsPowerSupply_OnBattery(t *testing.T) {
+	root := t.TempDir()
+	writePowerSupply(t, root, "BAT0", map[string]string{
+		"type":     "Battery",
+		"capacity": "42",
+		"status":   "Discharging",
+	})
+	writePowerSupply(t, root, "AC", map[string]string{
+		"type":   "Mains",
+		"online": "0",
+	})
+
+	state, err := readSysfsPowerSupply(root)
+
+	require.NoError(t, err)
+	assert.Equal(t, 42.0, state.batteryPercent)
+	assert.False(t, state.charging)
+	assert.False(t, state.onACPower)
+}
+
+func TestReadSysfsPowerSupply_Charging(t *testing.T) {
+	root := t.TempDir()
+	writePowerSupply(t, root, "BAT0", map[string]string{
+		"type":     "Battery",
+		"capacity": "80",
+		"status":   "Charging",
+	})
+	writePowerSupply(t, root, "AC", map[string]string{
+		"type":   "Mains",
+		"online": "1",
+	})
+
+	state, err := readSysfsPowerSupply(root)
+
+	require.NoError(t, err)
+	assert.Equal(t, 80.0, state.batteryPercent)
+	assert.True(t, state.charging)
+	assert.True(t, state.onACPower)
+}
+
+func TestReadSysfsPowerSupply_IgnoresDeviceBatteries(t *testing.T) {
+	root := t.TempDir()
+	writePowerSupply(t, root, "hidpp_battery_0", map[string]string{
+		"type":     "Battery",
+		"scope":    "Device",
+		"capacity": "10",
+	})
+
+	_, err := readSysfsPowerSupply(root)
+
+	assert.ErrorIs(t, err, errNoBattery)
+}
@@ -0,0 +1,7 @@
+//go:build !linux && !darwin
+
+package monitor
+
+func readPowerSupply() (powerSupply, error) {
+	return powerSupply{}, errNoBattery
+}
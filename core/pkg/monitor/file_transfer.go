@@ -0,0 +1,115 @@
+package monitor
+
+import (
+	"sync"
+	"time"
+
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// FileTransfer reports the throughput and backlog of file uploads and
+// downloads.
+//
+// Nothing is reported while no transfers are running, so runs that don't
+// transfer files don't get empty charts.
+type FileTransfer struct {
+	name    string
+	metrics map[string][]float64
+	mutex   sync.RWMutex
+
+	// transferMetrics is updated by the file transfer manager
+	transferMetrics *filetransfer.TransferMetrics
+
+	// last is the previous snapshot and when it was taken
+	last     filetransfer.TransferMetricsSnapshot
+	lastTime time.Time
+}
+
+func NewFileTransfer(transferMetrics *filetransfer.TransferMetrics) *FileTransfer {
+	ft := &FileTransfer{
+		name:            "file_transfer",
+		metrics:         map[string][]float64{},
+		transferMetrics: transferMetrics,
+		lastTime:        time.Now(),
+	}
+	if transferMetrics != nil {
+		ft.last = transferMetrics.Snapshot()
+	}
+	return ft
+}
+
+func (ft *FileTransfer) Name() string { return ft.name }
+
+func (ft *FileTransfer) SampleMetrics() error {
+	ft.mutex.Lock()
+	defer ft.mutex.Unlock()
+
+	now := time.Now()
+	snapshot := ft.transferMetrics.Snapshot()
+	last, lastTime := ft.last, ft.lastTime
+	ft.last, ft.lastTime = snapshot, now
+
+	if snapshot == last && snapshot.Queued == 0 && snapshot.InFlight == 0 {
+		return nil
+	}
+
+	seconds := now.Sub(lastTime).Seconds()
+	if seconds > 0 {
+		ft.metrics["file_transfer.upload_bytes_per_sec"] = append(
+			ft.metrics["file_transfer.upload_bytes_per_sec"],
+			float64(snapshot.UploadedBytes-last.UploadedBytes)/seconds,
+		)
+		ft.metrics["file_transfer.download_bytes_per_sec"] = append(
+			ft.metrics["file_transfer.download_bytes_per_sec"],
+			float64(snapshot.DownloadedBytes-last.DownloadedBytes)/seconds,
+		)
+	}
+	ft.metrics["file_transfer.in_flight"] = append(
+		ft.metrics["file_transfer.in_flight"],
+		float64(snapshot.InFlight),
+	)
+	ft.metrics["file_transfer.queued"] = append(
+		ft.metrics["file_transfer.queued"],
+		float64(snapshot.Queued),
+	)
+	ft.metrics["file_transfer.failed"] = append(
+		ft.metrics["file_transfer.failed"],
+		float64(snapshot.Failed),
+	)
+
+	return nil
+}
+
+func (ft *FileTransfer) AggregateMetrics() map[string]float64 {
+	ft.mutex.Lock()
+	defer ft.mutex.Unlock()
+
+	aggregates := make(map[string]float64)
+	for metric, samples := range ft.metrics {
+		if len(samples) == 0 {
+			continue
+		}
+		switch metric {
+		case "file_transfer.upload_bytes_per_sec",
+			"file_transfer.download_bytes_per_sec":
+			aggregates[metric] = Average(samples)
+		default:
+			aggregates[metric] = samples[len(samples)-1]
+		}
+	}
+	return aggregates
+}
+
+func (ft *FileTransfer) ClearMetrics() {
+	ft.mutex.Lock()
+	defer ft.mutex.Unlock()
+
+	ft.metrics = map[string][]float64{}
+}
+
+func (ft *FileTransfer) IsAvailable() bool { return ft.transferMetrics != nil }
+
+func (ft *FileTransfer) Probe() *service.MetadataRequest {
+	return nil
+}
@@ -0,0 +1,59 @@
+package monitor_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/pkg/monitor"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+func TestFileTransfer_Idle(t *testing.T) {
+	ft := monitor.NewFileTransfer(filetransfer.NewTransferMetrics())
+
+	assert.NoError(t, ft.SampleMetrics())
+
+	assert.Empty(t, ft.AggregateMetrics())
+}
+
+func TestFileTransfer_ReportsTransfers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("contents"))
+		}))
+	defer server.Close()
+	logger := observability.NewNoOpLogger()
+	stats := filetransfer.NewFileTransferStats()
+	metrics := filetransfer.NewTransferMetrics()
+	fm := filetransfer.NewFileTransferManager(
+		filetransfer.WithLogger(logger),
+		filetransfer.WithFileTransferStats(stats),
+		filetransfer.WithTransferMetrics(metrics),
+		filetransfer.WithFileTransfers(&filetransfer.FileTransfers{
+			Default: filetransfer.NewDefaultFileTransfer(
+				retryablehttp.NewClient(), logger, stats),
+		}),
+	)
+	ft := monitor.NewFileTransfer(metrics)
+
+	task := &filetransfer.Task{
+		Type: filetransfer.DownloadTask,
+		Path: filepath.Join(t.TempDir(), "file.txt"),
+		Url:  server.URL,
+	}
+	task.SetCompletionCallback(func(*filetransfer.Task) {})
+	fm.AddTask(task)
+	fm.Close()
+	assert.NoError(t, ft.SampleMetrics())
+
+	aggregates := ft.AggregateMetrics()
+	assert.Greater(t, aggregates["file_transfer.download_bytes_per_sec"], 0.0)
+	assert.Equal(t, 0.0, aggregates["file_transfer.upload_bytes_per_sec"])
+	assert.Equal(t, 0.0, aggregates["file_transfer.in_flight"])
+	assert.Equal(t, 0.0, aggregates["file_transfer.failed"])
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -13,6 +14,7 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
+	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/runwork"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -22,6 +24,9 @@ import (
 const (
 	defaultSamplingInterval = 2.0 * time.Second
 	defaultSamplesToAverage = 15
+	defaultWarmupInterval   = 100 * time.Millisecond
+	defaultBurstInterval    = 100 * time.Millisecond
+	defaultBurstDuration    = 30 * time.Second
 )
 
 func Average(nums []float64) float64 {
@@ -81,6 +86,15 @@ type Asset interface {
 	Probe() *service.MetadataRequest
 }
 
+// samplingBurst is a temporary high-frequency sampling mode for an asset.
+type samplingBurst struct {
+	// The interval at which metrics are sampled during the burst
+	interval time.Duration
+
+	// How long the burst lasts
+	duration time.Duration
+}
+
 type SystemMonitor struct {
 	// The context for the system monitor
 	ctx    context.Context
@@ -92,6 +106,9 @@ type SystemMonitor struct {
 	// The list of assets to monitor
 	assets []Asset
 
+	// Pending sampling bursts for each monitored asset
+	bursts map[Asset]chan samplingBurst
+
 	// extraWork accepts outgoing messages for the run
 	extraWork runwork.ExtraWork
 
@@ -107,15 +124,30 @@ type SystemMonitor struct {
 	// The number of samples to average before sending the metrics
 	samplesToAverage int
 
+	// The delay before the first sample is taken
+	samplingDelay time.Duration
+
+	// The duration of the high-frequency warm-up burst
+	//
+	// During the warm-up, every sample is published without averaging.
+	warmupDuration time.Duration
+
+	// The interval at which metrics are sampled during the warm-up
+	warmupInterval time.Duration
+
 	// A logger for internal debug logging.
 	logger *observability.CoreLogger
 }
 
 // NewSystemMonitor creates a new SystemMonitor with the given settings
+//
+// If transferMetrics is not nil, file transfer throughput is reported
+// along with the system's metrics.
 func NewSystemMonitor(
 	logger *observability.CoreLogger,
 	settings *service.Settings,
 	extraWork runwork.ExtraWork,
+	transferMetrics *filetransfer.TransferMetrics,
 ) *SystemMonitor {
 	sbs := settings.XStatsBufferSize.GetValue()
 	var buffer *Buffer
@@ -134,6 +166,7 @@ func NewSystemMonitor(
 		buffer:           buffer,
 		samplingInterval: defaultSamplingInterval,
 		samplesToAverage: defaultSamplesToAverage,
+		warmupInterval:   defaultWarmupInterval,
 	}
 
 	// TODO: rename the setting...should be SamplingIntervalSeconds
@@ -143,12 +176,24 @@ func NewSystemMonitor(
 	if sta := settings.XStatsSamplesToAverage; sta != nil {
 		systemMonitor.samplesToAverage = int(sta.GetValue())
 	}
+	if sd := settings.XStatsSamplingDelaySeconds; sd != nil {
+		systemMonitor.samplingDelay = time.Duration(sd.GetValue() * float64(time.Second))
+	}
+	if wd := settings.XStatsWarmupDurationSeconds; wd != nil {
+		systemMonitor.warmupDuration = time.Duration(wd.GetValue() * float64(time.Second))
+	}
+	if wi := settings.XStatsWarmupSampleRateSeconds; wi != nil && wi.GetValue() > 0 {
+		systemMonitor.warmupInterval = time.Duration(wi.GetValue() * float64(time.Second))
+	}
 
 	systemMonitor.logger.Debug(
 		fmt.Sprintf(
-			"samplingInterval: %v, samplesToAverage: %v",
+			"samplingInterval: %v, samplesToAverage: %v, samplingDelay: %v, warmupDuration: %v, warmupInterval: %v",
 			systemMonitor.samplingInterval,
 			systemMonitor.samplesToAverage,
+			systemMonitor.samplingDelay,
+			systemMonitor.warmupDuration,
+			systemMonitor.warmupInterval,
 		),
 	)
 
@@ -166,12 +211,19 @@ func NewSystemMonitor(
 		NewDisk(diskPaths),
 		NewMemory(pid),
 		NewNetwork(),
+		NewBattery(),
 		// NOTE: we pass the logger for more detailed error reporting
 		// during the initial rollout of the GPU monitoring with nvidia_gpu_stats
 		// TODO: remove the logger once we are confident that it is stable
 		NewGPUNvidia(logger, pid, samplingInterval),
 		NewGPUAMD(),
 		NewGPUApple(),
+		NewFileTransfer(transferMetrics),
+	}
+
+	systemMonitor.bursts = make(map[Asset]chan samplingBurst, len(systemMonitor.assets))
+	for _, asset := range systemMonitor.assets {
+		systemMonitor.bursts[asset] = make(chan samplingBurst, 1)
 	}
 
 	return systemMonitor
@@ -253,6 +305,22 @@ func (sm *SystemMonitor) Monitor(asset Asset) {
 		}
 	}()
 
+	// Wait before taking the first sample, if requested
+	if sm.samplingDelay > 0 {
+		delay := time.NewTimer(sm.samplingDelay)
+		select {
+		case <-sm.ctx.Done():
+			delay.Stop()
+			return
+		case <-delay.C:
+		}
+	}
+
+	// Sample at a high frequency for a short while, publishing every sample
+	if sm.warmupDuration > 0 && !sm.warmUp(asset) {
+		return
+	}
+
 	// Create a ticker that fires every `samplingInterval` seconds
 	ticker := time.NewTicker(sm.samplingInterval)
 	defer ticker.Stop()
@@ -264,39 +332,156 @@ func (sm *SystemMonitor) Monitor(asset Asset) {
 		case <-sm.ctx.Done():
 			return
 		case <-ticker.C:
-			// NOTE: the pattern in SampleMetric is to capture whatever metrics are available,
-			// accumulate errors along the way, and log them here.
-			err := asset.SampleMetrics()
-			if err != nil {
-				sm.logger.CaptureError(
-					fmt.Errorf("monitor: %v: error sampling metrics: %v", asset.Name(), err),
-				)
+			sm.sample(asset)
+			sometimes.Do(func() { sm.publish(asset) })
+		case burst := <-sm.bursts[asset]:
+			if !sm.burst(asset, burst) {
+				return
 			}
+		}
+	}
+
+}
+
+// Burst temporarily samples the given assets at a high frequency.
+//
+// Every sample taken during the burst is stored in the metrics buffer
+// at full resolution instead of being averaged. If no asset names are
+// given, all assets are sampled.
+func (sm *SystemMonitor) Burst(
+	interval time.Duration,
+	duration time.Duration,
+	assetNames []string,
+) {
+	if sm == nil {
+		return
+	}
+	if sm.buffer == nil {
+		sm.logger.Warn("monitor: ignoring sampling burst, stats buffer is disabled")
+		return
+	}
+
+	if interval <= 0 {
+		interval = defaultBurstInterval
+	}
+	if duration <= 0 {
+		duration = defaultBurstDuration
+	}
+
+	for _, asset := range sm.assets {
+		if len(assetNames) > 0 && !slices.Contains(assetNames, asset.Name()) {
+			continue
+		}
 
-			sometimes.Do(func() {
-				aggregatedMetrics := asset.AggregateMetrics()
-				asset.ClearMetrics()
-
-				if len(aggregatedMetrics) == 0 {
-					return // nothing to do
-				}
-				ts := timestamppb.Now()
-				// Also store aggregated metrics in the buffer if we have one
-				if sm.buffer != nil {
-					for k, v := range aggregatedMetrics {
-						sm.buffer.push(k, ts, v)
-					}
-				}
-
-				// publish metrics
-				sm.extraWork.AddRecordOrCancel(
-					sm.ctx.Done(),
-					makeStatsRecord(aggregatedMetrics, ts),
-				)
-			})
+		select {
+		case sm.bursts[asset] <- samplingBurst{interval: interval, duration: duration}:
+		default:
+			sm.logger.Warn(
+				"monitor: sampling burst already pending",
+				"asset_name", asset.Name(),
+			)
+		}
+	}
+}
+
+// burst samples the asset every `burst.interval` until `burst.duration`
+// elapses, storing each sample in the buffer.
+//
+// Returns false if the monitor was stopped during the burst.
+func (sm *SystemMonitor) burst(asset Asset, burst samplingBurst) bool {
+	// Flush the samples collected so far so that they are not mixed
+	// with the full-resolution burst samples.
+	sm.publish(asset)
+
+	ticker := time.NewTicker(burst.interval)
+	defer ticker.Stop()
+
+	deadline := time.NewTimer(burst.duration)
+	defer deadline.Stop()
+
+	for {
+		select {
+		case <-sm.ctx.Done():
+			return false
+		case <-deadline.C:
+			return true
+		case <-ticker.C:
+			sm.sample(asset)
+
+			metrics := asset.AggregateMetrics()
+			asset.ClearMetrics()
+			sm.pushToBuffer(metrics, timestamppb.Now())
+		}
+	}
+}
+
+// warmUp samples and publishes the asset's metrics every `warmupInterval`
+// until `warmupDuration` elapses.
+//
+// Returns false if the monitor was stopped during the warm-up.
+func (sm *SystemMonitor) warmUp(asset Asset) bool {
+	ticker := time.NewTicker(sm.warmupInterval)
+	defer ticker.Stop()
+
+	deadline := time.NewTimer(sm.warmupDuration)
+	defer deadline.Stop()
+
+	for {
+		select {
+		case <-sm.ctx.Done():
+			return false
+		case <-deadline.C:
+			return true
+		case <-ticker.C:
+			sm.sample(asset)
+			sm.publish(asset)
 		}
 	}
+}
 
+// sample collects a single sample of the asset's metrics.
+func (sm *SystemMonitor) sample(asset Asset) {
+	// NOTE: the pattern in SampleMetric is to capture whatever metrics are available,
+	// accumulate errors along the way, and log them here.
+	err := asset.SampleMetrics()
+	if err != nil {
+		sm.logger.CaptureError(
+			fmt.Errorf("monitor: %v: error sampling metrics: %v", asset.Name(), err),
+		)
+	}
+}
+
+// publish aggregates the asset's accumulated samples and sends them as
+// a stats record.
+func (sm *SystemMonitor) publish(asset Asset) {
+	aggregatedMetrics := asset.AggregateMetrics()
+	asset.ClearMetrics()
+
+	if len(aggregatedMetrics) == 0 {
+		return // nothing to do
+	}
+	ts := timestamppb.Now()
+	// Also store aggregated metrics in the buffer if we have one
+	sm.pushToBuffer(aggregatedMetrics, ts)
+
+	// publish metrics
+	sm.extraWork.AddRecordOrCancel(
+		sm.ctx.Done(),
+		makeStatsRecord(aggregatedMetrics, ts),
+	)
+}
+
+// pushToBuffer stores the metrics in the buffer, if we have one.
+func (sm *SystemMonitor) pushToBuffer(
+	metrics map[string]float64,
+	ts *timestamppb.Timestamp,
+) {
+	if sm.buffer == nil {
+		return
+	}
+	for k, v := range metrics {
+		sm.buffer.push(k, ts, v)
+	}
 }
 
 func (sm *SystemMonitor) GetBuffer() map[string]List {
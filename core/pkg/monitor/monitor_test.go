@@ -0,0 +1,273 @@
+package monitor
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/internal/runworktest"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// fakeAsset is an Asset that counts how many times it was sampled.
+type fakeAsset struct {
+	name string
+
+	mu      sync.Mutex
+	samples int
+	pending int
+}
+
+func (a *fakeAsset) Name() string { return a.name }
+
+func (a *fakeAsset) SampleMetrics() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.samples++
+	a.pending++
+	return nil
+}
+
+func (a *fakeAsset) AggregateMetrics() map[string]float64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.pending == 0 {
+		return nil
+	}
+	return map[string]float64{a.name: float64(a.pending)}
+}
+
+func (a *fakeAsset) ClearMetrics() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.pending = 0
+}
+
+func (a *fakeAsset) IsAvailable() bool { return true }
+
+func (a *fakeAsset) Probe() *service.MetadataRequest { return nil }
+
+func (a *fakeAsset) Samples() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.samples
+}
+
+// newTestMonitor returns a system monitor that only monitors the given
+// assets.
+func newTestMonitor(
+	t *testing.T,
+	settings *service.Settings,
+	assets ...Asset,
+) (*SystemMonitor, *runworktest.FakeRunWork) {
+	t.Helper()
+
+	settings.XDisableStats = wrapperspb.Bool(true)
+	extraWork := runworktest.New()
+	sm := NewSystemMonitor(
+		observability.NewNoOpLogger(),
+		settings,
+		extraWork,
+		nil,
+	)
+
+	sm.assets = assets
+	sm.bursts = make(map[Asset]chan samplingBurst, len(assets))
+	for _, asset := range assets {
+		sm.bursts[asset] = make(chan samplingBurst, 1)
+	}
+
+	return sm, extraWork
+}
+
+// bufferedValues returns the values in the metrics buffer for the key.
+func bufferedValues(sm *SystemMonitor, key string) []float64 {
+	sm.buffer.mutex.Lock()
+	defer sm.buffer.mutex.Unlock()
+
+	list := sm.buffer.elements[key]
+	var values []float64
+	for _, m := range list.GetElements() {
+		values = append(values, m.Value)
+	}
+	return values
+}
+
+// statsRecords returns the stats records that were published.
+func statsRecords(extraWork *runworktest.FakeRunWork) []*service.StatsRecord {
+	var stats []*service.StatsRecord
+	for _, record := range extraWork.AllRecords() {
+		if s := record.GetStats(); s != nil {
+			stats = append(stats, s)
+		}
+	}
+	return stats
+}
+
+func TestNewSystemMonitor_ReadsSamplingSettings(t *testing.T) {
+	testCases := []struct {
+		name     string
+		settings *service.Settings
+		delay    time.Duration
+		warmup   time.Duration
+		interval time.Duration
+	}{
+		{
+			name:     "defaults",
+			settings: &service.Settings{},
+			interval: defaultWarmupInterval,
+		},
+		{
+			name: "delay and warm-up",
+			settings: &service.Settings{
+				XStatsSamplingDelaySeconds:    wrapperspb.Double(1.5),
+				XStatsWarmupDurationSeconds:   wrapperspb.Double(10),
+				XStatsWarmupSampleRateSeconds: wrapperspb.Double(0.25),
+			},
+			delay:    1500 * time.Millisecond,
+			warmup:   10 * time.Second,
+			interval: 250 * time.Millisecond,
+		},
+		{
+			name: "non-positive warm-up interval",
+			settings: &service.Settings{
+				XStatsWarmupDurationSeconds:   wrapperspb.Double(10),
+				XStatsWarmupSampleRateSeconds: wrapperspb.Double(0),
+			},
+			warmup:   10 * time.Second,
+			interval: defaultWarmupInterval,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sm, _ := newTestMonitor(t, tc.settings)
+
+			assert.Equal(t, tc.delay, sm.samplingDelay)
+			assert.Equal(t, tc.warmup, sm.warmupDuration)
+			assert.Equal(t, tc.interval, sm.warmupInterval)
+		})
+	}
+}
+
+func TestMonitor_SamplingDelayPostponesFirstSample(t *testing.T) {
+	asset := &fakeAsset{name: "fake"}
+	sm, _ := newTestMonitor(t,
+		&service.Settings{
+			XStatsSampleRateSeconds:    wrapperspb.Double(0.01),
+			XStatsSamplingDelaySeconds: wrapperspb.Double(60),
+		},
+		asset,
+	)
+
+	sm.Do()
+	time.Sleep(100 * time.Millisecond)
+	sm.Stop()
+
+	assert.Zero(t, asset.Samples())
+}
+
+func TestMonitor_WarmUpPublishesEverySample(t *testing.T) {
+	asset := &fakeAsset{name: "fake"}
+	sm, extraWork := newTestMonitor(t,
+		&service.Settings{
+			// Without the warm-up, no stats record would be published.
+			XStatsSamplesToAverage:        wrapperspb.Int32(1000),
+			XStatsWarmupDurationSeconds:   wrapperspb.Double(60),
+			XStatsWarmupSampleRateSeconds: wrapperspb.Double(0.01),
+		},
+		asset,
+	)
+
+	sm.Do()
+	require.Eventually(t,
+		func() bool { return len(statsRecords(extraWork)) >= 3 },
+		5*time.Second,
+		10*time.Millisecond,
+	)
+	sm.Stop()
+
+	for _, stats := range statsRecords(extraWork) {
+		require.Len(t, stats.Item, 1)
+		assert.Equal(t, "fake", stats.Item[0].Key)
+		assert.Equal(t, "1", stats.Item[0].ValueJson)
+	}
+}
+
+func TestBurst_QueuesBurstForNamedAssets(t *testing.T) {
+	cpu := &fakeAsset{name: "cpu"}
+	gpu := &fakeAsset{name: "gpu"}
+	sm, _ := newTestMonitor(t,
+		&service.Settings{XStatsBufferSize: wrapperspb.Int32(-1)},
+		cpu, gpu,
+	)
+
+	sm.Burst(0, 0, []string{"gpu"})
+
+	assert.Empty(t, sm.bursts[cpu])
+	require.Len(t, sm.bursts[gpu], 1)
+	assert.Equal(t,
+		samplingBurst{
+			interval: defaultBurstInterval,
+			duration: defaultBurstDuration,
+		},
+		<-sm.bursts[gpu])
+}
+
+func TestBurst_AllAssetsByDefault(t *testing.T) {
+	cpu := &fakeAsset{name: "cpu"}
+	gpu := &fakeAsset{name: "gpu"}
+	sm, _ := newTestMonitor(t,
+		&service.Settings{XStatsBufferSize: wrapperspb.Int32(-1)},
+		cpu, gpu,
+	)
+
+	sm.Burst(time.Second, time.Minute, nil)
+	sm.Burst(time.Second, time.Minute, nil) // dropped, already pending
+
+	for _, asset := range []Asset{cpu, gpu} {
+		require.Len(t, sm.bursts[asset], 1)
+		assert.Equal(t,
+			samplingBurst{interval: time.Second, duration: time.Minute},
+			<-sm.bursts[asset])
+	}
+}
+
+func TestBurst_IgnoredWithoutBuffer(t *testing.T) {
+	asset := &fakeAsset{name: "fake"}
+	sm, _ := newTestMonitor(t, &service.Settings{}, asset)
+
+	sm.Burst(time.Second, time.Minute, nil)
+
+	assert.Empty(t, sm.bursts[asset])
+}
+
+func TestBurst_BuffersEverySample(t *testing.T) {
+	asset := &fakeAsset{name: "fake"}
+	sm, extraWork := newTestMonitor(t,
+		&service.Settings{
+			XStatsBufferSize:        wrapperspb.Int32(-1),
+			XStatsSampleRateSeconds: wrapperspb.Double(60),
+		},
+		asset,
+	)
+
+	sm.Do()
+	sm.Burst(10*time.Millisecond, time.Minute, nil)
+	require.Eventually(t,
+		func() bool { return len(bufferedValues(sm, "fake")) >= 3 },
+		5*time.Second,
+		10*time.Millisecond,
+	)
+	sm.Stop()
+
+	for _, value := range bufferedValues(sm, "fake") {
+		assert.Equal(t, 1.0, value)
+	}
+	assert.Empty(t, statsRecords(extraWork))
+}
@@ -0,0 +1,171 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/wandb/simplejsonext"
+
+	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/internal/runconfig"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// artifactRefRun is the run that config artifact references are resolved
+// for.
+type artifactRefRun struct {
+	entity  string
+	project string
+	runID   string
+}
+
+// resolveConfigArtifacts starts resolving new artifact references in the
+// run config, like {"$artifact": "entity/project/name:alias"}.
+//
+// Each reference is resolved once, off the sender goroutine. The artifact
+// version it refers to is marked as used by the run, and a config update
+// replacing the reference by the version's path is sent through the
+// transaction log. References that fail to resolve are reported and left
+// as they are.
+//
+// When syncing, the updates are already in the transaction log.
+func (s *Sender) resolveConfigArtifacts() {
+	if s.graphqlClient == nil ||
+		!s.startState.Intialized ||
+		s.settings.GetXSync().GetValue() {
+		return
+	}
+
+	run := artifactRefRun{
+		entity:  s.startState.Entity,
+		project: s.startState.Project,
+		runID:   s.startState.RunID,
+	}
+
+	for _, ref := range s.runConfig.ArtifactRefs() {
+		key := strings.Join(ref.Path.Labels(), "\x00") + "\x00" + ref.Name
+		if _, isStarted := s.startedArtifactRefs[key]; isStarted {
+			continue
+		}
+		s.startedArtifactRefs[key] = struct{}{}
+
+		go s.resolveArtifactRef(run, ref)
+	}
+}
+
+// resolveArtifactRef resolves a config artifact reference and sends the
+// resulting config update.
+func (s *Sender) resolveArtifactRef(
+	run artifactRefRun,
+	ref runconfig.ArtifactRef,
+) {
+	resolved, err := s.lookUpArtifactRef(run, ref.Name)
+	if err != nil {
+		s.logger.Warn(
+			"sender: failed to resolve config artifact",
+			"name", ref.Name,
+			"error", err,
+		)
+		if s.terminalPrinter != nil {
+			s.terminalPrinter.Writef(
+				"Could not resolve the artifact %q in the config: %v",
+				ref.Name, err)
+		}
+		return
+	}
+
+	valueJSON, err := simplejsonext.Marshal(resolved)
+	if err != nil {
+		s.logger.CaptureError(
+			fmt.Errorf("sender: failed to encode resolved artifact: %v", err))
+		return
+	}
+
+	s.runWork.AddRecordOrCancel(
+		s.runWork.BeforeEndCtx().Done(),
+		&service.Record{
+			RecordType: &service.Record_Config{
+				Config: &service.ConfigRecord{
+					Update: []*service.ConfigItem{{
+						NestedKey: ref.Path.Labels(),
+						ValueJson: string(valueJSON),
+					}},
+				},
+			},
+		},
+	)
+}
+
+// lookUpArtifactRef finds the artifact version that a reference's name
+// refers to, records it as an input of the run, and returns its path,
+// like "entity/project/name:v3".
+//
+// The entity and project default to the run's.
+func (s *Sender) lookUpArtifactRef(
+	run artifactRefRun,
+	refName string,
+) (string, error) {
+	entity, project, name, alias := runconfig.ParseArtifactRefName(refName)
+	if entity == "" {
+		entity = run.entity
+	}
+	if project == "" {
+		project = run.project
+	}
+
+	ctx := s.runWork.BeforeEndCtx()
+	artifact, err := s.findArtifact(ctx, entity, project, name, alias)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = gql.UseArtifact(
+		ctx,
+		s.graphqlClient,
+		run.entity,
+		run.project,
+		run.runID,
+		artifact.Id,
+	)
+	if err != nil {
+		return "", fmt.Errorf("gql.UseArtifact: %v", err)
+	}
+
+	version := alias
+	if artifact.VersionIndex != nil {
+		version = fmt.Sprintf("v%d", *artifact.VersionIndex)
+	}
+
+	return fmt.Sprintf(
+		"%s/%s/%s:%s",
+		entity,
+		project,
+		artifact.ArtifactSequence.Name,
+		version,
+	), nil
+}
+
+// findArtifact looks up an artifact version by its name and alias.
+func (s *Sender) findArtifact(
+	ctx context.Context,
+	entity, project, name, alias string,
+) (*gql.ArtifactByNameProjectArtifact, error) {
+	data, err := gql.ArtifactByName(
+		ctx,
+		s.graphqlClient,
+		entity,
+		project,
+		fmt.Sprintf("%s:%s", name, alias),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("gql.ArtifactByName: %v", err)
+	}
+
+	if data.GetProject() == nil || data.GetProject().GetArtifact() == nil {
+		return nil, errors.New("artifact not found")
+	}
+
+	return data.GetProject().GetArtifact(), nil
+}
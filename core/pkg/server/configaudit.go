@@ -0,0 +1,105 @@
+package server
+
+import (
+	"github.com/wandb/simplejsonext"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/internal/runconfig"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// maxConfigHistory is how many config changes to keep for
+// ConfigHistoryRequest.
+const maxConfigHistory = 1000
+
+// configAudit records the changes made to the run config.
+//
+// Its methods do nothing on a nil receiver, which is used when auditing
+// is disabled.
+type configAudit struct {
+	// source describes what is currently changing the config, such as
+	// "config" for a ConfigRecord.
+	source string
+
+	// recordNum is the number of the record currently changing the
+	// config, or 0 if there is none.
+	recordNum int64
+
+	// pending are changes not yet written to the transaction log.
+	pending []*service.ConfigChange
+
+	// history are the most recent changes, oldest first.
+	history []*service.ConfigChange
+
+	// dropped is the number of changes removed from history.
+	dropped int64
+}
+
+// SetSource sets what the following changes are attributed to.
+func (a *configAudit) SetSource(source string, recordNum int64) {
+	if a == nil {
+		return
+	}
+
+	a.source = source
+	a.recordNum = recordNum
+}
+
+// Record adds a change to the audit trail.
+func (a *configAudit) Record(change runconfig.ValueChange) {
+	if a == nil {
+		return
+	}
+
+	entry := &service.ConfigChange{
+		NestedKey: change.Path.Labels(),
+		Timestamp: timestamppb.Now(),
+		Source:    a.source,
+		RecordNum: a.recordNum,
+	}
+	if change.Kind != runconfig.ChangeAdded {
+		entry.OldValueJson = configChangeJSON(change.OldValue)
+	}
+	if change.Kind != runconfig.ChangeRemoved {
+		entry.NewValueJson = configChangeJSON(change.NewValue)
+	}
+
+	a.pending = append(a.pending, entry)
+	a.history = append(a.history, entry)
+	if len(a.history) > maxConfigHistory {
+		excess := len(a.history) - maxConfigHistory
+		a.history = a.history[excess:]
+		a.dropped += int64(excess)
+	}
+}
+
+// TakePending returns and clears the changes not yet written to the
+// transaction log.
+func (a *configAudit) TakePending() []*service.ConfigChange {
+	if a == nil {
+		return nil
+	}
+
+	pending := a.pending
+	a.pending = nil
+	return pending
+}
+
+// History returns the most recent changes and the number of older ones
+// that were not kept.
+func (a *configAudit) History() ([]*service.ConfigChange, int64) {
+	if a == nil {
+		return nil, 0
+	}
+
+	return a.history, a.dropped
+}
+
+// configChangeJSON encodes a value for the audit trail.
+func configChangeJSON(value any) string {
+	encoded, err := simplejsonext.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
@@ -16,10 +16,12 @@ import (
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/mailbox"
 	"github.com/wandb/wandb/core/internal/pathtree"
+	"github.com/wandb/wandb/core/internal/runconfig"
 	"github.com/wandb/wandb/core/internal/runfiles"
 	"github.com/wandb/wandb/core/internal/runhistory"
 	"github.com/wandb/wandb/core/internal/runmetric"
 	"github.com/wandb/wandb/core/internal/runsummary"
+	"github.com/wandb/wandb/core/internal/settings"
 	"github.com/wandb/wandb/core/internal/tensorboard"
 	"github.com/wandb/wandb/core/internal/timer"
 	"github.com/wandb/wandb/core/internal/version"
@@ -135,6 +137,9 @@ type Handler struct {
 	terminalPrinter *observability.Printer
 
 	mailbox *mailbox.Mailbox
+
+	// isRunSeen is whether a run record was handled
+	isRunSeen bool
 }
 
 // NewHandler creates a new handler
@@ -142,6 +147,12 @@ func NewHandler(
 	commit string,
 	params HandlerParams,
 ) *Handler {
+	// The sender reports an invalid policy; both use the default for it.
+	metricHandler := runmetric.New()
+	policy, _ := runmetric.ParseRedefinePolicy(
+		settings.From(params.Settings).GetMetricRedefinePolicy())
+	metricHandler.SetRedefinePolicy(policy)
+
 	return &Handler{
 		commit:                commit,
 		runTimer:              timer.New(),
@@ -155,7 +166,7 @@ func NewHandler(
 		runSummary:            runsummary.New(),
 		skipSummary:           params.SkipSummary,
 		runHistorySampler:     runhistory.NewRunHistorySampler(),
-		metricHandler:         runmetric.New(),
+		metricHandler:         metricHandler,
 		fileTransferStats:     params.FileTransferStats,
 		runfilesUploaderOrNil: params.RunfilesUploader,
 		tbHandler:             params.TBHandler,
@@ -259,6 +270,8 @@ func (h *Handler) handleRecord(record *service.Record) {
 		h.handleTelemetry(record)
 	case *service.Record_UseArtifact:
 		h.handleUseArtifact(record)
+	case *service.Record_ConfigChange:
+		h.handleConfigChange(record)
 	case nil:
 		h.logger.CaptureFatalAndPanic(
 			errors.New("handler: handleRecord: record type is nil"))
@@ -304,6 +317,14 @@ func (h *Handler) handleRequest(record *service.Record) {
 		h.handleRequestGetSummary(record)
 	case *service.Request_NetworkStatus:
 		h.handleRequestNetworkStatus(record)
+	case *service.Request_FileStreamMetrics:
+		h.handleRequestFileStreamMetrics(record)
+	case *service.Request_ConfigHistory:
+		h.handleRequestConfigHistory(record)
+	case *service.Request_FileStreamTransmitInterval:
+		h.handleRequestFileStreamTransmitInterval(record)
+	case *service.Request_StepAck:
+		h.handleRequestStepAck(record, x.StepAck)
 	case *service.Request_PartialHistory:
 		h.handleRequestPartialHistory(record, x.PartialHistory)
 	case *service.Request_PollExit:
@@ -334,6 +355,8 @@ func (h *Handler) handleRequest(record *service.Record) {
 		h.handleRequestCancel(x.Cancel)
 	case *service.Request_GetSystemMetrics:
 		h.handleRequestGetSystemMetrics(record)
+	case *service.Request_SystemMetricsBurst:
+		h.handleRequestSystemMetricsBurst(x.SystemMetricsBurst)
 	case *service.Request_InternalMessages:
 		h.handleRequestInternalMessages(record)
 	case *service.Request_Sync:
@@ -840,6 +863,11 @@ func (h *Handler) handlePreempting(record *service.Record) {
 }
 
 func (h *Handler) handleRun(record *service.Record) {
+	if !h.isRunSeen {
+		h.isRunSeen = true
+		h.interpolateConfigEnv(record.GetRun())
+	}
+
 	h.fwdRecordWithControl(record,
 		func(control *service.Control) {
 			control.AlwaysSend = true
@@ -847,6 +875,40 @@ func (h *Handler) handleRun(record *service.Record) {
 	)
 }
 
+// interpolateConfigEnv resolves environment variable references in the
+// initial config, if enabled.
+//
+// This happens before the record is written to the transaction log, so
+// that `wandb sync` uploads the values from the user's environment
+// rather than from the environment of the machine that syncs.
+func (h *Handler) interpolateConfigEnv(run *service.RunRecord) {
+	if run == nil {
+		return
+	}
+
+	env := run.ConfigEnv
+	run.ConfigEnv = nil
+
+	if !h.settings.GetXConfigInterpolateEnv().GetValue() ||
+		h.settings.GetXSync().GetValue() {
+		return
+	}
+
+	err := runconfig.InterpolateEnv(run.Config,
+		func(name string) (string, bool) {
+			value, ok := env[name]
+			return value, ok
+		})
+	if err != nil {
+		h.logger.Warn("handler: failed to interpolate config", "error", err)
+		if h.terminalPrinter != nil {
+			h.terminalPrinter.Writef(
+				"Some config values reference unset environment variables: %v",
+				err)
+		}
+	}
+}
+
 func (h *Handler) handleConfig(record *service.Record) {
 	h.fwdRecord(record)
 }
@@ -933,6 +995,16 @@ func (h *Handler) handleRequestGetSystemMetrics(record *service.Record) {
 	h.respond(record, response)
 }
 
+func (h *Handler) handleRequestSystemMetricsBurst(
+	request *service.SystemMetricsBurstRequest,
+) {
+	h.systemMonitor.Burst(
+		time.Duration(request.GetSampleRateSeconds()*float64(time.Second)),
+		time.Duration(request.GetDurationSeconds()*float64(time.Second)),
+		request.GetAssets(),
+	)
+}
+
 func (h *Handler) handleRequestInternalMessages(record *service.Record) {
 	messages := h.terminalPrinter.Read()
 	response := &service.Response{
@@ -963,6 +1035,12 @@ func (h *Handler) handleUseArtifact(record *service.Record) {
 	h.fwdRecord(record)
 }
 
+// handleConfigChange forwards the sender's audit trail of config changes
+// so that it is written to the transaction log.
+func (h *Handler) handleConfigChange(record *service.Record) {
+	h.fwdRecord(record)
+}
+
 func (h *Handler) handleRequestJobInput(record *service.Record) {
 	h.fwdRecord(record)
 }
@@ -1037,6 +1115,34 @@ func (h *Handler) handleRequestNetworkStatus(record *service.Record) {
 	h.fwdRecord(record)
 }
 
+func (h *Handler) handleRequestFileStreamMetrics(record *service.Record) {
+	h.fwdRecord(record)
+}
+
+func (h *Handler) handleRequestConfigHistory(record *service.Record) {
+	h.fwdRecord(record)
+}
+
+func (h *Handler) handleRequestFileStreamTransmitInterval(record *service.Record) {
+	h.fwdRecord(record)
+}
+
+// handleRequestStepAck flushes the requested step if it is still being
+// accumulated, so that the sender can wait for it to be uploaded.
+func (h *Handler) handleRequestStepAck(
+	record *service.Record,
+	request *service.StepAckRequest,
+) {
+	switch {
+	case h.settings.GetXShared().GetValue():
+		h.flushPartialHistory(false, 0)
+	case h.partialHistory != nil && request.GetStep() >= h.partialHistoryStep:
+		h.flushPartialHistory(true, h.partialHistoryStep+1)
+	}
+
+	h.fwdRecord(record)
+}
+
 // handleRequestPartialHistory updates the run history, flushing data for
 // completed steps.
 func (h *Handler) handleRequestPartialHistory(
@@ -1182,7 +1288,20 @@ func (h *Handler) flushPartialHistory(useStep bool, nextStep int64) {
 			RecordType: &service.Record_Metric{Metric: newMetric},
 		})
 	}
-	h.metricHandler.InsertStepMetrics(h.partialHistory)
+	h.metricHandler.InsertDerivedMetrics(h.partialHistory)
+	for _, step := range h.metricHandler.InsertStepMetrics(h.partialHistory) {
+		h.logger.Warn(
+			"handler: synthesizing step metric that was never logged",
+			"step_metric", step,
+		)
+		h.terminalPrinter.Writef(
+			"The step metric %q has not been logged, so a counter is used"+
+				" in its place. Log %q to set the x-axis of the metrics"+
+				" that use it.",
+			step,
+			step,
+		)
+	}
 
 	h.runHistorySampler.SampleNext(h.partialHistory)
 
@@ -1191,6 +1310,8 @@ func (h *Handler) flushPartialHistory(useStep bool, nextStep int64) {
 		h.updateSummary()
 	}
 
+	h.metricHandler.DownsampleHistory(h.partialHistory)
+
 	items, err := h.partialHistory.ToRecords()
 	currentStep := h.partialHistoryStep
 
@@ -738,6 +738,36 @@ func TestHandlePartialHistory(t *testing.T) {
 	}
 }
 
+func TestHandleStepAck_FlushesStep(t *testing.T) {
+	inChan := make(chan *service.Record, server.BufferSize)
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+
+	makeHandler(inChan, fwdChan, outChan, "" /*commit*/)
+
+	inChan <- makePartialHistoryRecord(data{
+		items: map[string]string{"key1": "1"},
+		step:  0,
+		flush: false,
+	})
+	inChan <- &service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_StepAck{
+					StepAck: &service.StepAckRequest{Step: 0},
+				},
+			},
+		},
+	}
+
+	history := makeOutput(<-fwdChan)
+	assert.Equal(t, int64(0), history.step)
+	assert.Equal(t, "1", history.items["key1"])
+
+	ack := <-fwdChan
+	assert.NotNil(t, ack.GetRequest().GetStepAck())
+}
+
 func TestHandleHistory(t *testing.T) {
 	testCases := []testCase{
 		{
@@ -8,6 +8,9 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
 
 	"github.com/Khan/genqlient/graphql"
 	"google.golang.org/protobuf/proto"
@@ -20,6 +23,7 @@ import (
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/gql"
 	"github.com/wandb/wandb/core/internal/mailbox"
+	"github.com/wandb/wandb/core/internal/nonfinite"
 	"github.com/wandb/wandb/core/internal/paths"
 	"github.com/wandb/wandb/core/internal/runbranch"
 	"github.com/wandb/wandb/core/internal/runconfig"
@@ -44,6 +48,10 @@ const (
 	configDebouncerBurstSize  = 1        // todo: audit burst size
 	summaryDebouncerRateLimit = 1 / 30.0 // todo: audit rate limit
 	summaryDebouncerBurstSize = 1        // todo: audit burst size
+
+	// Default limits on the size of the run config, in bytes of JSON.
+	defaultConfigMaxBytes      = 10 << 20
+	defaultConfigMaxValueBytes = 1 << 20
 )
 
 type SenderParams struct {
@@ -61,6 +69,7 @@ type SenderParams struct {
 	Mailbox             *mailbox.Mailbox
 	OutChan             chan *service.Result
 	OutputFileName      *paths.RelativePath
+	TerminalPrinter     *observability.Printer
 }
 
 // Sender is the sender for a stream it handles the incoming messages and sends to the server
@@ -78,6 +87,13 @@ type Sender struct {
 	// outChan is the channel for dispatcher messages
 	outChan chan *service.Result
 
+	// outChanMu guards closing outChan, since step acknowledgments
+	// are sent to it from the filestream's goroutine.
+	outChanMu sync.Mutex
+
+	// isOutChanClosed is whether outChan is closed.
+	isOutChanClosed bool
+
 	// graphqlClient is the graphql client
 	graphqlClient graphql.Client
 
@@ -118,6 +134,21 @@ type Sender struct {
 	// Keep track of config which is being updated incrementally
 	runConfig *runconfig.RunConfig
 
+	// upsertedConfig is a copy of the config as of the last successful
+	// config upsert, or nil before the first one
+	upsertedConfig *runconfig.RunConfig
+
+	// configFilters select the config keys to upload
+	configFilters []runconfig.FilterOption
+
+	// configAudit records changes to the config, or is nil if auditing
+	// is disabled
+	configAudit *configAudit
+
+	// startedArtifactRefs are the config artifact references that are
+	// being or have been resolved, by path and name
+	startedArtifactRefs map[string]struct{}
+
 	// Info about the (local) server we are talking to
 	serverInfo *gql.ServerInfoServerInfo
 
@@ -140,6 +171,9 @@ type Sender struct {
 	// mailbox is used to store cancel functions for each mailbox slot
 	mailbox *mailbox.Mailbox
 
+	// terminalPrinter, if set, shows warnings to the user
+	terminalPrinter *observability.Printer
+
 	// consoleLogsSender uploads captured console output.
 	consoleLogsSender *runconsolelogs.Sender
 }
@@ -162,6 +196,7 @@ func NewSender(
 	s := &Sender{
 		runWork:             runWork,
 		runConfig:           runconfig.New(),
+		startedArtifactRefs: make(map[string]struct{}),
 		telemetry:           &service.TelemetryRecord{CoreVersion: version.Version},
 		runConfigMetrics:    runmetric.NewRunConfigMetrics(),
 		logger:              params.Logger,
@@ -174,6 +209,7 @@ func NewSender(
 		networkPeeker:       params.Peeker,
 		graphqlClient:       params.GraphqlClient,
 		mailbox:             params.Mailbox,
+		terminalPrinter:     params.TerminalPrinter,
 		runSummary:          params.RunSummary,
 		outChan:             params.OutChan,
 		startState:          runbranch.NewRunParams(),
@@ -197,6 +233,35 @@ func NewSender(
 		}),
 	}
 
+	s.runConfig.SetSizeLimits(configSizeLimits(params.Settings))
+	s.configFilters = configFilters(params.Settings)
+	s.runConfig.SetMergeStrategy(
+		configMergeStrategy(params.Settings, params.Logger))
+	if params.Settings.IsConfigPreserveOrder() {
+		s.runConfig.PreserveOrder()
+	}
+	s.runConfig.SetStrictTypes(params.Settings.IsConfigStrictTypes())
+	s.runConfigMetrics.SetRedefinePolicy(
+		metricRedefinePolicy(params.Settings, params.Logger))
+	s.runConfigMetrics.SetConflictCallback(s.logMetricConflict)
+	if params.Settings.IsConfigAudit() {
+		s.configAudit = &configAudit{}
+		s.runConfig.SetChangeCallback(s.configAudit.Record)
+	}
+	s.runConfig.SetDottedKeys(
+		params.Settings.IsConfigDottedKeys(),
+		configKeyEscape(params.Settings, params.Logger))
+
+	nonFinitePolicy, err := nonfinite.ParsePolicy(
+		params.Settings.GetJSONNonFinitePolicy())
+	if err != nil {
+		params.Logger.Warn("sender: using default non-finite policy", "error", err)
+	}
+	s.runConfig.SetNonFinitePolicy(nonFinitePolicy)
+	if s.runSummary != nil {
+		s.runSummary.SetNonFinitePolicy(nonFinitePolicy)
+	}
+
 	backendOrNil := params.Backend
 	if !s.settings.GetXOffline().GetValue() && backendOrNil != nil && !s.settings.GetDisableJobCreation().GetValue() {
 		s.jobBuilder = launch.NewJobBuilder(s.settings, s.logger, false)
@@ -205,6 +270,130 @@ func NewSender(
 	return s
 }
 
+// configSizeLimits returns the run config size limits from the settings.
+//
+// Unset limits use defaults, and negative limits are disabled.
+func configSizeLimits(settings *settings.Settings) runconfig.SizeLimits {
+	limit := func(value int32, defaultValue int) int {
+		switch {
+		case value == 0:
+			return defaultValue
+		case value < 0:
+			return 0
+		default:
+			return int(value)
+		}
+	}
+
+	return runconfig.SizeLimits{
+		MaxBytes: limit(
+			settings.GetConfigMaxBytes(),
+			defaultConfigMaxBytes),
+		MaxValueBytes: limit(
+			settings.GetConfigMaxValueBytes(),
+			defaultConfigMaxValueBytes),
+	}
+}
+
+// configFilters returns the filters for the config keys to upload.
+func configFilters(settings *settings.Settings) []runconfig.FilterOption {
+	var filters []runconfig.FilterOption
+
+	if include := settings.GetConfigIncludeKeys(); len(include) > 0 {
+		// The "_wandb" key holds data that the UI relies on.
+		filters = append(filters,
+			runconfig.WithInclude(include...),
+			runconfig.WithInclude("_wandb"))
+	}
+
+	if exclude := settings.GetConfigExcludeKeys(); len(exclude) > 0 {
+		filters = append(filters, runconfig.WithExclude(exclude...))
+	}
+
+	return filters
+}
+
+// configMergeStrategy returns how config updates combine with existing
+// values according to the settings.
+func configMergeStrategy(
+	settings *settings.Settings,
+	logger *observability.CoreLogger,
+) runconfig.MergeStrategy {
+	var strategy runconfig.MergeStrategy
+
+	switch dictMerge := settings.GetConfigDictMerge(); dictMerge {
+	case "", "merge":
+	case "replace":
+		strategy.ReplaceDicts = true
+	default:
+		logger.Warn(
+			"sender: unknown config dict merge setting, using default",
+			"value", dictMerge)
+	}
+
+	switch listMerge := settings.GetConfigListMerge(); listMerge {
+	case "", "replace":
+	case "append":
+		strategy.AppendLists = true
+	default:
+		logger.Warn(
+			"sender: unknown config list merge setting, using default",
+			"value", listMerge)
+	}
+
+	return strategy
+}
+
+// metricRedefinePolicy returns how metric definitions combine with
+// earlier definitions of the same metric.
+func metricRedefinePolicy(
+	settings *settings.Settings,
+	logger *observability.CoreLogger,
+) runmetric.RedefinePolicy {
+	policy, err := runmetric.ParseRedefinePolicy(
+		settings.GetMetricRedefinePolicy())
+
+	if err != nil {
+		logger.Warn(
+			"sender: unknown metric redefine policy, using default",
+			"error", err)
+	}
+
+	return policy
+}
+
+// logMetricConflict logs a metric definition that disagrees with an
+// earlier definition of the same metric.
+func (s *Sender) logMetricConflict(conflict runmetric.RedefineConflict) {
+	s.logger.Debug(
+		"sender: metric redefinition conflicts with earlier definition",
+		"metric", conflict.Name,
+		"fields", conflict.Fields,
+	)
+}
+
+// configKeyEscape returns the character that escapes dots in dotted
+// config keys.
+func configKeyEscape(
+	settings *settings.Settings,
+	logger *observability.CoreLogger,
+) rune {
+	escape := settings.GetConfigKeyEscape()
+	if escape == "" {
+		return runconfig.DefaultKeyEscape
+	}
+
+	if utf8.RuneCountInString(escape) != 1 {
+		logger.Warn(
+			"sender: config key escape must be one character, using default",
+			"value", escape)
+		return runconfig.DefaultKeyEscape
+	}
+
+	r, _ := utf8.DecodeRuneInString(escape)
+	return r
+}
+
 // Do processes all records on the input channel.
 func (s *Sender) Do(inChan <-chan *service.Record) {
 	defer s.logger.Reraise()
@@ -226,7 +415,11 @@ func (s *Sender) Do(inChan <-chan *service.Record) {
 }
 
 func (s *Sender) Close() {
+	s.outChanMu.Lock()
+	defer s.outChanMu.Unlock()
+
 	// sender is done processing data, close our dispatch channel
+	s.isOutChanClosed = true
 	close(s.outChan)
 }
 
@@ -340,6 +533,8 @@ func (s *Sender) sendRecord(record *service.Record) {
 		s.sendRequest(record, x.Request)
 	case *service.Record_UseArtifact:
 		s.sendUseArtifact(record)
+	case *service.Record_ConfigChange:
+		// Only recorded in the transaction log.
 	case *service.Record_Artifact:
 		s.sendArtifact(record, x.Artifact)
 	case nil:
@@ -358,6 +553,14 @@ func (s *Sender) sendRequest(record *service.Record, request *service.Request) {
 		s.sendRequestRunStart(x.RunStart)
 	case *service.Request_NetworkStatus:
 		s.sendRequestNetworkStatus(record, x.NetworkStatus)
+	case *service.Request_FileStreamMetrics:
+		s.sendRequestFileStreamMetrics(record)
+	case *service.Request_ConfigHistory:
+		s.sendRequestConfigHistory(record)
+	case *service.Request_FileStreamTransmitInterval:
+		s.sendRequestFileStreamTransmitInterval(x.FileStreamTransmitInterval)
+	case *service.Request_StepAck:
+		s.sendRequestStepAck(record, x.StepAck)
 	case *service.Request_Defer:
 		s.sendRequestDefer(x.Defer)
 	case *service.Request_LogArtifact:
@@ -455,6 +658,98 @@ func (s *Sender) sendRequestNetworkStatus(
 	}
 }
 
+// sendRequestFileStreamMetrics responds with debug statistics about
+// the run's filestream.
+func (s *Sender) sendRequestFileStreamMetrics(record *service.Record) {
+	var metrics fs.MetricsSnapshot
+	if s.fileStream != nil {
+		metrics = s.fileStream.Metrics()
+	}
+
+	s.respond(record,
+		&service.Response{
+			ResponseType: &service.Response_FileStreamMetricsResponse{
+				FileStreamMetricsResponse: &service.FileStreamMetricsResponse{
+					Requests:           metrics.Requests,
+					FailedRequests:     metrics.FailedRequests,
+					Retries:            metrics.Retries,
+					BytesSent:          metrics.BytesSent,
+					MaxRequestBytes:    metrics.MaxRequestBytes,
+					MeanLatencySeconds: metrics.MeanLatency.Seconds(),
+					MaxLatencySeconds:  metrics.MaxLatency.Seconds(),
+					DroppedLines:       metrics.DroppedLines,
+					DeferredLines:      metrics.DeferredLines,
+					Files:              fileStreamFileMetrics(metrics.Files),
+					DrainSeconds:       metrics.DrainTime.Seconds(),
+				},
+			},
+		},
+	)
+}
+
+// fileStreamFileMetrics converts per-file filestream metrics to protos.
+func fileStreamFileMetrics(
+	files map[string]fs.FileMetrics,
+) map[string]*service.FileStreamFileMetrics {
+	result := make(map[string]*service.FileStreamFileMetrics, len(files))
+	for name, file := range files {
+		result[name] = &service.FileStreamFileMetrics{
+			Lines: file.Lines,
+			Bytes: file.Bytes,
+		}
+	}
+	return result
+}
+
+// sendRequestStepAck responds once all history logged so far, including
+// the requested step, is accepted by the server.
+//
+// The response is sent from the filestream's goroutine, possibly after
+// the sender has processed later records.
+func (s *Sender) sendRequestStepAck(
+	record *service.Record,
+	request *service.StepAckRequest,
+) {
+	respond := func(accepted bool) {
+		s.outChanMu.Lock()
+		defer s.outChanMu.Unlock()
+
+		if s.isOutChanClosed {
+			return
+		}
+
+		s.respondResponse(record,
+			&service.Response{
+				ResponseType: &service.Response_StepAckResponse{
+					StepAckResponse: &service.StepAckResponse{
+						Accepted: accepted,
+						Step:     request.GetStep(),
+					},
+				},
+			})
+	}
+
+	if s.fileStream == nil {
+		respond(false)
+		return
+	}
+
+	s.fileStream.AckHistory(respond)
+}
+
+// sendRequestFileStreamTransmitInterval changes how often the filestream
+// makes requests.
+func (s *Sender) sendRequestFileStreamTransmitInterval(
+	request *service.FileStreamTransmitIntervalRequest,
+) {
+	if s.fileStream == nil {
+		return
+	}
+
+	s.fileStream.SetTransmitInterval(time.Duration(
+		float64(time.Second) * request.GetTransmitIntervalSeconds()))
+}
+
 func (s *Sender) sendJobFlush() {
 	if s.jobBuilder == nil {
 		return
@@ -486,6 +781,7 @@ func (s *Sender) sendJobFlush() {
 		artifact,
 		0,
 		"",
+		s.artifactSaverOptions()...,
 	)
 	if _, err = saver.Save(); err != nil {
 		s.logger.Error(
@@ -643,15 +939,39 @@ func (s *Sender) sendUseArtifact(record *service.Record) {
 
 // Inserts W&B-internal information into the run configuration.
 func (s *Sender) updateConfigPrivate() {
+	s.updateConfigStats()
 	s.runConfig.AddTelemetryAndMetrics(
 		s.telemetry,
 		s.runConfigMetrics.ToRunConfigData(),
 	)
 }
 
+// Records the size of the user's config in telemetry.
+//
+// The size is logged whenever it changes to help debug pathological
+// configs.
+func (s *Sender) updateConfigStats() {
+	stats := s.runConfig.Stats()
+	configStats := &service.ConfigStats{
+		SizeBytes: int64(stats.Bytes),
+		LeafCount: int64(stats.Leaves),
+	}
+
+	if proto.Equal(s.telemetry.GetConfigStats(), configStats) {
+		return
+	}
+
+	s.telemetry.ConfigStats = configStats
+	s.logger.Debug(
+		"sender: config size changed",
+		"bytes", stats.Bytes,
+		"leaves", stats.Leaves,
+	)
+}
+
 // Serializes the run configuration to send to the backend.
 func (s *Sender) serializeConfig(format runconfig.Format) (string, error) {
-	serializedConfig, err := s.runConfig.Serialize(format)
+	serializedConfig, err := s.runConfig.Serialize(format, s.configFilters...)
 
 	if err != nil {
 		err = fmt.Errorf("failed to marshal config: %s", err)
@@ -725,7 +1045,9 @@ func (s *Sender) sendResumeRun(record *service.Record, run *service.RunRecord) {
 	}
 
 	// Merge the resumed config into the run config
+	s.configAudit.SetSource("resume", 0)
 	s.runConfig.MergeResumedConfig(s.startState.Config)
+	s.writeConfigAudit()
 
 	proto.Merge(run, s.startState.Proto())
 	s.upsertRun(record, run)
@@ -767,11 +1089,9 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 	//
 	// Logically, it would make more sense to instead start with the
 	// resumed config and apply updates on top of it.
-	s.runConfig.ApplyChangeRecord(run.Config,
-		func(err error) {
-			s.logger.CaptureError(
-				fmt.Errorf("error updating run config: %v", err))
-		})
+	s.configAudit.SetSource("run", record.GetNum())
+	s.runConfig.ApplyChangeRecord(run.Config, s.reportConfigError)
+	s.writeConfigAudit()
 
 	proto.Merge(s.telemetry, run.Telemetry)
 	s.updateConfigPrivate()
@@ -937,6 +1257,8 @@ func (s *Sender) upsertRun(record *service.Record, run *service.RunRecord) {
 			},
 		)
 	}
+
+	s.resolveConfigArtifacts()
 }
 
 // sendHistory sends a history record to the file stream,
@@ -992,11 +1314,27 @@ func (s *Sender) upsertConfig() {
 	}
 
 	s.updateConfigPrivate()
-	config, err := s.serializeConfig(runconfig.FormatJson)
+
+	// After the first upsert, only send the top-level keys that changed.
+	snapshot := runconfig.NewFrom(s.runConfig.CloneTree(s.configFilters...))
+	toSend := snapshot
+	if s.upsertedConfig != nil {
+		changes := s.upsertedConfig.Diff(snapshot)
+		toSend = snapshot.Delta(changes)
+
+		// Removed top-level keys can't be expressed in an upsert.
+		if toSend.IsEmpty() {
+			s.upsertedConfig = snapshot
+			return
+		}
+	}
+
+	serializedConfig, err := toSend.Serialize(runconfig.FormatJson)
 	if err != nil {
 		s.logger.Error("sender: upsertConfig: failed to serialize config", "error", err)
 		return
 	}
+	config := string(serializedConfig)
 	if config == "" {
 		return
 	}
@@ -1031,7 +1369,10 @@ func (s *Sender) upsertConfig() {
 	)
 	if err != nil {
 		s.logger.Error("sender: sendConfig:", "error", err)
+		return
 	}
+
+	s.upsertedConfig = snapshot
 }
 
 func (s *Sender) uploadSummaryFile() {
@@ -1072,13 +1413,8 @@ func (s *Sender) uploadConfigFile() {
 		return
 	}
 
-	config, err := s.serializeConfig(runconfig.FormatYaml)
-	if err != nil {
-		s.logger.Error("sender: writeAndSendConfigFile: failed to serialize config", "error", err)
-		return
-	}
 	configFile := filepath.Join(s.settings.GetFilesDir().GetValue(), ConfigFileName)
-	if err := os.WriteFile(configFile, []byte(config), 0644); err != nil {
+	if err := s.writeConfigFile(configFile); err != nil {
 		s.logger.Error("sender: writeAndSendConfigFile: failed to write config file", "error", err)
 		return
 	}
@@ -1098,19 +1434,107 @@ func (s *Sender) uploadConfigFile() {
 	s.fwdRecord(record)
 }
 
+// writeConfigFile writes the config as YAML to a file.
+//
+// The config is streamed to the file, since it can be very large.
+func (s *Sender) writeConfigFile(path string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+
+	err = s.runConfig.SerializeTo(file, runconfig.FormatYaml, s.configFilters...)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
 // sendConfig sends a config record to the server via an upsertBucket mutation
 // and updates the in memory config
-func (s *Sender) sendConfig(_ *service.Record, configRecord *service.ConfigRecord) {
+func (s *Sender) sendConfig(record *service.Record, configRecord *service.ConfigRecord) {
 	if configRecord != nil {
-		s.runConfig.ApplyChangeRecord(configRecord,
-			func(err error) {
-				s.logger.CaptureError(
-					fmt.Errorf("error updating run config: %v", err))
-			})
+		s.configAudit.SetSource("config", record.GetNum())
+		s.runConfig.ApplyChangeRecord(configRecord, s.reportConfigError)
+		s.writeConfigAudit()
+		s.resolveConfigArtifacts()
 	}
 	s.configDebouncer.SetNeedsDebounce()
 }
 
+// writeConfigAudit records new config changes in the transaction log.
+//
+// The record makes its way back to the sender, which ignores it.
+func (s *Sender) writeConfigAudit() {
+	changes := s.configAudit.TakePending()
+	if len(changes) == 0 {
+		return
+	}
+
+	// When syncing, the changes are already in the transaction log.
+	if s.settings.GetXSync().GetValue() {
+		return
+	}
+
+	s.fwdRecord(&service.Record{
+		RecordType: &service.Record_ConfigChange{
+			ConfigChange: &service.ConfigChangeRecord{Change: changes},
+		},
+	})
+}
+
+// sendRequestConfigHistory responds with the changes made to the config.
+func (s *Sender) sendRequestConfigHistory(record *service.Record) {
+	changes, dropped := s.configAudit.History()
+
+	s.respond(record,
+		&service.Response{
+			ResponseType: &service.Response_ConfigHistoryResponse{
+				ConfigHistoryResponse: &service.ConfigHistoryResponse{
+					Change:  changes,
+					Dropped: dropped,
+				},
+			},
+		},
+	)
+}
+
+// reportConfigError logs an error from updating the run config.
+//
+// The user is told about updates skipped for being too large, since
+// otherwise their config would silently be missing keys.
+func (s *Sender) reportConfigError(err error) {
+	var sizeErr *runconfig.SizeLimitError
+	if !errors.As(err, &sizeErr) {
+		s.logger.CaptureError(
+			fmt.Errorf("error updating run config: %v", err))
+		return
+	}
+
+	s.logger.Warn("sender: skipped config update", "error", err)
+	if s.terminalPrinter == nil {
+		return
+	}
+
+	if sizeErr.IsTotal {
+		s.terminalPrinter.Writef(
+			"Config key %q was not saved because the config would exceed"+
+				" %d bytes. Its largest keys are: %s.",
+			sizeErr.Key,
+			sizeErr.Limit,
+			strings.Join(sizeErr.LargestKeys, ", "),
+		)
+	} else {
+		s.terminalPrinter.Writef(
+			"Config key %q was not saved because its value is %d bytes,"+
+				" over the limit of %d bytes.",
+			sizeErr.Key,
+			sizeErr.Bytes,
+			sizeErr.Limit,
+		)
+	}
+}
+
 // sendSystemMetrics sends a system metrics record via the file stream
 func (s *Sender) sendSystemMetrics(record *service.StatsRecord) {
 	if s.fileStream == nil {
@@ -1227,6 +1651,25 @@ func (s *Sender) sendFiles(_ *service.Record, filesRecord *service.FilesRecord)
 	s.runfilesUploader.Process(filesRecord)
 }
 
+// artifactSaverOptions returns the settings-derived options for
+// artifact savers.
+func (s *Sender) artifactSaverOptions() []artifacts.ArtifactSaverOption {
+	opts := []artifacts.ArtifactSaverOption{
+		artifacts.WithMultipartThreshold(
+			s.settings.GetXFileTransferMultipartThresholdBytes().GetValue()),
+	}
+	if retries := s.settings.GetXFileTransferMultipartPartRetries(); retries != nil {
+		opts = append(opts, artifacts.WithMaxPartRetries(int(retries.GetValue())))
+	}
+	if concurrency := s.settings.GetXFileTransferDigestConcurrency(); concurrency != nil {
+		opts = append(opts, artifacts.WithDigestConcurrency(int(concurrency.GetValue())))
+	}
+	if maxFailures := s.settings.GetXArtifactUploadMaxFailures(); maxFailures != nil {
+		opts = append(opts, artifacts.WithMaxFailures(int(maxFailures.GetValue())))
+	}
+	return opts
+}
+
 func (s *Sender) sendArtifact(_ *service.Record, msg *service.ArtifactRecord) {
 	saver := artifacts.NewArtifactSaver(
 		s.runWork.BeforeEndCtx(),
@@ -1236,6 +1679,7 @@ func (s *Sender) sendArtifact(_ *service.Record, msg *service.ArtifactRecord) {
 		msg,
 		0,
 		"",
+		s.artifactSaverOptions()...,
 	)
 	artifactID, err := saver.Save()
 	if err != nil {
@@ -1255,6 +1699,7 @@ func (s *Sender) sendRequestLogArtifact(record *service.Record, msg *service.Log
 		msg.Artifact,
 		msg.HistoryStep,
 		msg.StagingDir,
+		s.artifactSaverOptions()...,
 	)
 	artifactID, err := saver.Save()
 	if err != nil {
@@ -1262,6 +1707,9 @@ func (s *Sender) sendRequestLogArtifact(record *service.Record, msg *service.Log
 	} else {
 		response.ArtifactId = artifactID
 	}
+	for _, failure := range saver.Failures() {
+		response.FailedFiles = append(response.FailedFiles, failure.ToProto())
+	}
 
 	s.jobBuilder.HandleLogArtifactResult(&response, msg.Artifact)
 	s.respond(record,
@@ -1288,6 +1736,12 @@ func (s *Sender) sendRequestDownloadArtifact(record *service.Record, msg *servic
 		msg.AllowMissingReferences,
 		msg.SkipCache,
 		msg.PathPrefix,
+		artifacts.WithCacheMaxSize(
+			s.settings.GetXArtifactsCacheMaxSizeBytes().GetValue()),
+		artifacts.WithCacheHardLinks(
+			s.settings.GetXArtifactsCacheHardlinks().GetValue()),
+		artifacts.WithDownloadBatchSize(
+			int(s.settings.GetXArtifactsDownloadBatchSize().GetValue())),
 	).Download(); err != nil {
 		// Online mode handling: error during download
 		s.logger.CaptureError(
@@ -1371,13 +1825,21 @@ func (s *Sender) sendRequestStopStatus(record *service.Record, _ *service.StopSt
 
 	var stopResponse *service.StopStatusResponse
 
+	switch {
+	// the filestream already heard from the server that the run was stopped
+	case s.fileStream != nil && s.fileStream.RunShouldStop():
+		stopResponse = &service.StopStatusResponse{
+			RunShouldStop: true,
+		}
+
 	// if any of the entity, project or runId is empty, we can't make the request
-	if entity == "" || project == "" || runId == "" {
+	case entity == "" || project == "" || runId == "":
 		s.logger.Error("sender: sendStopStatus: entity, project, runId are empty")
 		stopResponse = &service.StopStatusResponse{
 			RunShouldStop: false,
 		}
-	} else {
+
+	default:
 		response, err := gql.RunStoppedStatus(
 			s.runWork.BeforeEndCtx(),
 			s.graphqlClient,
@@ -52,9 +52,10 @@ func makeSender(client graphql.Client, recordChan chan *service.Record, resultCh
 	})
 	backend := server.NewBackend(logger, settings)
 	fileStream := server.NewFileStream(
-		backend, logger, observability.NewPrinter(), settings, nil)
+		runWork, backend, logger, observability.NewPrinter(), settings, nil)
 	fileTransferManager := server.NewFileTransferManager(
 		filetransfer.NewFileTransferStats(),
+		filetransfer.NewTransferMetrics(),
 		logger,
 		settings,
 	)
@@ -161,6 +161,7 @@ func NewStream(
 
 	backendOrNil := NewBackend(s.logger, settings)
 	fileTransferStats := filetransfer.NewFileTransferStats()
+	transferMetrics := filetransfer.NewTransferMetrics()
 	fileWatcher := watcher.New(watcher.Params{Logger: s.logger})
 	tbHandler := tensorboard.NewTBHandler(tensorboard.Params{
 		ExtraWork: s.runWork,
@@ -175,6 +176,7 @@ func NewStream(
 	if backendOrNil != nil {
 		graphqlClientOrNil = NewGraphQLClient(backendOrNil, settings, peeker)
 		fileStreamOrNil = NewFileStream(
+			s.runWork,
 			backendOrNil,
 			s.logger,
 			terminalPrinter,
@@ -183,6 +185,7 @@ func NewStream(
 		)
 		fileTransferManagerOrNil = NewFileTransferManager(
 			fileTransferStats,
+			transferMetrics,
 			s.logger,
 			settings,
 		)
@@ -198,6 +201,12 @@ func NewStream(
 	}
 
 	mailbox := mailbox.NewMailbox()
+	systemMonitor := monitor.NewSystemMonitor(
+		s.logger,
+		s.settings.Proto,
+		s.runWork,
+		transferMetrics,
+	)
 
 	s.handler = NewHandler(commit,
 		HandlerParams{
@@ -205,7 +214,7 @@ func NewStream(
 			Settings:          s.settings.Proto,
 			FwdChan:           make(chan *service.Record, BufferSize),
 			OutChan:           make(chan *service.Result, BufferSize),
-			SystemMonitor:     monitor.NewSystemMonitor(s.logger, s.settings.Proto, s.runWork),
+			SystemMonitor:     systemMonitor,
 			RunfilesUploader:  runfilesUploaderOrNil,
 			TBHandler:         tbHandler,
 			FileTransferStats: fileTransferStats,
@@ -253,6 +262,7 @@ func NewStream(
 			OutChan:             make(chan *service.Result, BufferSize),
 			Mailbox:             mailbox,
 			OutputFileName:      outputFile,
+			TerminalPrinter:     terminalPrinter,
 		},
 	)
 
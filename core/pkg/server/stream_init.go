@@ -5,9 +5,11 @@ package server
 import (
 	"fmt"
 	"maps"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
@@ -58,9 +60,20 @@ func NewBackend(
 // The custom proxy URLs are passed as arguments to the function.
 //
 // The default environment proxy settings are read from the environment variables
-// HTTP_PROXY, HTTPS_PROXY, and NO_PROXY.
+// HTTP_PROXY, HTTPS_PROXY, and NO_PROXY. Hosts excluded by NO_PROXY are never
+// proxied, even if there's a custom proxy.
 func ProxyFn(httpProxy string, httpsProxy string) func(req *http.Request) (*url.URL, error) {
+	noProxy := os.Getenv("NO_PROXY")
+	if noProxy == "" {
+		noProxy = os.Getenv("no_proxy")
+	}
+
 	return func(req *http.Request) (*url.URL, error) {
+		if (httpProxy != "" || httpsProxy != "") &&
+			matchesNoProxy(req.URL.Hostname(), noProxy) {
+			return nil, nil
+		}
+
 		if req.URL.Scheme == "http" && httpProxy != "" {
 			proxyURLParsed, err := url.Parse(httpProxy)
 			if err != nil {
@@ -80,6 +93,53 @@ func ProxyFn(httpProxy string, httpsProxy string) func(req *http.Request) (*url.
 	}
 }
 
+// matchesNoProxy reports whether a host is excluded from proxying by
+// a NO_PROXY value.
+//
+// NO_PROXY is a comma-separated list of hosts, domains, IP addresses and
+// CIDR ranges. A domain also matches its subdomains, and "*" matches
+// every host. Ports are ignored.
+func matchesNoProxy(host string, noProxy string) bool {
+	host = strings.ToLower(host)
+	hostIP := net.ParseIP(host)
+
+	for _, entry := range strings.Split(noProxy, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if entry == "*" {
+			return true
+		}
+
+		if _, ipNet, err := net.ParseCIDR(entry); err == nil {
+			if hostIP != nil && ipNet.Contains(hostIP) {
+				return true
+			}
+			continue
+		}
+
+		if h, _, err := net.SplitHostPort(entry); err == nil {
+			entry = h
+		}
+		entry = strings.Trim(entry, "[]")
+
+		if ip := net.ParseIP(entry); ip != nil {
+			if hostIP != nil && ip.Equal(hostIP) {
+				return true
+			}
+			continue
+		}
+
+		domain := strings.TrimPrefix(strings.TrimPrefix(entry, "*"), ".")
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func NewGraphQLClient(
 	backend *api.Backend,
 	settings *settings.Settings,
@@ -131,7 +191,12 @@ func NewGraphQLClient(
 	return graphql.NewClient(endpoint, httpClient)
 }
 
+// fileStreamTransports lets the filestreams of all runs in this process
+// share connections and rate limits.
+var fileStreamTransports = api.NewTransportPool()
+
 func NewFileStream(
+	extraWork runwork.ExtraWork,
 	backend *api.Backend,
 	logger *observability.CoreLogger,
 	printer *observability.Printer,
@@ -144,8 +209,13 @@ func NewFileStream(
 		fileStreamHeaders["X-WANDB-USE-ASYNC-FILESTREAM"] = "true"
 	}
 
+	metrics := filestream.NewMetrics()
+	transmitRateLimit := rate.NewLimiter(rate.Every(15*time.Second), 1)
+	throttle := filestream.NewThrottle(transmitRateLimit)
+
 	opts := api.ClientOptions{
-		RetryPolicy:     filestream.RetryPolicy,
+		RetryPolicy: metrics.CheckRetry(
+			throttle.CheckRetry(filestream.RetryPolicy)),
 		RetryMax:        filestream.DefaultRetryMax,
 		RetryWaitMin:    filestream.DefaultRetryWaitMin,
 		RetryWaitMax:    filestream.DefaultRetryWaitMax,
@@ -166,15 +236,45 @@ func NewFileStream(
 	if timeout := settings.GetFileStreamTimeout(); timeout > 0 {
 		opts.NonRetryTimeout = timeout
 	}
+	opts.IdleConnTimeout = settings.GetFileStreamIdleConnTimeout()
+	opts.MaxIdleConnsPerHost = int(settings.GetFileStreamMaxIdleConnsPerHost())
+	opts.KeepAlive = settings.IsFileStreamKeepAlive()
+	opts.DisableHTTP2 = settings.IsFileStreamHTTP2Disabled()
+	opts.Transport = fileStreamTransports.Get(
+		strings.Join([]string{
+			settings.GetBaseURL(),
+			settings.GetHTTPProxy(),
+			settings.GetHTTPSProxy(),
+			fileStreamHeaders["Proxy-Authorization"],
+			fmt.Sprint(
+				opts.IdleConnTimeout,
+				opts.MaxIdleConnsPerHost,
+				opts.DisableHTTP2,
+			),
+		}, "\x00"),
+		opts,
+	)
 
-	fileStreamRetryClient := backend.NewClient(opts)
+	var fileStreamClient api.Client = backend.NewClient(opts)
+	if dryRunPath := settings.GetFileStreamDryRunPath(); dryRunPath != "" {
+		dryRunClient, err := filestream.NewDryRunClient(dryRunPath)
+		if err != nil {
+			logger.CaptureError(err)
+		} else {
+			logger.Info("filestream: dry run", "path", dryRunPath)
+			fileStreamClient = dryRunClient
+		}
+	}
 
 	params := filestream.FileStreamParams{
 		Settings:          settings,
 		Logger:            logger,
 		Printer:           printer,
-		ApiClient:         fileStreamRetryClient,
-		TransmitRateLimit: rate.NewLimiter(rate.Every(15*time.Second), 1),
+		ApiClient:         fileStreamClient,
+		TransmitRateLimit: transmitRateLimit,
+		ExtraWork:         extraWork,
+		Metrics:           metrics,
+		Throttle:          throttle,
 	}
 
 	return filestream.NewFileStream(params)
@@ -182,22 +282,20 @@ func NewFileStream(
 
 func NewFileTransferManager(
 	fileTransferStats filetransfer.FileTransferStats,
+	transferMetrics *filetransfer.TransferMetrics,
 	logger *observability.CoreLogger,
 	settings *settings.Settings,
 ) filetransfer.FileTransferManager {
 	fileTransferRetryClient := retryablehttp.NewClient()
 	fileTransferRetryClient.Logger = logger
-	fileTransferRetryClient.CheckRetry = filetransfer.FileTransferRetryPolicy
+	throttle := filetransfer.NewThrottle()
+	fileTransferRetryClient.CheckRetry = throttle.CheckRetry(
+		filetransfer.FileTransferRetryPolicy)
 	fileTransferRetryClient.RetryMax = filetransfer.DefaultRetryMax
 	fileTransferRetryClient.RetryWaitMin = filetransfer.DefaultRetryWaitMin
 	fileTransferRetryClient.RetryWaitMax = filetransfer.DefaultRetryWaitMax
 	fileTransferRetryClient.HTTPClient.Timeout = filetransfer.DefaultNonRetryTimeout
-	fileTransferRetryClient.Backoff = clients.ExponentialBackoffWithJitter
-	fileTransfers := filetransfer.NewFileTransfers(
-		fileTransferRetryClient,
-		logger,
-		fileTransferStats,
-	)
+	fileTransferRetryClient.Backoff = filetransfer.FileTransferBackoff
 
 	// Set the Proxy function on the HTTP client.
 	transport := &http.Transport{
@@ -225,11 +323,38 @@ func NewFileTransferManager(
 		fileTransferRetryClient.HTTPClient.Timeout = timeout
 	}
 
-	return filetransfer.NewFileTransferManager(
+	// Some file transfers copy the client, so they're made after it's
+	// fully configured.
+	fileTransfers := filetransfer.NewFileTransfers(
+		fileTransferRetryClient,
+		logger,
+		fileTransferStats,
+		settings,
+	)
+
+	opts := []filetransfer.FileTransferManagerOption{
 		filetransfer.WithLogger(logger),
 		filetransfer.WithFileTransfers(fileTransfers),
 		filetransfer.WithFileTransferStats(fileTransferStats),
-	)
+		filetransfer.WithThrottle(throttle),
+		filetransfer.WithTransferMetrics(transferMetrics),
+		filetransfer.WithTaskTimeouts(
+			settings.GetFileTransferUploadTimeout(),
+			settings.GetFileTransferDownloadTimeout(),
+		),
+		filetransfer.WithUploadDeduplication(
+			settings.IsFileTransferUploadDedupe()),
+		filetransfer.WithConcurrencyLimit(
+			int(settings.GetFileTransferMaxConcurrency())),
+		filetransfer.WithPerHostConcurrencyLimit(
+			int(settings.GetFileTransferMaxConcurrencyPerHost())),
+	}
+	if settings.IsFileTransferSnapshotUploads() {
+		opts = append(opts,
+			filetransfer.WithUploadSnapshots(settings.GetTmpDir()))
+	}
+
+	return filetransfer.NewFileTransferManager(opts...)
 }
 
 func NewRunfilesUploader(
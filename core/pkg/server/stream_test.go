@@ -1,11 +1,20 @@
 package server_test
 
 import (
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wandb/wandb/core/internal/filetransfer"
+	"github.com/wandb/wandb/core/internal/settings"
+	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestProxyFn(t *testing.T) {
@@ -54,6 +63,30 @@ func TestProxyFn(t *testing.T) {
 			expectedProxy: "http://custom-proxy:8080",
 			expectedError: false,
 		},
+		{
+			name:          "NO_PROXY host bypasses custom proxy",
+			httpsProxy:    "http://custom-proxy:8443",
+			requestURL:    "https://storage.internal.example.com/bucket/file",
+			envProxy:      map[string]string{"NO_PROXY": "localhost, .example.com"},
+			expectedProxy: "",
+			expectedError: false,
+		},
+		{
+			name:          "NO_PROXY CIDR bypasses custom proxy",
+			httpProxy:     "http://custom-proxy:8080",
+			requestURL:    "http://10.1.2.3:9000/file",
+			envProxy:      map[string]string{"NO_PROXY": "10.0.0.0/8"},
+			expectedProxy: "",
+			expectedError: false,
+		},
+		{
+			name:          "Host not in NO_PROXY uses custom proxy",
+			httpsProxy:    "http://custom-proxy:8443",
+			requestURL:    "https://notexample.com",
+			envProxy:      map[string]string{"NO_PROXY": "example.com"},
+			expectedProxy: "http://custom-proxy:8443",
+			expectedError: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -97,3 +130,37 @@ func TestProxyFn(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFileTransferManager_ReferenceDownloadUsesProxy(t *testing.T) {
+	var proxiedURLs []string
+	proxy := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			proxiedURLs = append(proxiedURLs, r.URL.String())
+			_, _ = w.Write([]byte("content"))
+		}))
+	defer proxy.Close()
+	fileTransferManager := server.NewFileTransferManager(
+		filetransfer.NewFileTransferStats(),
+		filetransfer.NewTransferMetrics(),
+		observability.NewNoOpLogger(),
+		settings.From(&service.Settings{
+			HttpProxy: wrapperspb.String(proxy.URL),
+		}),
+	)
+	defer fileTransferManager.Close()
+
+	stream, err := fileTransferManager.DownloadStream(&filetransfer.Task{
+		Type:      filetransfer.DownloadTask,
+		Url:       "http://reference.example.com/file.txt",
+		Reference: true,
+	})
+	require.NoError(t, err)
+	content, err := io.ReadAll(stream)
+	require.NoError(t, err)
+	require.NoError(t, stream.Close())
+
+	assert.Equal(t, "content", string(content))
+	assert.Equal(t,
+		[]string{"http://reference.example.com/file.txt"},
+		proxiedURLs)
+}
@@ -39,6 +39,7 @@ type TelemetryRecord struct {
 	Issues      *Issues      `protobuf:"bytes,11,opt,name=issues,proto3" json:"issues,omitempty"`
 	CoreVersion string       `protobuf:"bytes,12,opt,name=core_version,json=coreVersion,proto3" json:"core_version,omitempty"`
 	Platform    string       `protobuf:"bytes,13,opt,name=platform,proto3" json:"platform,omitempty"`
+	ConfigStats *ConfigStats `protobuf:"bytes,14,opt,name=config_stats,json=configStats,proto3" json:"config_stats,omitempty"`
 	XInfo       *XRecordInfo `protobuf:"bytes,200,opt,name=_info,json=Info,proto3" json:"_info,omitempty"`
 }
 
@@ -158,6 +159,13 @@ func (x *TelemetryRecord) GetPlatform() string {
 	return ""
 }
 
+func (x *TelemetryRecord) GetConfigStats() *ConfigStats {
+	if x != nil {
+		return x.ConfigStats
+	}
+	return nil
+}
+
 func (x *TelemetryRecord) GetXInfo() *XRecordInfo {
 	if x != nil {
 		return x.XInfo
@@ -2056,6 +2064,61 @@ func (x *Issues) GetSettings_PreprocessingWarnings() bool {
 	return false
 }
 
+type ConfigStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SizeBytes int64 `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"` // size of the user's config as JSON
+	LeafCount int64 `protobuf:"varint,2,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"` // number of leaf values in the user's config
+}
+
+func (x *ConfigStats) Reset() {
+	*x = ConfigStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wandb_proto_wandb_telemetry_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigStats) ProtoMessage() {}
+
+func (x *ConfigStats) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_telemetry_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigStats.ProtoReflect.Descriptor instead.
+func (*ConfigStats) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_telemetry_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConfigStats) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ConfigStats) GetLeafCount() int64 {
+	if x != nil {
+		return x.LeafCount
+	}
+	return 0
+}
+
 var File_wandb_proto_wandb_telemetry_proto protoreflect.FileDescriptor
 
 var file_wandb_proto_wandb_telemetry_proto_rawDesc = []byte{
@@ -2064,7 +2127,7 @@ var file_wandb_proto_wandb_telemetry_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
 	0x6e, 0x61, 0x6c, 0x1a, 0x1c, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2f, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xec, 0x04, 0x0a, 0x0f, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52,
+	0x6f, 0x22, 0xac, 0x05, 0x0a, 0x0f, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52,
 	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73,
 	0x5f, 0x69, 0x6e, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x61,
 	0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x49, 0x6d, 0x70,
@@ -2099,7 +2162,11 @@ var file_wandb_proto_wandb_telemetry_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
 	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x31, 0x0a,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x3e, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x31, 0x0a,
 	0x05, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
 	0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x5f,
 	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x49, 0x6e, 0x66, 0x6f,
@@ -2529,7 +2596,12 @@ var file_wandb_proto_wandb_telemetry_proto_rawDesc = []byte{
 	0x6e, 0x67, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x08, 0x52, 0x1d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x72, 0x65, 0x70, 0x72,
 	0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x4b, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2544,7 +2616,7 @@ func file_wandb_proto_wandb_telemetry_proto_rawDescGZIP() []byte {
 	return file_wandb_proto_wandb_telemetry_proto_rawDescData
 }
 
-var file_wandb_proto_wandb_telemetry_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_wandb_proto_wandb_telemetry_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_wandb_proto_wandb_telemetry_proto_goTypes = []interface{}{
 	(*TelemetryRecord)(nil), // 0: wandb_internal.TelemetryRecord
 	(*TelemetryResult)(nil), // 1: wandb_internal.TelemetryResult
@@ -2554,7 +2626,8 @@ var file_wandb_proto_wandb_telemetry_proto_goTypes = []interface{}{
 	(*Labels)(nil),          // 5: wandb_internal.Labels
 	(*Deprecated)(nil),      // 6: wandb_internal.Deprecated
 	(*Issues)(nil),          // 7: wandb_internal.Issues
-	(*XRecordInfo)(nil),     // 8: wandb_internal._RecordInfo
+	(*ConfigStats)(nil),     // 8: wandb_internal.ConfigStats
+	(*XRecordInfo)(nil),     // 9: wandb_internal._RecordInfo
 }
 var file_wandb_proto_wandb_telemetry_proto_depIdxs = []int32{
 	2, // 0: wandb_internal.TelemetryRecord.imports_init:type_name -> wandb_internal.Imports
@@ -2564,12 +2637,13 @@ var file_wandb_proto_wandb_telemetry_proto_depIdxs = []int32{
 	5, // 4: wandb_internal.TelemetryRecord.label:type_name -> wandb_internal.Labels
 	6, // 5: wandb_internal.TelemetryRecord.deprecated:type_name -> wandb_internal.Deprecated
 	7, // 6: wandb_internal.TelemetryRecord.issues:type_name -> wandb_internal.Issues
-	8, // 7: wandb_internal.TelemetryRecord._info:type_name -> wandb_internal._RecordInfo
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	8, // 7: wandb_internal.TelemetryRecord.config_stats:type_name -> wandb_internal.ConfigStats
+	9, // 8: wandb_internal.TelemetryRecord._info:type_name -> wandb_internal._RecordInfo
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_wandb_proto_wandb_telemetry_proto_init() }
@@ -2675,6 +2749,18 @@ func file_wandb_proto_wandb_telemetry_proto_init() {
 				return nil
 			}
 		}
+		file_wandb_proto_wandb_telemetry_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2682,7 +2768,7 @@ func file_wandb_proto_wandb_telemetry_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_wandb_proto_wandb_telemetry_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
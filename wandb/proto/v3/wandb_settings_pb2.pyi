@@ -153,7 +153,7 @@ class Settings(google.protobuf.message.Message):
 
     Some fields such as `run_id` only make sense at the run level.
 
-    Next ID: 173
+    Next ID: 241
     """
 
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
@@ -175,6 +175,43 @@ class Settings(google.protobuf.message.Message):
     IDENTITY_TOKEN_FILE_FIELD_NUMBER: builtins.int
     CREDENTIALS_FILE_FIELD_NUMBER: builtins.int
     _FILE_STREAM_MAX_BYTES_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_FLUSH_BYTES_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_FLUSH_LINES_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_MIN_BATCH_DELAY_SECONDS_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_HEARTBEAT_SECONDS_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_MAX_LINE_BYTES_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_BACKLOG_BYTES_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_BACKLOG_BLOCK_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_TRANSMIT_INTERVAL_SECONDS_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_DRY_RUN_PATH_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_MAX_REQUEST_BYTES_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_DRAIN_TIMEOUT_SECONDS_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_DRAIN_TIMEOUT_POLICY_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_IDLE_CONN_TIMEOUT_SECONDS_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_MAX_IDLE_CONNS_PER_HOST_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_KEEP_ALIVE_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_DISABLE_HTTP2_FIELD_NUMBER: builtins.int
+    _CONSOLE_DISABLE_DEDUPE_FIELD_NUMBER: builtins.int
+    _CONSOLE_MAX_LINES_PER_SECOND_FIELD_NUMBER: builtins.int
+    _CONSOLE_DISABLE_REDACTION_FIELD_NUMBER: builtins.int
+    _CONSOLE_REDACT_PATTERNS_FIELD_NUMBER: builtins.int
+    _CONSOLE_MAX_LINE_LENGTH_FIELD_NUMBER: builtins.int
+    _CONSOLE_STRIP_ANSI_FIELD_NUMBER: builtins.int
+    _CONSOLE_CARRIAGE_RETURN_NEWLINE_FIELD_NUMBER: builtins.int
+    _CONFIG_MAX_BYTES_FIELD_NUMBER: builtins.int
+    _CONFIG_MAX_VALUE_BYTES_FIELD_NUMBER: builtins.int
+    _CONFIG_INCLUDE_KEYS_FIELD_NUMBER: builtins.int
+    _CONFIG_EXCLUDE_KEYS_FIELD_NUMBER: builtins.int
+    _CONFIG_DICT_MERGE_FIELD_NUMBER: builtins.int
+    _CONFIG_LIST_MERGE_FIELD_NUMBER: builtins.int
+    _JSON_NON_FINITE_POLICY_FIELD_NUMBER: builtins.int
+    _CONFIG_PRESERVE_ORDER_FIELD_NUMBER: builtins.int
+    _CONFIG_STRICT_TYPES_FIELD_NUMBER: builtins.int
+    _CONFIG_INTERPOLATE_ENV_FIELD_NUMBER: builtins.int
+    _CONFIG_DOTTED_KEYS_FIELD_NUMBER: builtins.int
+    _CONFIG_KEY_ESCAPE_FIELD_NUMBER: builtins.int
+    _CONFIG_AUDIT_FIELD_NUMBER: builtins.int
+    _METRIC_REDEFINE_POLICY_FIELD_NUMBER: builtins.int
     _EXTRA_HTTP_HEADERS_FIELD_NUMBER: builtins.int
     _FILE_STREAM_RETRY_MAX_FIELD_NUMBER: builtins.int
     _FILE_STREAM_RETRY_WAIT_MIN_SECONDS_FIELD_NUMBER: builtins.int
@@ -184,10 +221,39 @@ class Settings(google.protobuf.message.Message):
     _FILE_TRANSFER_RETRY_WAIT_MIN_SECONDS_FIELD_NUMBER: builtins.int
     _FILE_TRANSFER_RETRY_WAIT_MAX_SECONDS_FIELD_NUMBER: builtins.int
     _FILE_TRANSFER_TIMEOUT_SECONDS_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_UPLOAD_TIMEOUT_SECONDS_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_DOWNLOAD_TIMEOUT_SECONDS_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_UPLOAD_DEDUPE_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_SNAPSHOT_UPLOADS_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_MULTIPART_THRESHOLD_BYTES_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_MULTIPART_PART_RETRIES_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_DIGEST_CONCURRENCY_FIELD_NUMBER: builtins.int
+    _ARTIFACT_UPLOAD_MAX_FAILURES_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_DOWNLOAD_CONCURRENCY_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_DOWNLOAD_CHUNK_SIZE_BYTES_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_MAX_CONCURRENCY_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_MAX_CONCURRENCY_PER_HOST_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_SYMLINK_POLICY_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_COMPRESS_GLOBS_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_REFERENCE_INCLUDE_GLOBS_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_REFERENCE_EXCLUDE_GLOBS_FIELD_NUMBER: builtins.int
+    _FILE_TRANSFER_LOCAL_REFERENCE_POLICY_FIELD_NUMBER: builtins.int
+    _HTTP_REFERENCE_HEADERS_FIELD_NUMBER: builtins.int
+    _HTTP_REFERENCE_BEARER_TOKEN_FIELD_NUMBER: builtins.int
+    _WEBDAV_USERNAME_FIELD_NUMBER: builtins.int
+    _WEBDAV_PASSWORD_FIELD_NUMBER: builtins.int
+    _OCI_USERNAME_FIELD_NUMBER: builtins.int
+    _OCI_PASSWORD_FIELD_NUMBER: builtins.int
+    _ARTIFACTS_CACHE_MAX_SIZE_BYTES_FIELD_NUMBER: builtins.int
+    _ARTIFACTS_CACHE_HARDLINKS_FIELD_NUMBER: builtins.int
+    _ARTIFACTS_DOWNLOAD_BATCH_SIZE_FIELD_NUMBER: builtins.int
     _GRAPHQL_RETRY_MAX_FIELD_NUMBER: builtins.int
     _GRAPHQL_RETRY_WAIT_MIN_SECONDS_FIELD_NUMBER: builtins.int
     _GRAPHQL_RETRY_WAIT_MAX_SECONDS_FIELD_NUMBER: builtins.int
     _GRAPHQL_TIMEOUT_SECONDS_FIELD_NUMBER: builtins.int
+    _STATS_SAMPLING_DELAY_SECONDS_FIELD_NUMBER: builtins.int
+    _STATS_WARMUP_DURATION_SECONDS_FIELD_NUMBER: builtins.int
+    _STATS_WARMUP_SAMPLE_RATE_SECONDS_FIELD_NUMBER: builtins.int
     _ARGS_FIELD_NUMBER: builtins.int
     _AWS_LAMBDA_FIELD_NUMBER: builtins.int
     _CLI_ONLY_MODE_FIELD_NUMBER: builtins.int
@@ -392,6 +458,224 @@ class Settings(google.protobuf.message.Message):
         This number is approximate: requests will be slightly larger.
         """
     @property
+    def _file_stream_flush_bytes(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Amount of buffered filestream data at which to make a request
+        without waiting for the transmit rate limit.
+        """
+    @property
+    def _file_stream_flush_lines(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Number of buffered filestream lines at which to make a request
+        without waiting for the transmit rate limit.
+        """
+    @property
+    def _file_stream_min_batch_delay_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Minimum time to collect filestream updates before making a request.
+
+        This prevents a burst of small updates from each becoming a request.
+        """
+    @property
+    def _file_stream_heartbeat_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Maximum time between filestream requests.
+
+        An empty request is sent as a heartbeat if there is no data for this
+        long. If unset, the server may choose the interval.
+        """
+    @property
+    def _file_stream_max_line_bytes(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Maximum length of a line in a filestream file, such as a row of history.
+
+        Values above the backend's limit of about 10 MB are ignored.
+        """
+    @property
+    def _file_stream_backlog_bytes(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Amount of unsent filestream data above which to warn that data is
+        logged faster than it can be uploaded.
+        """
+    @property
+    def _file_stream_backlog_block(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to block history updates while the filestream backlog is
+        above _file_stream_backlog_bytes, slowing down the logging process.
+        """
+    @property
+    def _file_stream_transmit_interval_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Minimum time between filestream requests.
+
+        This can be raised to reduce bandwidth use on constrained connections.
+        The server may still require a longer interval.
+        """
+    @property
+    def _file_stream_dry_run_path(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """If set, filestream requests are written to this file as lines of JSON
+        instead of being sent, for testing and debugging.
+        """
+    @property
+    def _file_stream_max_request_bytes(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Maximum size of a filestream request body in bytes.
+
+        Unlike _file_stream_max_bytes, this is a hard limit: requests that
+        exceed it are split into several smaller requests. A single line
+        larger than the limit is still sent in its own request.
+        """
+    @property
+    def _file_stream_drain_timeout_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Maximum time to spend sending remaining filestream data when a run
+        finishes. If unset, there is no limit.
+        """
+    @property
+    def _file_stream_drain_timeout_policy(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """What to do with unsent filestream data after the drain timeout:
+        "spill" (the default) reports it as not uploaded, so that the user is
+        told to upload the run with `wandb sync`, and "drop" discards it.
+        """
+    @property
+    def _file_stream_idle_conn_timeout_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """How long an idle filestream connection is kept open for reuse."""
+    @property
+    def _file_stream_max_idle_conns_per_host(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Maximum number of idle filestream connections to keep per host."""
+    @property
+    def _file_stream_keep_alive(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to reuse connections between filestream requests.
+
+        On by default, so that the filestreams of all runs in a process share
+        a connection pool. Turning it off opens a new connection for each
+        request, so that a broken connection is never reused.
+        """
+    @property
+    def _file_stream_disable_http2(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to use only HTTP/1.1 for filestream requests."""
+    @property
+    def _console_disable_dedupe(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to stop collapsing identical consecutive console lines when
+        streaming them to the UI. The saved output file is never collapsed.
+        """
+    @property
+    def _console_max_lines_per_second(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Maximum rate of new console lines to stream to the UI.
+
+        Lines above the limit are skipped in the live view but kept in the
+        saved output file. A skipped line that changes later, such as a
+        progress bar, is still sent. If unset or not positive, there is no
+        limit.
+        """
+    @property
+    def _console_disable_redaction(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to stop redacting secrets such as API keys and tokens from
+        console output streamed to the UI. The saved output file is never
+        redacted.
+        """
+    @property
+    def _console_redact_patterns(self) -> global___ListStringValue:
+        """Additional regular expressions for secrets to redact from console
+        output streamed to the UI.
+
+        If a pattern has a group named "secret", only that group is redacted;
+        otherwise the whole match is.
+        """
+    @property
+    def _console_max_line_length(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Maximum number of characters in a captured console line.
+
+        Longer lines are truncated. Defaults to 4096.
+        """
+    @property
+    def _console_strip_ansi(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to remove unsupported ANSI escape sequences, such as colors
+        and hyperlinks, from captured console output instead of keeping them
+        as text.
+        """
+    @property
+    def _console_carriage_return_newline(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether a carriage return in console output starts a new line.
+
+        By default, a carriage return overwrites the current line, so that
+        progress bars only keep their latest state.
+        """
+    @property
+    def _config_max_bytes(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Maximum size of the run config in bytes when encoded as JSON.
+
+        Config updates that would exceed it are skipped with a warning.
+        Defaults to 10 MB; a negative value disables the limit.
+        """
+    @property
+    def _config_max_value_bytes(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Maximum size in bytes of a single value in a config update when
+        encoded as JSON.
+
+        Larger values are skipped with a warning. Defaults to 1 MB; a
+        negative value disables the limit.
+        """
+    @property
+    def _config_include_keys(self) -> global___ListStringValue:
+        """Patterns for the only config keys to upload, such as "model.*".
+
+        Patterns are dot-separated and each part may use shell wildcards. A
+        pattern that matches a key also matches everything under it. The
+        "_wandb" key is always uploaded. Excluded keys are still available
+        to the run, such as for building jobs.
+        """
+    @property
+    def _config_exclude_keys(self) -> global___ListStringValue:
+        """Patterns for config keys not to upload, such as "secrets.*".
+
+        Uses the same syntax as _config_include_keys and takes precedence
+        over it.
+        """
+    @property
+    def _config_dict_merge(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """How config updates combine dict values with existing dicts:
+        "merge" (the default) updates keys recursively, and "replace"
+        replaces the whole dict.
+        """
+    @property
+    def _config_list_merge(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """How config updates combine list values with existing lists:
+        "replace" (the default) replaces the list, and "append" appends to it.
+        """
+    @property
+    def _json_non_finite_policy(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """What to do with NaN and +-Infinity in config and summary values:
+        "allow" (the default) uploads them as extended JSON, "error" skips
+        values containing them, "drop" removes them, and "stringify" uploads
+        them as the strings "NaN", "Infinity" and "-Infinity".
+        """
+    @property
+    def _config_preserve_order(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to write config keys in the order they were first set instead
+        of sorting them.
+        """
+    @property
+    def _config_strict_types(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to reject config values that can't be serialized in every
+        format, such as nulls, instead of storing them.
+        """
+    @property
+    def _config_interpolate_env(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to replace references like "${env:DATA_DIR}" in the initial
+        config's string values by environment variables of the user process.
+        """
+    @property
+    def _config_dotted_keys(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether config keys like "optimizer.lr" refer to nested keys."""
+    @property
+    def _config_key_escape(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """The character that escapes dots in dotted config keys, as in
+        "file\\.txt". Defaults to a backslash.
+        """
+    @property
+    def _config_audit(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to record changes to the config in the transaction log and
+        keep recent ones for debugging.
+        """
+    @property
+    def _metric_redefine_policy(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """How a metric definition combines with an earlier definition of the
+        same metric: "merge" (the default) replaces only the fields that the
+        new definition sets, "overwrite" replaces the whole definition, and
+        "keep_first" keeps the fields that the earlier definition set.
+        """
+    @property
     def _extra_http_headers(self) -> global___MapStringKeyStringValue:
         """Additional headers to add to all outgoing HTTP requests."""
     @property
@@ -419,6 +703,129 @@ class Settings(google.protobuf.message.Message):
     def _file_transfer_timeout_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
         """Per-retry timeout for file upload/download operations."""
     @property
+    def _file_transfer_upload_timeout_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Deadline for each file upload, including retries."""
+    @property
+    def _file_transfer_download_timeout_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Deadline for each file download, including retries."""
+    @property
+    def _file_transfer_upload_dedupe(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to skip uploads whose Content-MD5 matches the last upload to
+        the same place by this run.
+        """
+    @property
+    def _file_transfer_snapshot_uploads(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to copy files before uploading them, so that files still being
+        written aren't uploaded in a torn state.
+        """
+    @property
+    def _file_transfer_multipart_threshold_bytes(self) -> google.protobuf.wrappers_pb2.Int64Value:
+        """Minimum size of files uploaded in multiple parts."""
+    @property
+    def _file_transfer_multipart_part_retries(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Number of times a failed part of a multipart upload is retried before
+        the whole file is retried.
+        """
+    @property
+    def _file_transfer_digest_concurrency(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Number of file parts hashed at once before a multipart upload.
+
+        Defaults to the number of CPUs.
+        """
+    @property
+    def _artifact_upload_max_failures(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Number of files that may fail to upload without failing to log an
+        artifact. Files that fail are left out of the artifact.
+        """
+    @property
+    def _file_transfer_download_concurrency(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Number of byte ranges of a large file downloaded concurrently.
+
+        Ranged downloads are disabled unless this is greater than 1.
+        """
+    @property
+    def _file_transfer_download_chunk_size_bytes(self) -> google.protobuf.wrappers_pb2.Int64Value:
+        """Size of each byte range in a concurrent download."""
+    @property
+    def _file_transfer_max_concurrency(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Maximum number of file uploads/downloads in progress at once."""
+    @property
+    def _file_transfer_max_concurrency_per_host(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Maximum number of file uploads/downloads to a single host at once.
+
+        There is no per-host limit unless this is positive.
+        """
+    @property
+    def _file_transfer_symlink_policy(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """How symbolic links in downloaded directories are handled: "skip"
+        (the default), "preserve" or "follow".
+        """
+    @property
+    def _file_transfer_compress_globs(self) -> global___ListStringValue:
+        """Unix glob patterns matched against file names to gzip before upload.
+
+        Files uploaded with an MD5 checksum, like artifact files, are never
+        compressed.
+        """
+    @property
+    def _file_transfer_reference_include_globs(self) -> global___ListStringValue:
+        """Unix glob patterns for files to download when a reference expands to
+        many files, like an HDFS directory. If empty, all files are included.
+
+        Patterns without a "/" are matched against file names, and others
+        against paths relative to the reference.
+        """
+    @property
+    def _file_transfer_reference_exclude_globs(self) -> global___ListStringValue:
+        """Unix glob patterns for files to skip when a reference expands to many
+        files. Exclusions take precedence over inclusions.
+        """
+    @property
+    def _file_transfer_local_reference_policy(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """How file:// references are downloaded: "copy" (the default), "link"
+        to hard link files when possible, or "verify" to only check them in
+        place.
+        """
+    @property
+    def _http_reference_headers(self) -> global___MapStringKeyStringValue:
+        """Additional headers to send when downloading http(s):// references."""
+    @property
+    def _http_reference_bearer_token(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Bearer token to send when downloading http(s):// references."""
+    @property
+    def _webdav_username(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """User name for webdav:// and webdavs:// transfers."""
+    @property
+    def _webdav_password(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Password for webdav:// and webdavs:// transfers."""
+    @property
+    def _oci_username(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """User name for OCI registries, used to get tokens for oci:// references."""
+    @property
+    def _oci_password(self) -> google.protobuf.wrappers_pb2.StringValue:
+        """Password or access token for OCI registries."""
+    @property
+    def _artifacts_cache_max_size_bytes(self) -> google.protobuf.wrappers_pb2.Int64Value:
+        """Maximum size of the artifacts cache in bytes.
+
+        Least recently used files are removed after downloading an artifact
+        if the cache is larger. The cache is unbounded unless this is positive.
+        """
+    @property
+    def _artifacts_cache_hardlinks(self) -> google.protobuf.wrappers_pb2.BoolValue:
+        """Whether to hard link files restored from the artifacts cache instead
+        of copying them.
+
+        Hard links save space and time, but modifying a restored file in
+        place also modifies the cached copy.
+        """
+    @property
+    def _artifacts_download_batch_size(self) -> google.protobuf.wrappers_pb2.Int32Value:
+        """Number of files downloaded between checkpoints of an artifact download.
+
+        An interrupted download resumes after the last completed batch.
+        """
+    @property
     def _graphql_retry_max(self) -> google.protobuf.wrappers_pb2.Int32Value:
         """Maximum number of retries for GraphQL operations."""
     @property
@@ -431,6 +838,20 @@ class Settings(google.protobuf.message.Message):
     def _graphql_timeout_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
         """Per-retry timeout for GraphQL operations."""
     @property
+    def _stats_sampling_delay_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Delay before the system monitor takes its first sample.
+
+        Useful to skip startup transients of long-running jobs.
+        """
+    @property
+    def _stats_warmup_duration_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Duration of a high-frequency sampling burst after the system monitor
+        starts, so that short-lived runs still capture a few samples.
+        """
+    @property
+    def _stats_warmup_sample_rate_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue:
+        """Sampling interval used during the warm-up burst."""
+    @property
     def _args(self) -> global___ListStringValue: ...
     @property
     def _aws_lambda(self) -> google.protobuf.wrappers_pb2.BoolValue: ...
@@ -736,6 +1157,43 @@ class Settings(google.protobuf.message.Message):
         identity_token_file: google.protobuf.wrappers_pb2.StringValue | None = ...,
         credentials_file: google.protobuf.wrappers_pb2.StringValue | None = ...,
         _file_stream_max_bytes: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_stream_flush_bytes: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_stream_flush_lines: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_stream_min_batch_delay_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _file_stream_heartbeat_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _file_stream_max_line_bytes: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_stream_backlog_bytes: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_stream_backlog_block: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _file_stream_transmit_interval_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _file_stream_dry_run_path: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _file_stream_max_request_bytes: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_stream_drain_timeout_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _file_stream_drain_timeout_policy: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _file_stream_idle_conn_timeout_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _file_stream_max_idle_conns_per_host: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_stream_keep_alive: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _file_stream_disable_http2: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _console_disable_dedupe: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _console_max_lines_per_second: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _console_disable_redaction: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _console_redact_patterns: global___ListStringValue | None = ...,
+        _console_max_line_length: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _console_strip_ansi: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _console_carriage_return_newline: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _config_max_bytes: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _config_max_value_bytes: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _config_include_keys: global___ListStringValue | None = ...,
+        _config_exclude_keys: global___ListStringValue | None = ...,
+        _config_dict_merge: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _config_list_merge: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _json_non_finite_policy: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _config_preserve_order: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _config_strict_types: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _config_interpolate_env: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _config_dotted_keys: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _config_key_escape: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _config_audit: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _metric_redefine_policy: google.protobuf.wrappers_pb2.StringValue | None = ...,
         _extra_http_headers: global___MapStringKeyStringValue | None = ...,
         _file_stream_retry_max: google.protobuf.wrappers_pb2.Int32Value | None = ...,
         _file_stream_retry_wait_min_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
@@ -745,10 +1203,39 @@ class Settings(google.protobuf.message.Message):
         _file_transfer_retry_wait_min_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
         _file_transfer_retry_wait_max_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
         _file_transfer_timeout_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _file_transfer_upload_timeout_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _file_transfer_download_timeout_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _file_transfer_upload_dedupe: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _file_transfer_snapshot_uploads: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _file_transfer_multipart_threshold_bytes: google.protobuf.wrappers_pb2.Int64Value | None = ...,
+        _file_transfer_multipart_part_retries: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_transfer_digest_concurrency: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _artifact_upload_max_failures: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_transfer_download_concurrency: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_transfer_download_chunk_size_bytes: google.protobuf.wrappers_pb2.Int64Value | None = ...,
+        _file_transfer_max_concurrency: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_transfer_max_concurrency_per_host: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _file_transfer_symlink_policy: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _file_transfer_compress_globs: global___ListStringValue | None = ...,
+        _file_transfer_reference_include_globs: global___ListStringValue | None = ...,
+        _file_transfer_reference_exclude_globs: global___ListStringValue | None = ...,
+        _file_transfer_local_reference_policy: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _http_reference_headers: global___MapStringKeyStringValue | None = ...,
+        _http_reference_bearer_token: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _webdav_username: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _webdav_password: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _oci_username: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _oci_password: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _artifacts_cache_max_size_bytes: google.protobuf.wrappers_pb2.Int64Value | None = ...,
+        _artifacts_cache_hardlinks: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _artifacts_download_batch_size: google.protobuf.wrappers_pb2.Int32Value | None = ...,
         _graphql_retry_max: google.protobuf.wrappers_pb2.Int32Value | None = ...,
         _graphql_retry_wait_min_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
         _graphql_retry_wait_max_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
         _graphql_timeout_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _stats_sampling_delay_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _stats_warmup_duration_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _stats_warmup_sample_rate_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
         _args: global___ListStringValue | None = ...,
         _aws_lambda: google.protobuf.wrappers_pb2.BoolValue | None = ...,
         _cli_only_mode: google.protobuf.wrappers_pb2.BoolValue | None = ...,
@@ -889,7 +1376,7 @@ class Settings(google.protobuf.message.Message):
         https_proxy: google.protobuf.wrappers_pb2.StringValue | None = ...,
         _proxies: global___MapStringKeyStringValue | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["_args", b"_args", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_code_path_local", b"_code_path_local", "_colab", b"_colab", "_cuda", b"_cuda", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_update_check", b"_disable_update_check", "_disable_viewer", b"_disable_viewer", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_file_stream_max_bytes", b"_file_stream_max_bytes", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_network_buffer", b"_network_buffer", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_platform", b"_platform", "_proxies", b"_proxies", "_python", b"_python", "_require_core", b"_require_core", "_runqueue_item_id", b"_runqueue_item_id", "_save_requirements", b"_save_requirements", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_shared", b"_shared", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_sync", b"_sync", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "console_multipart", b"console_multipart", "credentials_file", b"credentials_file", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "fork_from", b"fork_from", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "http_proxy", b"http_proxy", "https_proxy", b"https_proxy", "identity_token_file", b"identity_token_file", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "notebook_name", b"notebook_name", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resume_from", b"resume_from", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_args", b"_args", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_code_path_local", b"_code_path_local", "_colab", b"_colab", "_cuda", b"_cuda", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_update_check", b"_disable_update_check", "_disable_viewer", b"_disable_viewer", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_file_stream_max_bytes", b"_file_stream_max_bytes", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_network_buffer", b"_network_buffer", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_platform", b"_platform", "_proxies", b"_proxies", "_python", b"_python", "_require_core", b"_require_core", "_runqueue_item_id", b"_runqueue_item_id", "_save_requirements", b"_save_requirements", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_shared", b"_shared", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_sync", b"_sync", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "console_multipart", b"console_multipart", "credentials_file", b"credentials_file", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "fork_from", b"fork_from", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "http_proxy", b"http_proxy", "https_proxy", b"https_proxy", "identity_token_file", b"identity_token_file", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "notebook_name", b"notebook_name", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resume_from", b"resume_from", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_args", b"_args", "_artifact_upload_max_failures", b"_artifact_upload_max_failures", "_artifacts_cache_hardlinks", b"_artifacts_cache_hardlinks", "_artifacts_cache_max_size_bytes", b"_artifacts_cache_max_size_bytes", "_artifacts_download_batch_size", b"_artifacts_download_batch_size", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_code_path_local", b"_code_path_local", "_colab", b"_colab", "_config_audit", b"_config_audit", "_config_dict_merge", b"_config_dict_merge", "_config_dotted_keys", b"_config_dotted_keys", "_config_exclude_keys", b"_config_exclude_keys", "_config_include_keys", b"_config_include_keys", "_config_interpolate_env", b"_config_interpolate_env", "_config_key_escape", b"_config_key_escape", "_config_list_merge", b"_config_list_merge", "_config_max_bytes", b"_config_max_bytes", "_config_max_value_bytes", b"_config_max_value_bytes", "_config_preserve_order", b"_config_preserve_order", "_config_strict_types", b"_config_strict_types", "_console_carriage_return_newline", b"_console_carriage_return_newline", "_console_disable_dedupe", b"_console_disable_dedupe", "_console_disable_redaction", b"_console_disable_redaction", "_console_max_line_length", b"_console_max_line_length", "_console_max_lines_per_second", b"_console_max_lines_per_second", "_console_redact_patterns", b"_console_redact_patterns", "_console_strip_ansi", b"_console_strip_ansi", "_cuda", b"_cuda", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_update_check", b"_disable_update_check", "_disable_viewer", b"_disable_viewer", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_file_stream_backlog_block", b"_file_stream_backlog_block", "_file_stream_backlog_bytes", b"_file_stream_backlog_bytes", "_file_stream_disable_http2", b"_file_stream_disable_http2", "_file_stream_drain_timeout_policy", b"_file_stream_drain_timeout_policy", "_file_stream_drain_timeout_seconds", b"_file_stream_drain_timeout_seconds", "_file_stream_dry_run_path", b"_file_stream_dry_run_path", "_file_stream_flush_bytes", b"_file_stream_flush_bytes", "_file_stream_flush_lines", b"_file_stream_flush_lines", "_file_stream_heartbeat_seconds", b"_file_stream_heartbeat_seconds", "_file_stream_idle_conn_timeout_seconds", b"_file_stream_idle_conn_timeout_seconds", "_file_stream_keep_alive", b"_file_stream_keep_alive", "_file_stream_max_bytes", b"_file_stream_max_bytes", "_file_stream_max_idle_conns_per_host", b"_file_stream_max_idle_conns_per_host", "_file_stream_max_line_bytes", b"_file_stream_max_line_bytes", "_file_stream_max_request_bytes", b"_file_stream_max_request_bytes", "_file_stream_min_batch_delay_seconds", b"_file_stream_min_batch_delay_seconds", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_stream_transmit_interval_seconds", b"_file_stream_transmit_interval_seconds", "_file_transfer_compress_globs", b"_file_transfer_compress_globs", "_file_transfer_digest_concurrency", b"_file_transfer_digest_concurrency", "_file_transfer_download_chunk_size_bytes", b"_file_transfer_download_chunk_size_bytes", "_file_transfer_download_concurrency", b"_file_transfer_download_concurrency", "_file_transfer_download_timeout_seconds", b"_file_transfer_download_timeout_seconds", "_file_transfer_local_reference_policy", b"_file_transfer_local_reference_policy", "_file_transfer_max_concurrency", b"_file_transfer_max_concurrency", "_file_transfer_max_concurrency_per_host", b"_file_transfer_max_concurrency_per_host", "_file_transfer_multipart_part_retries", b"_file_transfer_multipart_part_retries", "_file_transfer_multipart_threshold_bytes", b"_file_transfer_multipart_threshold_bytes", "_file_transfer_reference_exclude_globs", b"_file_transfer_reference_exclude_globs", "_file_transfer_reference_include_globs", b"_file_transfer_reference_include_globs", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_snapshot_uploads", b"_file_transfer_snapshot_uploads", "_file_transfer_symlink_policy", b"_file_transfer_symlink_policy", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_file_transfer_upload_dedupe", b"_file_transfer_upload_dedupe", "_file_transfer_upload_timeout_seconds", b"_file_transfer_upload_timeout_seconds", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_http_reference_bearer_token", b"_http_reference_bearer_token", "_http_reference_headers", b"_http_reference_headers", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_json_non_finite_policy", b"_json_non_finite_policy", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_metric_redefine_policy", b"_metric_redefine_policy", "_network_buffer", b"_network_buffer", "_noop", b"_noop", "_notebook", b"_notebook", "_oci_password", b"_oci_password", "_oci_username", b"_oci_username", "_offline", b"_offline", "_os", b"_os", "_platform", b"_platform", "_proxies", b"_proxies", "_python", b"_python", "_require_core", b"_require_core", "_runqueue_item_id", b"_runqueue_item_id", "_save_requirements", b"_save_requirements", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_shared", b"_shared", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_stats_sampling_delay_seconds", b"_stats_sampling_delay_seconds", "_stats_warmup_duration_seconds", b"_stats_warmup_duration_seconds", "_stats_warmup_sample_rate_seconds", b"_stats_warmup_sample_rate_seconds", "_sync", b"_sync", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_webdav_password", b"_webdav_password", "_webdav_username", b"_webdav_username", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "console_multipart", b"console_multipart", "credentials_file", b"credentials_file", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "fork_from", b"fork_from", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "http_proxy", b"http_proxy", "https_proxy", b"https_proxy", "identity_token_file", b"identity_token_file", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "notebook_name", b"notebook_name", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resume_from", b"resume_from", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_args", b"_args", "_artifact_upload_max_failures", b"_artifact_upload_max_failures", "_artifacts_cache_hardlinks", b"_artifacts_cache_hardlinks", "_artifacts_cache_max_size_bytes", b"_artifacts_cache_max_size_bytes", "_artifacts_download_batch_size", b"_artifacts_download_batch_size", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_code_path_local", b"_code_path_local", "_colab", b"_colab", "_config_audit", b"_config_audit", "_config_dict_merge", b"_config_dict_merge", "_config_dotted_keys", b"_config_dotted_keys", "_config_exclude_keys", b"_config_exclude_keys", "_config_include_keys", b"_config_include_keys", "_config_interpolate_env", b"_config_interpolate_env", "_config_key_escape", b"_config_key_escape", "_config_list_merge", b"_config_list_merge", "_config_max_bytes", b"_config_max_bytes", "_config_max_value_bytes", b"_config_max_value_bytes", "_config_preserve_order", b"_config_preserve_order", "_config_strict_types", b"_config_strict_types", "_console_carriage_return_newline", b"_console_carriage_return_newline", "_console_disable_dedupe", b"_console_disable_dedupe", "_console_disable_redaction", b"_console_disable_redaction", "_console_max_line_length", b"_console_max_line_length", "_console_max_lines_per_second", b"_console_max_lines_per_second", "_console_redact_patterns", b"_console_redact_patterns", "_console_strip_ansi", b"_console_strip_ansi", "_cuda", b"_cuda", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_update_check", b"_disable_update_check", "_disable_viewer", b"_disable_viewer", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_file_stream_backlog_block", b"_file_stream_backlog_block", "_file_stream_backlog_bytes", b"_file_stream_backlog_bytes", "_file_stream_disable_http2", b"_file_stream_disable_http2", "_file_stream_drain_timeout_policy", b"_file_stream_drain_timeout_policy", "_file_stream_drain_timeout_seconds", b"_file_stream_drain_timeout_seconds", "_file_stream_dry_run_path", b"_file_stream_dry_run_path", "_file_stream_flush_bytes", b"_file_stream_flush_bytes", "_file_stream_flush_lines", b"_file_stream_flush_lines", "_file_stream_heartbeat_seconds", b"_file_stream_heartbeat_seconds", "_file_stream_idle_conn_timeout_seconds", b"_file_stream_idle_conn_timeout_seconds", "_file_stream_keep_alive", b"_file_stream_keep_alive", "_file_stream_max_bytes", b"_file_stream_max_bytes", "_file_stream_max_idle_conns_per_host", b"_file_stream_max_idle_conns_per_host", "_file_stream_max_line_bytes", b"_file_stream_max_line_bytes", "_file_stream_max_request_bytes", b"_file_stream_max_request_bytes", "_file_stream_min_batch_delay_seconds", b"_file_stream_min_batch_delay_seconds", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_stream_transmit_interval_seconds", b"_file_stream_transmit_interval_seconds", "_file_transfer_compress_globs", b"_file_transfer_compress_globs", "_file_transfer_digest_concurrency", b"_file_transfer_digest_concurrency", "_file_transfer_download_chunk_size_bytes", b"_file_transfer_download_chunk_size_bytes", "_file_transfer_download_concurrency", b"_file_transfer_download_concurrency", "_file_transfer_download_timeout_seconds", b"_file_transfer_download_timeout_seconds", "_file_transfer_local_reference_policy", b"_file_transfer_local_reference_policy", "_file_transfer_max_concurrency", b"_file_transfer_max_concurrency", "_file_transfer_max_concurrency_per_host", b"_file_transfer_max_concurrency_per_host", "_file_transfer_multipart_part_retries", b"_file_transfer_multipart_part_retries", "_file_transfer_multipart_threshold_bytes", b"_file_transfer_multipart_threshold_bytes", "_file_transfer_reference_exclude_globs", b"_file_transfer_reference_exclude_globs", "_file_transfer_reference_include_globs", b"_file_transfer_reference_include_globs", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_snapshot_uploads", b"_file_transfer_snapshot_uploads", "_file_transfer_symlink_policy", b"_file_transfer_symlink_policy", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_file_transfer_upload_dedupe", b"_file_transfer_upload_dedupe", "_file_transfer_upload_timeout_seconds", b"_file_transfer_upload_timeout_seconds", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_http_reference_bearer_token", b"_http_reference_bearer_token", "_http_reference_headers", b"_http_reference_headers", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_json_non_finite_policy", b"_json_non_finite_policy", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_metric_redefine_policy", b"_metric_redefine_policy", "_network_buffer", b"_network_buffer", "_noop", b"_noop", "_notebook", b"_notebook", "_oci_password", b"_oci_password", "_oci_username", b"_oci_username", "_offline", b"_offline", "_os", b"_os", "_platform", b"_platform", "_proxies", b"_proxies", "_python", b"_python", "_require_core", b"_require_core", "_runqueue_item_id", b"_runqueue_item_id", "_save_requirements", b"_save_requirements", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_shared", b"_shared", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_stats_sampling_delay_seconds", b"_stats_sampling_delay_seconds", "_stats_warmup_duration_seconds", b"_stats_warmup_duration_seconds", "_stats_warmup_sample_rate_seconds", b"_stats_warmup_sample_rate_seconds", "_sync", b"_sync", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_webdav_password", b"_webdav_password", "_webdav_username", b"_webdav_username", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "console_multipart", b"console_multipart", "credentials_file", b"credentials_file", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "fork_from", b"fork_from", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "http_proxy", b"http_proxy", "https_proxy", b"https_proxy", "identity_token_file", b"identity_token_file", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "notebook_name", b"notebook_name", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resume_from", b"resume_from", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> None: ...
 
 global___Settings = Settings
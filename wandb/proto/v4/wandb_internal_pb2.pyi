@@ -55,6 +55,7 @@ class Record(google.protobuf.message.Message):
     PREEMPTING_FIELD_NUMBER: builtins.int
     NOOP_LINK_ARTIFACT_FIELD_NUMBER: builtins.int
     USE_ARTIFACT_FIELD_NUMBER: builtins.int
+    CONFIG_CHANGE_FIELD_NUMBER: builtins.int
     REQUEST_FIELD_NUMBER: builtins.int
     CONTROL_FIELD_NUMBER: builtins.int
     UUID_FIELD_NUMBER: builtins.int
@@ -104,6 +105,8 @@ class Record(google.protobuf.message.Message):
     @property
     def use_artifact(self) -> global___UseArtifactRecord: ...
     @property
+    def config_change(self) -> global___ConfigChangeRecord: ...
+    @property
     def request(self) -> global___Request:
         """request field does not belong here longterm"""
     @property
@@ -135,14 +138,15 @@ class Record(google.protobuf.message.Message):
         preempting: global___RunPreemptingRecord | None = ...,
         noop_link_artifact: google.protobuf.empty_pb2.Empty | None = ...,
         use_artifact: global___UseArtifactRecord | None = ...,
+        config_change: global___ConfigChangeRecord | None = ...,
         request: global___Request | None = ...,
         control: global___Control | None = ...,
         uuid: builtins.str = ...,
         _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "alert", b"alert", "artifact", b"artifact", "config", b"config", "control", b"control", "exit", b"exit", "files", b"files", "final", b"final", "footer", b"footer", "header", b"header", "history", b"history", "metric", b"metric", "noop_link_artifact", b"noop_link_artifact", "output", b"output", "output_raw", b"output_raw", "preempting", b"preempting", "record_type", b"record_type", "request", b"request", "run", b"run", "stats", b"stats", "summary", b"summary", "tbrecord", b"tbrecord", "telemetry", b"telemetry", "use_artifact", b"use_artifact"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "alert", b"alert", "artifact", b"artifact", "config", b"config", "control", b"control", "exit", b"exit", "files", b"files", "final", b"final", "footer", b"footer", "header", b"header", "history", b"history", "metric", b"metric", "noop_link_artifact", b"noop_link_artifact", "num", b"num", "output", b"output", "output_raw", b"output_raw", "preempting", b"preempting", "record_type", b"record_type", "request", b"request", "run", b"run", "stats", b"stats", "summary", b"summary", "tbrecord", b"tbrecord", "telemetry", b"telemetry", "use_artifact", b"use_artifact", "uuid", b"uuid"]) -> None: ...
-    def WhichOneof(self, oneof_group: typing_extensions.Literal["record_type", b"record_type"]) -> typing_extensions.Literal["history", "summary", "output", "config", "files", "stats", "artifact", "tbrecord", "alert", "telemetry", "metric", "output_raw", "run", "exit", "final", "header", "footer", "preempting", "noop_link_artifact", "use_artifact", "request"] | None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "alert", b"alert", "artifact", b"artifact", "config", b"config", "config_change", b"config_change", "control", b"control", "exit", b"exit", "files", b"files", "final", b"final", "footer", b"footer", "header", b"header", "history", b"history", "metric", b"metric", "noop_link_artifact", b"noop_link_artifact", "output", b"output", "output_raw", b"output_raw", "preempting", b"preempting", "record_type", b"record_type", "request", b"request", "run", b"run", "stats", b"stats", "summary", b"summary", "tbrecord", b"tbrecord", "telemetry", b"telemetry", "use_artifact", b"use_artifact"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "alert", b"alert", "artifact", b"artifact", "config", b"config", "config_change", b"config_change", "control", b"control", "exit", b"exit", "files", b"files", "final", b"final", "footer", b"footer", "header", b"header", "history", b"history", "metric", b"metric", "noop_link_artifact", b"noop_link_artifact", "num", b"num", "output", b"output", "output_raw", b"output_raw", "preempting", b"preempting", "record_type", b"record_type", "request", b"request", "run", b"run", "stats", b"stats", "summary", b"summary", "tbrecord", b"tbrecord", "telemetry", b"telemetry", "use_artifact", b"use_artifact", "uuid", b"uuid"]) -> None: ...
+    def WhichOneof(self, oneof_group: typing_extensions.Literal["record_type", b"record_type"]) -> typing_extensions.Literal["history", "summary", "output", "config", "files", "stats", "artifact", "tbrecord", "alert", "telemetry", "metric", "output_raw", "run", "exit", "final", "header", "footer", "preempting", "noop_link_artifact", "use_artifact", "config_change", "request"] | None: ...
 
 global___Record = Record
 
@@ -352,6 +356,22 @@ class RunRecord(google.protobuf.message.Message):
 
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
+    @typing_extensions.final
+    class ConfigEnvEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.str
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
     RUN_ID_FIELD_NUMBER: builtins.int
     ENTITY_FIELD_NUMBER: builtins.int
     PROJECT_FIELD_NUMBER: builtins.int
@@ -373,6 +393,7 @@ class RunRecord(google.protobuf.message.Message):
     RUNTIME_FIELD_NUMBER: builtins.int
     GIT_FIELD_NUMBER: builtins.int
     FORKED_FIELD_NUMBER: builtins.int
+    CONFIG_ENV_FIELD_NUMBER: builtins.int
     _INFO_FIELD_NUMBER: builtins.int
     run_id: builtins.str
     entity: builtins.str
@@ -403,6 +424,14 @@ class RunRecord(google.protobuf.message.Message):
     def git(self) -> global___GitRepoRecord: ...
     forked: builtins.bool
     @property
+    def config_env(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]:
+        """Environment variables of the user process that the config references
+        as "${env:NAME}", if _config_interpolate_env is set.
+
+        They're resolved before the record is written to the transaction log,
+        and then cleared.
+        """
+    @property
     def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
     def __init__(
         self,
@@ -428,10 +457,11 @@ class RunRecord(google.protobuf.message.Message):
         runtime: builtins.int = ...,
         git: global___GitRepoRecord | None = ...,
         forked: builtins.bool = ...,
+        config_env: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
         _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "config", b"config", "git", b"git", "settings", b"settings", "start_time", b"start_time", "summary", b"summary", "telemetry", b"telemetry"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "config", b"config", "display_name", b"display_name", "entity", b"entity", "forked", b"forked", "git", b"git", "host", b"host", "job_type", b"job_type", "notes", b"notes", "project", b"project", "resumed", b"resumed", "run_group", b"run_group", "run_id", b"run_id", "runtime", b"runtime", "settings", b"settings", "start_time", b"start_time", "starting_step", b"starting_step", "storage_id", b"storage_id", "summary", b"summary", "sweep_id", b"sweep_id", "tags", b"tags", "telemetry", b"telemetry"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "config", b"config", "config_env", b"config_env", "display_name", b"display_name", "entity", b"entity", "forked", b"forked", "git", b"git", "host", b"host", "job_type", b"job_type", "notes", b"notes", "project", b"project", "resumed", b"resumed", "run_group", b"run_group", "run_id", b"run_id", "runtime", b"runtime", "settings", b"settings", "start_time", b"start_time", "starting_step", b"starting_step", "storage_id", b"storage_id", "summary", b"summary", "sweep_id", b"sweep_id", "tags", b"tags", "telemetry", b"telemetry"]) -> None: ...
 
 global___RunRecord = RunRecord
 
@@ -845,6 +875,8 @@ class MetricRecord(google.protobuf.message.Message):
     SUMMARY_FIELD_NUMBER: builtins.int
     GOAL_FIELD_NUMBER: builtins.int
     _CONTROL_FIELD_NUMBER: builtins.int
+    _EXPRESSION_FIELD_NUMBER: builtins.int
+    _SAMPLE_EVERY_FIELD_NUMBER: builtins.int
     _INFO_FIELD_NUMBER: builtins.int
     name: builtins.str
     """only name or globname is set"""
@@ -862,6 +894,18 @@ class MetricRecord(google.protobuf.message.Message):
     goal: global___MetricRecord.MetricGoal.ValueType
     @property
     def _control(self) -> global___MetricControl: ...
+    _expression: builtins.str
+    """An arithmetic expression over other metrics that wandb-core evaluates
+    to log this metric in the same step as its inputs.
+
+    Not stored in the run config.
+    """
+    _sample_every: builtins.int
+    """Keep only every Nth value of this metric in the uploaded history.
+
+    The summary is still computed from every value. Values of 0 and 1
+    keep all values. Not stored in the run config.
+    """
     @property
     def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
     def __init__(
@@ -875,10 +919,12 @@ class MetricRecord(google.protobuf.message.Message):
         summary: global___MetricSummary | None = ...,
         goal: global___MetricRecord.MetricGoal.ValueType = ...,
         _control: global___MetricControl | None = ...,
+        _expression: builtins.str = ...,
+        _sample_every: builtins.int = ...,
         _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["_control", b"_control", "_info", b"_info", "options", b"options", "summary", b"summary"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_control", b"_control", "_info", b"_info", "glob_name", b"glob_name", "goal", b"goal", "name", b"name", "options", b"options", "step_metric", b"step_metric", "step_metric_index", b"step_metric_index", "summary", b"summary"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_control", b"_control", "_expression", b"_expression", "_info", b"_info", "_sample_every", b"_sample_every", "glob_name", b"glob_name", "goal", b"goal", "name", b"name", "options", b"options", "step_metric", b"step_metric", "step_metric_index", b"step_metric_index", "summary", b"summary"]) -> None: ...
 
 global___MetricRecord = MetricRecord
 
@@ -919,13 +965,19 @@ class MetricControl(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
     OVERWRITE_FIELD_NUMBER: builtins.int
+    REMOVE_FIELD_NUMBER: builtins.int
     overwrite: builtins.bool
+    remove: builtins.bool
+    """Remove the definition of the metric or glob, resetting its step
+    metric, summary and other options to their defaults.
+    """
     def __init__(
         self,
         *,
         overwrite: builtins.bool = ...,
+        remove: builtins.bool = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["overwrite", b"overwrite"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["overwrite", b"overwrite", "remove", b"remove"]) -> None: ...
 
 global___MetricControl = MetricControl
 
@@ -940,6 +992,7 @@ class MetricSummary(google.protobuf.message.Message):
     LAST_FIELD_NUMBER: builtins.int
     NONE_FIELD_NUMBER: builtins.int
     COPY_FIELD_NUMBER: builtins.int
+    FIRST_FIELD_NUMBER: builtins.int
     min: builtins.bool
     max: builtins.bool
     mean: builtins.bool
@@ -947,6 +1000,7 @@ class MetricSummary(google.protobuf.message.Message):
     last: builtins.bool
     none: builtins.bool
     copy: builtins.bool
+    first: builtins.bool
     def __init__(
         self,
         *,
@@ -957,8 +1011,9 @@ class MetricSummary(google.protobuf.message.Message):
         last: builtins.bool = ...,
         none: builtins.bool = ...,
         copy: builtins.bool = ...,
+        first: builtins.bool = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["best", b"best", "copy", b"copy", "last", b"last", "max", b"max", "mean", b"mean", "min", b"min", "none", b"none"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["best", b"best", "copy", b"copy", "first", b"first", "last", b"last", "max", b"max", "mean", b"mean", "min", b"min", "none", b"none"]) -> None: ...
 
 global___MetricSummary = MetricSummary
 
@@ -991,6 +1046,71 @@ class ConfigRecord(google.protobuf.message.Message):
 
 global___ConfigRecord = ConfigRecord
 
+@typing_extensions.final
+class ConfigChangeRecord(google.protobuf.message.Message):
+    """
+    ConfigChangeRecord: audit trail of changes made to the run config
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    CHANGE_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    @property
+    def change(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___ConfigChange]: ...
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
+    def __init__(
+        self,
+        *,
+        change: collections.abc.Iterable[global___ConfigChange] | None = ...,
+        _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "change", b"change"]) -> None: ...
+
+global___ConfigChangeRecord = ConfigChangeRecord
+
+@typing_extensions.final
+class ConfigChange(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    NESTED_KEY_FIELD_NUMBER: builtins.int
+    OLD_VALUE_JSON_FIELD_NUMBER: builtins.int
+    NEW_VALUE_JSON_FIELD_NUMBER: builtins.int
+    TIMESTAMP_FIELD_NUMBER: builtins.int
+    SOURCE_FIELD_NUMBER: builtins.int
+    RECORD_NUM_FIELD_NUMBER: builtins.int
+    @property
+    def nested_key(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """Path of the value that changed."""
+    old_value_json: builtins.str
+    """Value before the change, or empty if it was added."""
+    new_value_json: builtins.str
+    """Value after the change, or empty if it was removed."""
+    @property
+    def timestamp(self) -> google.protobuf.timestamp_pb2.Timestamp: ...
+    source: builtins.str
+    """What made the change, such as "config" or "resume"."""
+    record_num: builtins.int
+    """Number of the record in the transaction log that made the change,
+    or 0 if there was none.
+    """
+    def __init__(
+        self,
+        *,
+        nested_key: collections.abc.Iterable[builtins.str] | None = ...,
+        old_value_json: builtins.str = ...,
+        new_value_json: builtins.str = ...,
+        timestamp: google.protobuf.timestamp_pb2.Timestamp | None = ...,
+        source: builtins.str = ...,
+        record_num: builtins.int = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["timestamp", b"timestamp"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["nested_key", b"nested_key", "new_value_json", b"new_value_json", "old_value_json", b"old_value_json", "record_num", b"record_num", "source", b"source", "timestamp", b"timestamp"]) -> None: ...
+
+global___ConfigChange = ConfigChange
+
 @typing_extensions.final
 class ConfigItem(google.protobuf.message.Message):
     """
@@ -1003,21 +1123,71 @@ class ConfigItem(google.protobuf.message.Message):
 
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
+    class _DictMerge:
+        ValueType = typing.NewType("ValueType", builtins.int)
+        V: typing_extensions.TypeAlias = ValueType
+
+    class _DictMergeEnumTypeWrapper(google.protobuf.internal.enum_type_wrapper._EnumTypeWrapper[ConfigItem._DictMerge.ValueType], builtins.type):
+        DESCRIPTOR: google.protobuf.descriptor.EnumDescriptor
+        DICT_MERGE_DEFAULT: ConfigItem._DictMerge.ValueType  # 0
+        """Use the _config_dict_merge setting."""
+        DICT_MERGE_DEEP: ConfigItem._DictMerge.ValueType  # 1
+        """Update existing keys recursively, keeping ones not in the value."""
+        DICT_MERGE_REPLACE: ConfigItem._DictMerge.ValueType  # 2
+        """Replace the existing dict."""
+
+    class DictMerge(_DictMerge, metaclass=_DictMergeEnumTypeWrapper): ...
+    DICT_MERGE_DEFAULT: ConfigItem.DictMerge.ValueType  # 0
+    """Use the _config_dict_merge setting."""
+    DICT_MERGE_DEEP: ConfigItem.DictMerge.ValueType  # 1
+    """Update existing keys recursively, keeping ones not in the value."""
+    DICT_MERGE_REPLACE: ConfigItem.DictMerge.ValueType  # 2
+    """Replace the existing dict."""
+
+    class _ListMerge:
+        ValueType = typing.NewType("ValueType", builtins.int)
+        V: typing_extensions.TypeAlias = ValueType
+
+    class _ListMergeEnumTypeWrapper(google.protobuf.internal.enum_type_wrapper._EnumTypeWrapper[ConfigItem._ListMerge.ValueType], builtins.type):
+        DESCRIPTOR: google.protobuf.descriptor.EnumDescriptor
+        LIST_MERGE_DEFAULT: ConfigItem._ListMerge.ValueType  # 0
+        """Use the _config_list_merge setting."""
+        LIST_MERGE_REPLACE: ConfigItem._ListMerge.ValueType  # 1
+        """Replace the existing list."""
+        LIST_MERGE_APPEND: ConfigItem._ListMerge.ValueType  # 2
+        """Append the value to the existing list."""
+
+    class ListMerge(_ListMerge, metaclass=_ListMergeEnumTypeWrapper): ...
+    LIST_MERGE_DEFAULT: ConfigItem.ListMerge.ValueType  # 0
+    """Use the _config_list_merge setting."""
+    LIST_MERGE_REPLACE: ConfigItem.ListMerge.ValueType  # 1
+    """Replace the existing list."""
+    LIST_MERGE_APPEND: ConfigItem.ListMerge.ValueType  # 2
+    """Append the value to the existing list."""
+
     KEY_FIELD_NUMBER: builtins.int
     NESTED_KEY_FIELD_NUMBER: builtins.int
     VALUE_JSON_FIELD_NUMBER: builtins.int
+    DICT_MERGE_FIELD_NUMBER: builtins.int
+    LIST_MERGE_FIELD_NUMBER: builtins.int
     key: builtins.str
     @property
     def nested_key(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]: ...
     value_json: builtins.str
+    dict_merge: global___ConfigItem.DictMerge.ValueType
+    """How to combine a dict value with an existing dict."""
+    list_merge: global___ConfigItem.ListMerge.ValueType
+    """How to combine a list value with an existing list."""
     def __init__(
         self,
         *,
         key: builtins.str = ...,
         nested_key: collections.abc.Iterable[builtins.str] | None = ...,
         value_json: builtins.str = ...,
+        dict_merge: global___ConfigItem.DictMerge.ValueType = ...,
+        list_merge: global___ConfigItem.ListMerge.ValueType = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "nested_key", b"nested_key", "value_json", b"value_json"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["dict_merge", b"dict_merge", "key", b"key", "list_merge", b"list_merge", "nested_key", b"nested_key", "value_json", b"value_json"]) -> None: ...
 
 global___ConfigItem = ConfigItem
 
@@ -1676,6 +1846,11 @@ class Request(google.protobuf.message.Message):
     SYNC_FIELD_NUMBER: builtins.int
     JOB_INPUT_FIELD_NUMBER: builtins.int
     LINK_ARTIFACT_FIELD_NUMBER: builtins.int
+    SYSTEM_METRICS_BURST_FIELD_NUMBER: builtins.int
+    FILE_STREAM_METRICS_FIELD_NUMBER: builtins.int
+    FILE_STREAM_TRANSMIT_INTERVAL_FIELD_NUMBER: builtins.int
+    STEP_ACK_FIELD_NUMBER: builtins.int
+    CONFIG_HISTORY_FIELD_NUMBER: builtins.int
     TEST_INJECT_FIELD_NUMBER: builtins.int
     @property
     def stop_status(self) -> global___StopStatusRequest: ...
@@ -1746,6 +1921,16 @@ class Request(google.protobuf.message.Message):
     @property
     def link_artifact(self) -> global___LinkArtifactRequest: ...
     @property
+    def system_metrics_burst(self) -> global___SystemMetricsBurstRequest: ...
+    @property
+    def file_stream_metrics(self) -> global___FileStreamMetricsRequest: ...
+    @property
+    def file_stream_transmit_interval(self) -> global___FileStreamTransmitIntervalRequest: ...
+    @property
+    def step_ack(self) -> global___StepAckRequest: ...
+    @property
+    def config_history(self) -> global___ConfigHistoryRequest: ...
+    @property
     def test_inject(self) -> global___TestInjectRequest: ...
     def __init__(
         self,
@@ -1784,11 +1969,16 @@ class Request(google.protobuf.message.Message):
         sync: global___SyncRequest | None = ...,
         job_input: global___JobInputRequest | None = ...,
         link_artifact: global___LinkArtifactRequest | None = ...,
+        system_metrics_burst: global___SystemMetricsBurstRequest | None = ...,
+        file_stream_metrics: global___FileStreamMetricsRequest | None = ...,
+        file_stream_transmit_interval: global___FileStreamTransmitIntervalRequest | None = ...,
+        step_ack: global___StepAckRequest | None = ...,
+        config_history: global___ConfigHistoryRequest | None = ...,
         test_inject: global___TestInjectRequest | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["attach", b"attach", "cancel", b"cancel", "check_version", b"check_version", "defer", b"defer", "download_artifact", b"download_artifact", "get_summary", b"get_summary", "get_system_metrics", b"get_system_metrics", "internal_messages", b"internal_messages", "job_info", b"job_info", "job_input", b"job_input", "keepalive", b"keepalive", "link_artifact", b"link_artifact", "log_artifact", b"log_artifact", "login", b"login", "metadata", b"metadata", "network_status", b"network_status", "partial_history", b"partial_history", "pause", b"pause", "poll_exit", b"poll_exit", "python_packages", b"python_packages", "request_type", b"request_type", "resume", b"resume", "run_start", b"run_start", "run_status", b"run_status", "sampled_history", b"sampled_history", "sender_mark", b"sender_mark", "sender_read", b"sender_read", "server_info", b"server_info", "shutdown", b"shutdown", "status", b"status", "status_report", b"status_report", "stop_status", b"stop_status", "summary_record", b"summary_record", "sync", b"sync", "telemetry_record", b"telemetry_record", "test_inject", b"test_inject"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["attach", b"attach", "cancel", b"cancel", "check_version", b"check_version", "defer", b"defer", "download_artifact", b"download_artifact", "get_summary", b"get_summary", "get_system_metrics", b"get_system_metrics", "internal_messages", b"internal_messages", "job_info", b"job_info", "job_input", b"job_input", "keepalive", b"keepalive", "link_artifact", b"link_artifact", "log_artifact", b"log_artifact", "login", b"login", "metadata", b"metadata", "network_status", b"network_status", "partial_history", b"partial_history", "pause", b"pause", "poll_exit", b"poll_exit", "python_packages", b"python_packages", "request_type", b"request_type", "resume", b"resume", "run_start", b"run_start", "run_status", b"run_status", "sampled_history", b"sampled_history", "sender_mark", b"sender_mark", "sender_read", b"sender_read", "server_info", b"server_info", "shutdown", b"shutdown", "status", b"status", "status_report", b"status_report", "stop_status", b"stop_status", "summary_record", b"summary_record", "sync", b"sync", "telemetry_record", b"telemetry_record", "test_inject", b"test_inject"]) -> None: ...
-    def WhichOneof(self, oneof_group: typing_extensions.Literal["request_type", b"request_type"]) -> typing_extensions.Literal["stop_status", "network_status", "defer", "get_summary", "login", "pause", "resume", "poll_exit", "sampled_history", "partial_history", "run_start", "check_version", "log_artifact", "download_artifact", "keepalive", "run_status", "cancel", "metadata", "internal_messages", "python_packages", "shutdown", "attach", "status", "server_info", "sender_mark", "sender_read", "status_report", "summary_record", "telemetry_record", "job_info", "get_system_metrics", "sync", "job_input", "link_artifact", "test_inject"] | None: ...
+    def HasField(self, field_name: typing_extensions.Literal["attach", b"attach", "cancel", b"cancel", "check_version", b"check_version", "config_history", b"config_history", "defer", b"defer", "download_artifact", b"download_artifact", "file_stream_metrics", b"file_stream_metrics", "file_stream_transmit_interval", b"file_stream_transmit_interval", "get_summary", b"get_summary", "get_system_metrics", b"get_system_metrics", "internal_messages", b"internal_messages", "job_info", b"job_info", "job_input", b"job_input", "keepalive", b"keepalive", "link_artifact", b"link_artifact", "log_artifact", b"log_artifact", "login", b"login", "metadata", b"metadata", "network_status", b"network_status", "partial_history", b"partial_history", "pause", b"pause", "poll_exit", b"poll_exit", "python_packages", b"python_packages", "request_type", b"request_type", "resume", b"resume", "run_start", b"run_start", "run_status", b"run_status", "sampled_history", b"sampled_history", "sender_mark", b"sender_mark", "sender_read", b"sender_read", "server_info", b"server_info", "shutdown", b"shutdown", "status", b"status", "status_report", b"status_report", "step_ack", b"step_ack", "stop_status", b"stop_status", "summary_record", b"summary_record", "sync", b"sync", "system_metrics_burst", b"system_metrics_burst", "telemetry_record", b"telemetry_record", "test_inject", b"test_inject"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["attach", b"attach", "cancel", b"cancel", "check_version", b"check_version", "config_history", b"config_history", "defer", b"defer", "download_artifact", b"download_artifact", "file_stream_metrics", b"file_stream_metrics", "file_stream_transmit_interval", b"file_stream_transmit_interval", "get_summary", b"get_summary", "get_system_metrics", b"get_system_metrics", "internal_messages", b"internal_messages", "job_info", b"job_info", "job_input", b"job_input", "keepalive", b"keepalive", "link_artifact", b"link_artifact", "log_artifact", b"log_artifact", "login", b"login", "metadata", b"metadata", "network_status", b"network_status", "partial_history", b"partial_history", "pause", b"pause", "poll_exit", b"poll_exit", "python_packages", b"python_packages", "request_type", b"request_type", "resume", b"resume", "run_start", b"run_start", "run_status", b"run_status", "sampled_history", b"sampled_history", "sender_mark", b"sender_mark", "sender_read", b"sender_read", "server_info", b"server_info", "shutdown", b"shutdown", "status", b"status", "status_report", b"status_report", "step_ack", b"step_ack", "stop_status", b"stop_status", "summary_record", b"summary_record", "sync", b"sync", "system_metrics_burst", b"system_metrics_burst", "telemetry_record", b"telemetry_record", "test_inject", b"test_inject"]) -> None: ...
+    def WhichOneof(self, oneof_group: typing_extensions.Literal["request_type", b"request_type"]) -> typing_extensions.Literal["stop_status", "network_status", "defer", "get_summary", "login", "pause", "resume", "poll_exit", "sampled_history", "partial_history", "run_start", "check_version", "log_artifact", "download_artifact", "keepalive", "run_status", "cancel", "metadata", "internal_messages", "python_packages", "shutdown", "attach", "status", "server_info", "sender_mark", "sender_read", "status_report", "summary_record", "telemetry_record", "job_info", "get_system_metrics", "sync", "job_input", "link_artifact", "system_metrics_burst", "file_stream_metrics", "file_stream_transmit_interval", "step_ack", "config_history", "test_inject"] | None: ...
 
 global___Request = Request
 
@@ -1822,6 +2012,9 @@ class Response(google.protobuf.message.Message):
     GET_SYSTEM_METRICS_RESPONSE_FIELD_NUMBER: builtins.int
     SYNC_RESPONSE_FIELD_NUMBER: builtins.int
     LINK_ARTIFACT_RESPONSE_FIELD_NUMBER: builtins.int
+    FILE_STREAM_METRICS_RESPONSE_FIELD_NUMBER: builtins.int
+    STEP_ACK_RESPONSE_FIELD_NUMBER: builtins.int
+    CONFIG_HISTORY_RESPONSE_FIELD_NUMBER: builtins.int
     TEST_INJECT_RESPONSE_FIELD_NUMBER: builtins.int
     @property
     def keepalive_response(self) -> global___KeepaliveResponse: ...
@@ -1868,6 +2061,12 @@ class Response(google.protobuf.message.Message):
     @property
     def link_artifact_response(self) -> global___LinkArtifactResponse: ...
     @property
+    def file_stream_metrics_response(self) -> global___FileStreamMetricsResponse: ...
+    @property
+    def step_ack_response(self) -> global___StepAckResponse: ...
+    @property
+    def config_history_response(self) -> global___ConfigHistoryResponse: ...
+    @property
     def test_inject_response(self) -> global___TestInjectResponse: ...
     def __init__(
         self,
@@ -1894,11 +2093,14 @@ class Response(google.protobuf.message.Message):
         get_system_metrics_response: global___GetSystemMetricsResponse | None = ...,
         sync_response: global___SyncResponse | None = ...,
         link_artifact_response: global___LinkArtifactResponse | None = ...,
+        file_stream_metrics_response: global___FileStreamMetricsResponse | None = ...,
+        step_ack_response: global___StepAckResponse | None = ...,
+        config_history_response: global___ConfigHistoryResponse | None = ...,
         test_inject_response: global___TestInjectResponse | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["attach_response", b"attach_response", "cancel_response", b"cancel_response", "check_version_response", b"check_version_response", "download_artifact_response", b"download_artifact_response", "get_summary_response", b"get_summary_response", "get_system_metrics_response", b"get_system_metrics_response", "internal_messages_response", b"internal_messages_response", "job_info_response", b"job_info_response", "keepalive_response", b"keepalive_response", "link_artifact_response", b"link_artifact_response", "log_artifact_response", b"log_artifact_response", "login_response", b"login_response", "network_status_response", b"network_status_response", "poll_exit_response", b"poll_exit_response", "response_type", b"response_type", "run_start_response", b"run_start_response", "run_status_response", b"run_status_response", "sampled_history_response", b"sampled_history_response", "server_info_response", b"server_info_response", "shutdown_response", b"shutdown_response", "status_response", b"status_response", "stop_status_response", b"stop_status_response", "sync_response", b"sync_response", "test_inject_response", b"test_inject_response"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["attach_response", b"attach_response", "cancel_response", b"cancel_response", "check_version_response", b"check_version_response", "download_artifact_response", b"download_artifact_response", "get_summary_response", b"get_summary_response", "get_system_metrics_response", b"get_system_metrics_response", "internal_messages_response", b"internal_messages_response", "job_info_response", b"job_info_response", "keepalive_response", b"keepalive_response", "link_artifact_response", b"link_artifact_response", "log_artifact_response", b"log_artifact_response", "login_response", b"login_response", "network_status_response", b"network_status_response", "poll_exit_response", b"poll_exit_response", "response_type", b"response_type", "run_start_response", b"run_start_response", "run_status_response", b"run_status_response", "sampled_history_response", b"sampled_history_response", "server_info_response", b"server_info_response", "shutdown_response", b"shutdown_response", "status_response", b"status_response", "stop_status_response", b"stop_status_response", "sync_response", b"sync_response", "test_inject_response", b"test_inject_response"]) -> None: ...
-    def WhichOneof(self, oneof_group: typing_extensions.Literal["response_type", b"response_type"]) -> typing_extensions.Literal["keepalive_response", "stop_status_response", "network_status_response", "login_response", "get_summary_response", "poll_exit_response", "sampled_history_response", "run_start_response", "check_version_response", "log_artifact_response", "download_artifact_response", "run_status_response", "cancel_response", "internal_messages_response", "shutdown_response", "attach_response", "status_response", "server_info_response", "job_info_response", "get_system_metrics_response", "sync_response", "link_artifact_response", "test_inject_response"] | None: ...
+    def HasField(self, field_name: typing_extensions.Literal["attach_response", b"attach_response", "cancel_response", b"cancel_response", "check_version_response", b"check_version_response", "config_history_response", b"config_history_response", "download_artifact_response", b"download_artifact_response", "file_stream_metrics_response", b"file_stream_metrics_response", "get_summary_response", b"get_summary_response", "get_system_metrics_response", b"get_system_metrics_response", "internal_messages_response", b"internal_messages_response", "job_info_response", b"job_info_response", "keepalive_response", b"keepalive_response", "link_artifact_response", b"link_artifact_response", "log_artifact_response", b"log_artifact_response", "login_response", b"login_response", "network_status_response", b"network_status_response", "poll_exit_response", b"poll_exit_response", "response_type", b"response_type", "run_start_response", b"run_start_response", "run_status_response", b"run_status_response", "sampled_history_response", b"sampled_history_response", "server_info_response", b"server_info_response", "shutdown_response", b"shutdown_response", "status_response", b"status_response", "step_ack_response", b"step_ack_response", "stop_status_response", b"stop_status_response", "sync_response", b"sync_response", "test_inject_response", b"test_inject_response"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["attach_response", b"attach_response", "cancel_response", b"cancel_response", "check_version_response", b"check_version_response", "config_history_response", b"config_history_response", "download_artifact_response", b"download_artifact_response", "file_stream_metrics_response", b"file_stream_metrics_response", "get_summary_response", b"get_summary_response", "get_system_metrics_response", b"get_system_metrics_response", "internal_messages_response", b"internal_messages_response", "job_info_response", b"job_info_response", "keepalive_response", b"keepalive_response", "link_artifact_response", b"link_artifact_response", "log_artifact_response", b"log_artifact_response", "login_response", b"login_response", "network_status_response", b"network_status_response", "poll_exit_response", b"poll_exit_response", "response_type", b"response_type", "run_start_response", b"run_start_response", "run_status_response", b"run_status_response", "sampled_history_response", b"sampled_history_response", "server_info_response", b"server_info_response", "shutdown_response", b"shutdown_response", "status_response", b"status_response", "step_ack_response", b"step_ack_response", "stop_status_response", b"stop_status_response", "sync_response", b"sync_response", "test_inject_response", b"test_inject_response"]) -> None: ...
+    def WhichOneof(self, oneof_group: typing_extensions.Literal["response_type", b"response_type"]) -> typing_extensions.Literal["keepalive_response", "stop_status_response", "network_status_response", "login_response", "get_summary_response", "poll_exit_response", "sampled_history_response", "run_start_response", "check_version_response", "log_artifact_response", "download_artifact_response", "run_status_response", "cancel_response", "internal_messages_response", "shutdown_response", "attach_response", "status_response", "server_info_response", "job_info_response", "get_system_metrics_response", "sync_response", "link_artifact_response", "file_stream_metrics_response", "step_ack_response", "config_history_response", "test_inject_response"] | None: ...
 
 global___Response = Response
 
@@ -2190,6 +2392,41 @@ class GetSystemMetricsResponse(google.protobuf.message.Message):
 
 global___GetSystemMetricsResponse = GetSystemMetricsResponse
 
+@typing_extensions.final
+class SystemMetricsBurstRequest(google.protobuf.message.Message):
+    """
+    SystemMetricsBurstRequest: temporarily sample system metrics at a high
+    frequency, storing every sample in the system metrics buffer
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    SAMPLE_RATE_SECONDS_FIELD_NUMBER: builtins.int
+    DURATION_SECONDS_FIELD_NUMBER: builtins.int
+    ASSETS_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    sample_rate_seconds: builtins.float
+    """Sampling interval during the burst."""
+    duration_seconds: builtins.float
+    """Duration of the burst."""
+    @property
+    def assets(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """Names of the assets to sample, like "gpu". All assets if empty."""
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
+    def __init__(
+        self,
+        *,
+        sample_rate_seconds: builtins.float = ...,
+        duration_seconds: builtins.float = ...,
+        assets: collections.abc.Iterable[builtins.str] | None = ...,
+        _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "assets", b"assets", "duration_seconds", b"duration_seconds", "sample_rate_seconds", b"sample_rate_seconds"]) -> None: ...
+
+global___SystemMetricsBurstRequest = SystemMetricsBurstRequest
+
 @typing_extensions.final
 class StatusRequest(google.protobuf.message.Message):
     """
@@ -2309,6 +2546,239 @@ class HttpResponse(google.protobuf.message.Message):
 
 global___HttpResponse = HttpResponse
 
+@typing_extensions.final
+class FileStreamMetricsRequest(google.protobuf.message.Message):
+    """
+    FileStreamMetricsRequest: debug statistics about the run's filestream
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    _INFO_FIELD_NUMBER: builtins.int
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
+    def __init__(
+        self,
+        *,
+        _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> None: ...
+
+global___FileStreamMetricsRequest = FileStreamMetricsRequest
+
+@typing_extensions.final
+class FileStreamMetricsResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    @typing_extensions.final
+    class FilesEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        @property
+        def value(self) -> global___FileStreamFileMetrics: ...
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: global___FileStreamFileMetrics | None = ...,
+        ) -> None: ...
+        def HasField(self, field_name: typing_extensions.Literal["value", b"value"]) -> builtins.bool: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
+    REQUESTS_FIELD_NUMBER: builtins.int
+    FAILED_REQUESTS_FIELD_NUMBER: builtins.int
+    RETRIES_FIELD_NUMBER: builtins.int
+    BYTES_SENT_FIELD_NUMBER: builtins.int
+    MAX_REQUEST_BYTES_FIELD_NUMBER: builtins.int
+    MEAN_LATENCY_SECONDS_FIELD_NUMBER: builtins.int
+    MAX_LATENCY_SECONDS_FIELD_NUMBER: builtins.int
+    DROPPED_LINES_FIELD_NUMBER: builtins.int
+    DEFERRED_LINES_FIELD_NUMBER: builtins.int
+    FILES_FIELD_NUMBER: builtins.int
+    DRAIN_SECONDS_FIELD_NUMBER: builtins.int
+    requests: builtins.int
+    """Number of successful requests."""
+    failed_requests: builtins.int
+    """Number of requests that failed after exhausting retries."""
+    retries: builtins.int
+    """Number of times a request was retried."""
+    bytes_sent: builtins.int
+    """Total size of the bodies of successful requests."""
+    max_request_bytes: builtins.int
+    """Size of the largest request body sent."""
+    mean_latency_seconds: builtins.float
+    """Average and maximum time to complete a successful request."""
+    max_latency_seconds: builtins.float
+    dropped_lines: builtins.int
+    """Number of lines not uploaded because they were too large."""
+    deferred_lines: builtins.int
+    """Number of lines spilled to disk to be sent later."""
+    @property
+    def files(self) -> google.protobuf.internal.containers.MessageMap[builtins.str, global___FileStreamFileMetrics]:
+        """Lines and bytes sent for each file, such as wandb-history.jsonl."""
+    drain_seconds: builtins.float
+    """Time taken to send remaining data when the run finished,
+    or 0 if it has not finished.
+    """
+    def __init__(
+        self,
+        *,
+        requests: builtins.int = ...,
+        failed_requests: builtins.int = ...,
+        retries: builtins.int = ...,
+        bytes_sent: builtins.int = ...,
+        max_request_bytes: builtins.int = ...,
+        mean_latency_seconds: builtins.float = ...,
+        max_latency_seconds: builtins.float = ...,
+        dropped_lines: builtins.int = ...,
+        deferred_lines: builtins.int = ...,
+        files: collections.abc.Mapping[builtins.str, global___FileStreamFileMetrics] | None = ...,
+        drain_seconds: builtins.float = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["bytes_sent", b"bytes_sent", "deferred_lines", b"deferred_lines", "drain_seconds", b"drain_seconds", "dropped_lines", b"dropped_lines", "failed_requests", b"failed_requests", "files", b"files", "max_latency_seconds", b"max_latency_seconds", "max_request_bytes", b"max_request_bytes", "mean_latency_seconds", b"mean_latency_seconds", "requests", b"requests", "retries", b"retries"]) -> None: ...
+
+global___FileStreamMetricsResponse = FileStreamMetricsResponse
+
+@typing_extensions.final
+class ConfigHistoryRequest(google.protobuf.message.Message):
+    """
+    ConfigHistoryRequest: debug request for the changes made to the config
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    _INFO_FIELD_NUMBER: builtins.int
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
+    def __init__(
+        self,
+        *,
+        _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> None: ...
+
+global___ConfigHistoryRequest = ConfigHistoryRequest
+
+@typing_extensions.final
+class ConfigHistoryResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    CHANGE_FIELD_NUMBER: builtins.int
+    DROPPED_FIELD_NUMBER: builtins.int
+    @property
+    def change(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___ConfigChange]:
+        """Changes in the order they were made, up to a limit."""
+    dropped: builtins.int
+    """Number of older changes that were not kept."""
+    def __init__(
+        self,
+        *,
+        change: collections.abc.Iterable[global___ConfigChange] | None = ...,
+        dropped: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["change", b"change", "dropped", b"dropped"]) -> None: ...
+
+global___ConfigHistoryResponse = ConfigHistoryResponse
+
+@typing_extensions.final
+class FileStreamFileMetrics(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    LINES_FIELD_NUMBER: builtins.int
+    BYTES_FIELD_NUMBER: builtins.int
+    lines: builtins.int
+    bytes: builtins.int
+    def __init__(
+        self,
+        *,
+        lines: builtins.int = ...,
+        bytes: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["bytes", b"bytes", "lines", b"lines"]) -> None: ...
+
+global___FileStreamFileMetrics = FileStreamFileMetrics
+
+@typing_extensions.final
+class FileStreamTransmitIntervalRequest(google.protobuf.message.Message):
+    """
+    FileStreamTransmitIntervalRequest: change the minimum time between
+    filestream requests during a run
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    TRANSMIT_INTERVAL_SECONDS_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    transmit_interval_seconds: builtins.float
+    """The new interval, or 0 to restore the default."""
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
+    def __init__(
+        self,
+        *,
+        transmit_interval_seconds: builtins.float = ...,
+        _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "transmit_interval_seconds", b"transmit_interval_seconds"]) -> None: ...
+
+global___FileStreamTransmitIntervalRequest = FileStreamTransmitIntervalRequest
+
+@typing_extensions.final
+class StepAckRequest(google.protobuf.message.Message):
+    """
+    StepAckRequest: wait until a history step is accepted by the server
+
+    The response is sent once the step and all history logged before it
+    have been uploaded. If the step has not been flushed yet, it is
+    flushed first.
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    STEP_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    step: builtins.int
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
+    def __init__(
+        self,
+        *,
+        step: builtins.int = ...,
+        _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "step", b"step"]) -> None: ...
+
+global___StepAckRequest = StepAckRequest
+
+@typing_extensions.final
+class StepAckResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ACCEPTED_FIELD_NUMBER: builtins.int
+    STEP_FIELD_NUMBER: builtins.int
+    accepted: builtins.bool
+    """Whether the server accepted the step. This is false if the run
+    finished or uploads failed before the step was sent, or if the
+    run is offline.
+    """
+    step: builtins.int
+    def __init__(
+        self,
+        *,
+        accepted: builtins.bool = ...,
+        step: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["accepted", b"accepted", "step", b"step"]) -> None: ...
+
+global___StepAckResponse = StepAckResponse
+
 @typing_extensions.final
 class InternalMessagesRequest(google.protobuf.message.Message):
     """
@@ -2707,17 +3177,23 @@ class FilePusherStats(google.protobuf.message.Message):
     UPLOADED_BYTES_FIELD_NUMBER: builtins.int
     TOTAL_BYTES_FIELD_NUMBER: builtins.int
     DEDUPED_BYTES_FIELD_NUMBER: builtins.int
+    DOWNLOADED_BYTES_FIELD_NUMBER: builtins.int
+    TOTAL_DOWNLOAD_BYTES_FIELD_NUMBER: builtins.int
     uploaded_bytes: builtins.int
     total_bytes: builtins.int
     deduped_bytes: builtins.int
+    downloaded_bytes: builtins.int
+    total_download_bytes: builtins.int
     def __init__(
         self,
         *,
         uploaded_bytes: builtins.int = ...,
         total_bytes: builtins.int = ...,
         deduped_bytes: builtins.int = ...,
+        downloaded_bytes: builtins.int = ...,
+        total_download_bytes: builtins.int = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["deduped_bytes", b"deduped_bytes", "total_bytes", b"total_bytes", "uploaded_bytes", b"uploaded_bytes"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["deduped_bytes", b"deduped_bytes", "downloaded_bytes", b"downloaded_bytes", "total_bytes", b"total_bytes", "total_download_bytes", b"total_download_bytes", "uploaded_bytes", b"uploaded_bytes"]) -> None: ...
 
 global___FilePusherStats = FilePusherStats
 
@@ -3283,18 +3759,50 @@ class LogArtifactResponse(google.protobuf.message.Message):
 
     ARTIFACT_ID_FIELD_NUMBER: builtins.int
     ERROR_MESSAGE_FIELD_NUMBER: builtins.int
+    FAILED_FILES_FIELD_NUMBER: builtins.int
     artifact_id: builtins.str
     error_message: builtins.str
+    @property
+    def failed_files(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___FileTransferFailure]:
+        """Files that failed to upload, including failures that were tolerated."""
     def __init__(
         self,
         *,
         artifact_id: builtins.str = ...,
         error_message: builtins.str = ...,
+        failed_files: collections.abc.Iterable[global___FileTransferFailure] | None = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["artifact_id", b"artifact_id", "error_message", b"error_message"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["artifact_id", b"artifact_id", "error_message", b"error_message", "failed_files", b"failed_files"]) -> None: ...
 
 global___LogArtifactResponse = LogArtifactResponse
 
+@typing_extensions.final
+class FileTransferFailure(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    NAME_FIELD_NUMBER: builtins.int
+    ERROR_KIND_FIELD_NUMBER: builtins.int
+    RETRYABLE_FIELD_NUMBER: builtins.int
+    ERROR_MESSAGE_FIELD_NUMBER: builtins.int
+    name: builtins.str
+    """Path of the file in the artifact."""
+    error_kind: builtins.str
+    """Kind of error, such as "network", "throttled" or "not_found"."""
+    retryable: builtins.bool
+    """Whether transferring the file again might succeed."""
+    error_message: builtins.str
+    def __init__(
+        self,
+        *,
+        name: builtins.str = ...,
+        error_kind: builtins.str = ...,
+        retryable: builtins.bool = ...,
+        error_message: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["error_kind", b"error_kind", "error_message", b"error_message", "name", b"name", "retryable", b"retryable"]) -> None: ...
+
+global___FileTransferFailure = FileTransferFailure
+
 @typing_extensions.final
 class DownloadArtifactRequest(google.protobuf.message.Message):
     """
@@ -4064,3 +4572,91 @@ class JobInputRequest(google.protobuf.message.Message):
     def ClearField(self, field_name: typing_extensions.Literal["exclude_paths", b"exclude_paths", "include_paths", b"include_paths", "input_schema", b"input_schema", "input_source", b"input_source"]) -> None: ...
 
 global___JobInputRequest = JobInputRequest
+
+@typing_extensions.final
+class FileStreamPayload(google.protobuf.message.Message):
+    """
+    FileStreamPayload: binary wire format for filestream requests
+
+    A filestream request encoded as protobuf.
+
+    This mirrors the JSON body of a filestream request and is only sent
+    to servers that advertise support for it.
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    @typing_extensions.final
+    class FilesEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        @property
+        def value(self) -> global___FileStreamChunk: ...
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: global___FileStreamChunk | None = ...,
+        ) -> None: ...
+        def HasField(self, field_name: typing_extensions.Literal["value", b"value"]) -> builtins.bool: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
+    FILES_FIELD_NUMBER: builtins.int
+    UPLOADED_FIELD_NUMBER: builtins.int
+    PREEMPTING_FIELD_NUMBER: builtins.int
+    COMPLETE_FIELD_NUMBER: builtins.int
+    EXITCODE_FIELD_NUMBER: builtins.int
+    CHECKSUM_FIELD_NUMBER: builtins.int
+    @property
+    def files(self) -> google.protobuf.internal.containers.MessageMap[builtins.str, global___FileStreamChunk]: ...
+    @property
+    def uploaded(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]: ...
+    preempting: builtins.bool
+    complete: builtins.bool
+    exitcode: builtins.int
+    checksum: builtins.str
+    """A SHA-256 hash of the file names, offsets and lines."""
+    def __init__(
+        self,
+        *,
+        files: collections.abc.Mapping[builtins.str, global___FileStreamChunk] | None = ...,
+        uploaded: collections.abc.Iterable[builtins.str] | None = ...,
+        preempting: builtins.bool | None = ...,
+        complete: builtins.bool | None = ...,
+        exitcode: builtins.int | None = ...,
+        checksum: builtins.str = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_complete", b"_complete", "_exitcode", b"_exitcode", "_preempting", b"_preempting", "complete", b"complete", "exitcode", b"exitcode", "preempting", b"preempting"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_complete", b"_complete", "_exitcode", b"_exitcode", "_preempting", b"_preempting", "checksum", b"checksum", "complete", b"complete", "exitcode", b"exitcode", "files", b"files", "preempting", b"preempting", "uploaded", b"uploaded"]) -> None: ...
+    @typing.overload
+    def WhichOneof(self, oneof_group: typing_extensions.Literal["_complete", b"_complete"]) -> typing_extensions.Literal["complete"] | None: ...
+    @typing.overload
+    def WhichOneof(self, oneof_group: typing_extensions.Literal["_exitcode", b"_exitcode"]) -> typing_extensions.Literal["exitcode"] | None: ...
+    @typing.overload
+    def WhichOneof(self, oneof_group: typing_extensions.Literal["_preempting", b"_preempting"]) -> typing_extensions.Literal["preempting"] | None: ...
+
+global___FileStreamPayload = FileStreamPayload
+
+@typing_extensions.final
+class FileStreamChunk(google.protobuf.message.Message):
+    """A run of lines to update in a filestream file."""
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    OFFSET_FIELD_NUMBER: builtins.int
+    CONTENT_FIELD_NUMBER: builtins.int
+    offset: builtins.int
+    @property
+    def content(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]: ...
+    def __init__(
+        self,
+        *,
+        offset: builtins.int = ...,
+        content: collections.abc.Iterable[builtins.str] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["content", b"content", "offset", b"offset"]) -> None: ...
+
+global___FileStreamChunk = FileStreamChunk
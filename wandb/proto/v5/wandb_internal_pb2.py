@@ -18,341 +18,385 @@ from wandb.proto import wandb_base_pb2 as wandb_dot_proto_dot_wandb__base__pb2
 from wandb.proto import wandb_telemetry_pb2 as wandb_dot_proto_dot_wandb__telemetry__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n wandb/proto/wandb_internal.proto\x12\x0ewandb_internal\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cwandb/proto/wandb_base.proto\x1a!wandb/proto/wandb_telemetry.proto\"\x95\t\n\x06Record\x12\x0b\n\x03num\x18\x01 \x01(\x03\x12\x30\n\x07history\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.HistoryRecordH\x00\x12\x30\n\x07summary\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecordH\x00\x12.\n\x06output\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.OutputRecordH\x00\x12.\n\x06\x63onfig\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecordH\x00\x12,\n\x05\x66iles\x18\x06 \x01(\x0b\x32\x1b.wandb_internal.FilesRecordH\x00\x12,\n\x05stats\x18\x07 \x01(\x0b\x32\x1b.wandb_internal.StatsRecordH\x00\x12\x32\n\x08\x61rtifact\x18\x08 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecordH\x00\x12,\n\x08tbrecord\x18\t \x01(\x0b\x32\x18.wandb_internal.TBRecordH\x00\x12,\n\x05\x61lert\x18\n \x01(\x0b\x32\x1b.wandb_internal.AlertRecordH\x00\x12\x34\n\ttelemetry\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecordH\x00\x12.\n\x06metric\x18\x0c \x01(\x0b\x32\x1c.wandb_internal.MetricRecordH\x00\x12\x35\n\noutput_raw\x18\r \x01(\x0b\x32\x1f.wandb_internal.OutputRawRecordH\x00\x12(\n\x03run\x18\x11 \x01(\x0b\x32\x19.wandb_internal.RunRecordH\x00\x12-\n\x04\x65xit\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitRecordH\x00\x12,\n\x05\x66inal\x18\x14 \x01(\x0b\x32\x1b.wandb_internal.FinalRecordH\x00\x12.\n\x06header\x18\x15 \x01(\x0b\x32\x1c.wandb_internal.HeaderRecordH\x00\x12.\n\x06\x66ooter\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.FooterRecordH\x00\x12\x39\n\npreempting\x18\x17 \x01(\x0b\x32#.wandb_internal.RunPreemptingRecordH\x00\x12\x34\n\x12noop_link_artifact\x18\x18 \x01(\x0b\x32\x16.google.protobuf.EmptyH\x00\x12\x39\n\x0cuse_artifact\x18\x19 \x01(\x0b\x32!.wandb_internal.UseArtifactRecordH\x00\x12*\n\x07request\x18\x64 \x01(\x0b\x32\x17.wandb_internal.RequestH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x13 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfoB\r\n\x0brecord_type\"\xa8\x01\n\x07\x43ontrol\x12\x10\n\x08req_resp\x18\x01 \x01(\x08\x12\r\n\x05local\x18\x02 \x01(\x08\x12\x10\n\x08relay_id\x18\x03 \x01(\t\x12\x14\n\x0cmailbox_slot\x18\x04 \x01(\t\x12\x13\n\x0b\x61lways_send\x18\x05 \x01(\x08\x12\x14\n\x0c\x66low_control\x18\x06 \x01(\x08\x12\x12\n\nend_offset\x18\x07 \x01(\x03\x12\x15\n\rconnection_id\x18\x08 \x01(\t\"\xf3\x03\n\x06Result\x12\x35\n\nrun_result\x18\x11 \x01(\x0b\x32\x1f.wandb_internal.RunUpdateResultH\x00\x12\x34\n\x0b\x65xit_result\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitResultH\x00\x12\x33\n\nlog_result\x18\x14 \x01(\x0b\x32\x1d.wandb_internal.HistoryResultH\x00\x12\x37\n\x0esummary_result\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.SummaryResultH\x00\x12\x35\n\routput_result\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.OutputResultH\x00\x12\x35\n\rconfig_result\x18\x17 \x01(\x0b\x32\x1c.wandb_internal.ConfigResultH\x00\x12,\n\x08response\x18\x64 \x01(\x0b\x32\x18.wandb_internal.ResponseH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x18 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._ResultInfoB\r\n\x0bresult_type\":\n\x0b\x46inalRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"b\n\x0bVersionInfo\x12\x10\n\x08producer\x18\x01 \x01(\t\x12\x14\n\x0cmin_consumer\x18\x02 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"n\n\x0cHeaderRecord\x12\x31\n\x0cversion_info\x18\x01 \x01(\x0b\x32\x1b.wandb_internal.VersionInfo\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\x0c\x46ooterRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xde\x04\n\tRunRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\x12,\n\x06\x63onfig\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecord\x12.\n\x07summary\x18\x05 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\x12\x11\n\trun_group\x18\x06 \x01(\t\x12\x10\n\x08job_type\x18\x07 \x01(\t\x12\x14\n\x0c\x64isplay_name\x18\x08 \x01(\t\x12\r\n\x05notes\x18\t \x01(\t\x12\x0c\n\x04tags\x18\n \x03(\t\x12\x30\n\x08settings\x18\x0b \x01(\x0b\x32\x1e.wandb_internal.SettingsRecord\x12\x10\n\x08sweep_id\x18\x0c \x01(\t\x12\x0c\n\x04host\x18\r \x01(\t\x12\x15\n\rstarting_step\x18\x0e \x01(\x03\x12\x12\n\nstorage_id\x18\x10 \x01(\t\x12.\n\nstart_time\x18\x11 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07resumed\x18\x12 \x01(\x08\x12\x32\n\ttelemetry\x18\x13 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\x12\x0f\n\x07runtime\x18\x14 \x01(\x05\x12*\n\x03git\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12\x0e\n\x06\x66orked\x18\x16 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\rGitRepoRecord\x12\x1a\n\nremote_url\x18\x01 \x01(\tR\x06remote\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"c\n\x0fRunUpdateResult\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xac\x01\n\tErrorInfo\x12\x0f\n\x07message\x18\x01 \x01(\t\x12\x31\n\x04\x63ode\x18\x02 \x01(\x0e\x32#.wandb_internal.ErrorInfo.ErrorCode\"[\n\tErrorCode\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x11\n\rCOMMUNICATION\x10\x01\x12\x12\n\x0e\x41UTHENTICATION\x10\x02\x12\t\n\x05USAGE\x10\x03\x12\x0f\n\x0bUNSUPPORTED\x10\x04\"`\n\rRunExitRecord\x12\x11\n\texit_code\x18\x01 \x01(\x05\x12\x0f\n\x07runtime\x18\x02 \x01(\x05\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x0f\n\rRunExitResult\"B\n\x13RunPreemptingRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x15\n\x13RunPreemptingResult\"i\n\x0eSettingsRecord\x12*\n\x04item\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.SettingsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"/\n\x0cSettingsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x1a\n\x0bHistoryStep\x12\x0b\n\x03num\x18\x01 \x01(\x03\"\x92\x01\n\rHistoryRecord\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rHistoryResult\"\xdc\x01\n\x0cOutputRecord\x12<\n\x0boutput_type\x18\x01 \x01(\x0e\x32\'.wandb_internal.OutputRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x0e\n\x0cOutputResult\"\xe2\x01\n\x0fOutputRawRecord\x12?\n\x0boutput_type\x18\x01 \x01(\x0e\x32*.wandb_internal.OutputRawRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x11\n\x0fOutputRawResult\"\x98\x03\n\x0cMetricRecord\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tglob_name\x18\x02 \x01(\t\x12\x13\n\x0bstep_metric\x18\x04 \x01(\t\x12\x19\n\x11step_metric_index\x18\x05 \x01(\x05\x12.\n\x07options\x18\x06 \x01(\x0b\x32\x1d.wandb_internal.MetricOptions\x12.\n\x07summary\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.MetricSummary\x12\x35\n\x04goal\x18\x08 \x01(\x0e\x32\'.wandb_internal.MetricRecord.MetricGoal\x12/\n\x08_control\x18\t \x01(\x0b\x32\x1d.wandb_internal.MetricControl\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\nMetricGoal\x12\x0e\n\nGOAL_UNSET\x10\x00\x12\x11\n\rGOAL_MINIMIZE\x10\x01\x12\x11\n\rGOAL_MAXIMIZE\x10\x02\"\x0e\n\x0cMetricResult\"C\n\rMetricOptions\x12\x11\n\tstep_sync\x18\x01 \x01(\x08\x12\x0e\n\x06hidden\x18\x02 \x01(\x08\x12\x0f\n\x07\x64\x65\x66ined\x18\x03 \x01(\x08\"\"\n\rMetricControl\x12\x11\n\toverwrite\x18\x01 \x01(\x08\"o\n\rMetricSummary\x12\x0b\n\x03min\x18\x01 \x01(\x08\x12\x0b\n\x03max\x18\x02 \x01(\x08\x12\x0c\n\x04mean\x18\x03 \x01(\x08\x12\x0c\n\x04\x62\x65st\x18\x04 \x01(\x08\x12\x0c\n\x04last\x18\x05 \x01(\x08\x12\x0c\n\x04none\x18\x06 \x01(\x08\x12\x0c\n\x04\x63opy\x18\x07 \x01(\x08\"\x93\x01\n\x0c\x43onfigRecord\x12*\n\x06update\x18\x01 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12*\n\x06remove\x18\x02 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"A\n\nConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0e\n\x0c\x43onfigResult\"\x96\x01\n\rSummaryRecord\x12+\n\x06update\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x06remove\x18\x02 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bSummaryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rSummaryResult\"d\n\x0b\x46ilesRecord\x12(\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x19.wandb_internal.FilesItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xec\x01\n\tFilesItem\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x34\n\x06policy\x18\x02 \x01(\x0e\x32$.wandb_internal.FilesItem.PolicyType\x12\x30\n\x04type\x18\x03 \x01(\x0e\x32\".wandb_internal.FilesItem.FileType\"(\n\nPolicyType\x12\x07\n\x03NOW\x10\x00\x12\x07\n\x03\x45ND\x10\x01\x12\x08\n\x04LIVE\x10\x02\"9\n\x08\x46ileType\x12\t\n\x05OTHER\x10\x00\x12\t\n\x05WANDB\x10\x01\x12\t\n\x05MEDIA\x10\x02\x12\x0c\n\x08\x41RTIFACT\x10\x03J\x04\x08\x10\x10\x11\"\r\n\x0b\x46ilesResult\"\xe6\x01\n\x0bStatsRecord\x12\x39\n\nstats_type\x18\x01 \x01(\x0e\x32%.wandb_internal.StatsRecord.StatsType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\x04item\x18\x03 \x03(\x0b\x32\x19.wandb_internal.StatsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x17\n\tStatsType\x12\n\n\x06SYSTEM\x10\x00\",\n\tStatsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\xd9\x03\n\x0e\x41rtifactRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\x0c\n\x04name\x18\x05 \x01(\t\x12\x0e\n\x06\x64igest\x18\x06 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x07 \x01(\t\x12\x10\n\x08metadata\x18\x08 \x01(\t\x12\x14\n\x0cuser_created\x18\t \x01(\x08\x12\x18\n\x10use_after_commit\x18\n \x01(\x08\x12\x0f\n\x07\x61liases\x18\x0b \x03(\t\x12\x32\n\x08manifest\x18\x0c \x01(\x0b\x32 .wandb_internal.ArtifactManifest\x12\x16\n\x0e\x64istributed_id\x18\r \x01(\t\x12\x10\n\x08\x66inalize\x18\x0e \x01(\x08\x12\x11\n\tclient_id\x18\x0f \x01(\t\x12\x1a\n\x12sequence_client_id\x18\x10 \x01(\t\x12\x0f\n\x07\x62\x61se_id\x18\x11 \x01(\t\x12\x1c\n\x14ttl_duration_seconds\x18\x12 \x01(\x03\x12\x19\n\x11incremental_beta1\x18\x64 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xd8\x01\n\x10\x41rtifactManifest\x12\x0f\n\x07version\x18\x01 \x01(\x05\x12\x16\n\x0estorage_policy\x18\x02 \x01(\t\x12\x46\n\x15storage_policy_config\x18\x03 \x03(\x0b\x32\'.wandb_internal.StoragePolicyConfigItem\x12\x37\n\x08\x63ontents\x18\x04 \x03(\x0b\x32%.wandb_internal.ArtifactManifestEntry\x12\x1a\n\x12manifest_file_path\x18\x05 \x01(\t\"\xcf\x01\n\x15\x41rtifactManifestEntry\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0e\n\x06\x64igest\x18\x02 \x01(\t\x12\x0b\n\x03ref\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x10\n\x08mimetype\x18\x05 \x01(\t\x12\x12\n\nlocal_path\x18\x06 \x01(\t\x12\x19\n\x11\x62irth_artifact_id\x18\x07 \x01(\t\x12\x12\n\nskip_cache\x18\x08 \x01(\x08\x12(\n\x05\x65xtra\x18\x10 \x03(\x0b\x32\x19.wandb_internal.ExtraItem\",\n\tExtraItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\":\n\x17StoragePolicyConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\"\x10\n\x0e\x41rtifactResult\"\x14\n\x12LinkArtifactResult\"\xd0\x01\n\x13LinkArtifactRequest\x12\x11\n\tclient_id\x18\x01 \x01(\t\x12\x11\n\tserver_id\x18\x02 \x01(\t\x12\x16\n\x0eportfolio_name\x18\x03 \x01(\t\x12\x18\n\x10portfolio_entity\x18\x04 \x01(\t\x12\x19\n\x11portfolio_project\x18\x05 \x01(\t\x12\x19\n\x11portfolio_aliases\x18\x06 \x03(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"-\n\x14LinkArtifactResponse\x12\x15\n\rerror_message\x18\x01 \x01(\t\"h\n\x08TBRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\x12\x0f\n\x07log_dir\x18\x01 \x01(\t\x12\x10\n\x08root_dir\x18\x03 \x01(\t\x12\x0c\n\x04save\x18\x02 \x01(\x08\"\n\n\x08TBResult\"}\n\x0b\x41lertRecord\x12\r\n\x05title\x18\x01 \x01(\t\x12\x0c\n\x04text\x18\x02 \x01(\t\x12\r\n\x05level\x18\x03 \x01(\t\x12\x15\n\rwait_duration\x18\x04 \x01(\x03\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\r\n\x0b\x41lertResult\"\xfc\x0f\n\x07Request\x12\x38\n\x0bstop_status\x18\x01 \x01(\x0b\x32!.wandb_internal.StopStatusRequestH\x00\x12>\n\x0enetwork_status\x18\x02 \x01(\x0b\x32$.wandb_internal.NetworkStatusRequestH\x00\x12-\n\x05\x64\x65\x66\x65r\x18\x03 \x01(\x0b\x32\x1c.wandb_internal.DeferRequestH\x00\x12\x38\n\x0bget_summary\x18\x04 \x01(\x0b\x32!.wandb_internal.GetSummaryRequestH\x00\x12-\n\x05login\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.LoginRequestH\x00\x12-\n\x05pause\x18\x06 \x01(\x0b\x32\x1c.wandb_internal.PauseRequestH\x00\x12/\n\x06resume\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.ResumeRequestH\x00\x12\x34\n\tpoll_exit\x18\x08 \x01(\x0b\x32\x1f.wandb_internal.PollExitRequestH\x00\x12@\n\x0fsampled_history\x18\t \x01(\x0b\x32%.wandb_internal.SampledHistoryRequestH\x00\x12@\n\x0fpartial_history\x18\n \x01(\x0b\x32%.wandb_internal.PartialHistoryRequestH\x00\x12\x34\n\trun_start\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.RunStartRequestH\x00\x12<\n\rcheck_version\x18\x0c \x01(\x0b\x32#.wandb_internal.CheckVersionRequestH\x00\x12:\n\x0clog_artifact\x18\r \x01(\x0b\x32\".wandb_internal.LogArtifactRequestH\x00\x12\x44\n\x11\x64ownload_artifact\x18\x0e \x01(\x0b\x32\'.wandb_internal.DownloadArtifactRequestH\x00\x12\x35\n\tkeepalive\x18\x11 \x01(\x0b\x32 .wandb_internal.KeepaliveRequestH\x00\x12\x36\n\nrun_status\x18\x14 \x01(\x0b\x32 .wandb_internal.RunStatusRequestH\x00\x12/\n\x06\x63\x61ncel\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.CancelRequestH\x00\x12\x33\n\x08metadata\x18\x16 \x01(\x0b\x32\x1f.wandb_internal.MetadataRequestH\x00\x12\x44\n\x11internal_messages\x18\x17 \x01(\x0b\x32\'.wandb_internal.InternalMessagesRequestH\x00\x12@\n\x0fpython_packages\x18\x18 \x01(\x0b\x32%.wandb_internal.PythonPackagesRequestH\x00\x12\x33\n\x08shutdown\x18@ \x01(\x0b\x32\x1f.wandb_internal.ShutdownRequestH\x00\x12/\n\x06\x61ttach\x18\x41 \x01(\x0b\x32\x1d.wandb_internal.AttachRequestH\x00\x12/\n\x06status\x18\x42 \x01(\x0b\x32\x1d.wandb_internal.StatusRequestH\x00\x12\x38\n\x0bserver_info\x18\x43 \x01(\x0b\x32!.wandb_internal.ServerInfoRequestH\x00\x12\x38\n\x0bsender_mark\x18\x44 \x01(\x0b\x32!.wandb_internal.SenderMarkRequestH\x00\x12\x38\n\x0bsender_read\x18\x45 \x01(\x0b\x32!.wandb_internal.SenderReadRequestH\x00\x12<\n\rstatus_report\x18\x46 \x01(\x0b\x32#.wandb_internal.StatusReportRequestH\x00\x12>\n\x0esummary_record\x18G \x01(\x0b\x32$.wandb_internal.SummaryRecordRequestH\x00\x12\x42\n\x10telemetry_record\x18H \x01(\x0b\x32&.wandb_internal.TelemetryRecordRequestH\x00\x12\x32\n\x08job_info\x18I \x01(\x0b\x32\x1e.wandb_internal.JobInfoRequestH\x00\x12\x45\n\x12get_system_metrics\x18J \x01(\x0b\x32\'.wandb_internal.GetSystemMetricsRequestH\x00\x12+\n\x04sync\x18L \x01(\x0b\x32\x1b.wandb_internal.SyncRequestH\x00\x12\x34\n\tjob_input\x18M \x01(\x0b\x32\x1f.wandb_internal.JobInputRequestH\x00\x12<\n\rlink_artifact\x18N \x01(\x0b\x32#.wandb_internal.LinkArtifactRequestH\x00\x12\x39\n\x0btest_inject\x18\xe8\x07 \x01(\x0b\x32!.wandb_internal.TestInjectRequestH\x00\x42\x0e\n\x0crequest_typeJ\x04\x08K\x10L\"\xaa\x0c\n\x08Response\x12?\n\x12keepalive_response\x18\x12 \x01(\x0b\x32!.wandb_internal.KeepaliveResponseH\x00\x12\x42\n\x14stop_status_response\x18\x13 \x01(\x0b\x32\".wandb_internal.StopStatusResponseH\x00\x12H\n\x17network_status_response\x18\x14 \x01(\x0b\x32%.wandb_internal.NetworkStatusResponseH\x00\x12\x37\n\x0elogin_response\x18\x18 \x01(\x0b\x32\x1d.wandb_internal.LoginResponseH\x00\x12\x42\n\x14get_summary_response\x18\x19 \x01(\x0b\x32\".wandb_internal.GetSummaryResponseH\x00\x12>\n\x12poll_exit_response\x18\x1a \x01(\x0b\x32 .wandb_internal.PollExitResponseH\x00\x12J\n\x18sampled_history_response\x18\x1b \x01(\x0b\x32&.wandb_internal.SampledHistoryResponseH\x00\x12>\n\x12run_start_response\x18\x1c \x01(\x0b\x32 .wandb_internal.RunStartResponseH\x00\x12\x46\n\x16\x63heck_version_response\x18\x1d \x01(\x0b\x32$.wandb_internal.CheckVersionResponseH\x00\x12\x44\n\x15log_artifact_response\x18\x1e \x01(\x0b\x32#.wandb_internal.LogArtifactResponseH\x00\x12N\n\x1a\x64ownload_artifact_response\x18\x1f \x01(\x0b\x32(.wandb_internal.DownloadArtifactResponseH\x00\x12@\n\x13run_status_response\x18# \x01(\x0b\x32!.wandb_internal.RunStatusResponseH\x00\x12\x39\n\x0f\x63\x61ncel_response\x18$ \x01(\x0b\x32\x1e.wandb_internal.CancelResponseH\x00\x12N\n\x1ainternal_messages_response\x18% \x01(\x0b\x32(.wandb_internal.InternalMessagesResponseH\x00\x12=\n\x11shutdown_response\x18@ \x01(\x0b\x32 .wandb_internal.ShutdownResponseH\x00\x12\x39\n\x0f\x61ttach_response\x18\x41 \x01(\x0b\x32\x1e.wandb_internal.AttachResponseH\x00\x12\x39\n\x0fstatus_response\x18\x42 \x01(\x0b\x32\x1e.wandb_internal.StatusResponseH\x00\x12\x42\n\x14server_info_response\x18\x43 \x01(\x0b\x32\".wandb_internal.ServerInfoResponseH\x00\x12<\n\x11job_info_response\x18\x44 \x01(\x0b\x32\x1f.wandb_internal.JobInfoResponseH\x00\x12O\n\x1bget_system_metrics_response\x18\x45 \x01(\x0b\x32(.wandb_internal.GetSystemMetricsResponseH\x00\x12\x35\n\rsync_response\x18\x46 \x01(\x0b\x32\x1c.wandb_internal.SyncResponseH\x00\x12\x46\n\x16link_artifact_response\x18G \x01(\x0b\x32$.wandb_internal.LinkArtifactResponseH\x00\x12\x43\n\x14test_inject_response\x18\xe8\x07 \x01(\x0b\x32\".wandb_internal.TestInjectResponseH\x00\x42\x0f\n\rresponse_type\"\xc0\x02\n\x0c\x44\x65\x66\x65rRequest\x12\x36\n\x05state\x18\x01 \x01(\x0e\x32\'.wandb_internal.DeferRequest.DeferState\"\xf7\x01\n\nDeferState\x12\t\n\x05\x42\x45GIN\x10\x00\x12\r\n\tFLUSH_RUN\x10\x01\x12\x0f\n\x0b\x46LUSH_STATS\x10\x02\x12\x19\n\x15\x46LUSH_PARTIAL_HISTORY\x10\x03\x12\x0c\n\x08\x46LUSH_TB\x10\x04\x12\r\n\tFLUSH_SUM\x10\x05\x12\x13\n\x0f\x46LUSH_DEBOUNCER\x10\x06\x12\x10\n\x0c\x46LUSH_OUTPUT\x10\x07\x12\r\n\tFLUSH_JOB\x10\x08\x12\r\n\tFLUSH_DIR\x10\t\x12\x0c\n\x08\x46LUSH_FP\x10\n\x12\x0b\n\x07JOIN_FP\x10\x0b\x12\x0c\n\x08\x46LUSH_FS\x10\x0c\x12\x0f\n\x0b\x46LUSH_FINAL\x10\r\x12\x07\n\x03\x45ND\x10\x0e\"<\n\x0cPauseRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x0f\n\rPauseResponse\"=\n\rResumeRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0eResumeResponse\"M\n\x0cLoginRequest\x12\x0f\n\x07\x61pi_key\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"&\n\rLoginResponse\x12\x15\n\ractive_entity\x18\x01 \x01(\t\"A\n\x11GetSummaryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"?\n\x12GetSummaryResponse\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\"G\n\x17GetSystemMetricsRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"R\n\x12SystemMetricSample\x12-\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05value\x18\x02 \x01(\x02\"I\n\x13SystemMetricsBuffer\x12\x32\n\x06record\x18\x01 \x03(\x0b\x32\".wandb_internal.SystemMetricSample\"\xca\x01\n\x18GetSystemMetricsResponse\x12S\n\x0esystem_metrics\x18\x01 \x03(\x0b\x32;.wandb_internal.GetSystemMetricsResponse.SystemMetricsEntry\x1aY\n\x12SystemMetricsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32#.wandb_internal.SystemMetricsBuffer:\x02\x38\x01\"=\n\rStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\")\n\x0eStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"A\n\x11StopStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"-\n\x12StopStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"D\n\x14NetworkStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"P\n\x15NetworkStatusResponse\x12\x37\n\x11network_responses\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.HttpResponse\"D\n\x0cHttpResponse\x12\x18\n\x10http_status_code\x18\x01 \x01(\x05\x12\x1a\n\x12http_response_text\x18\x02 \x01(\t\"G\n\x17InternalMessagesRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"N\n\x18InternalMessagesResponse\x12\x32\n\x08messages\x18\x01 \x01(\x0b\x32 .wandb_internal.InternalMessages\"#\n\x10InternalMessages\x12\x0f\n\x07warning\x18\x01 \x03(\t\"?\n\x0fPollExitRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\xbc\x01\n\x10PollExitResponse\x12\x0c\n\x04\x64one\x18\x01 \x01(\x08\x12\x32\n\x0b\x65xit_result\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.RunExitResult\x12\x35\n\x0cpusher_stats\x18\x03 \x01(\x0b\x32\x1f.wandb_internal.FilePusherStats\x12/\n\x0b\x66ile_counts\x18\x04 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"@\n\rSyncOverwrite\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\"\x1e\n\x08SyncSkip\x12\x12\n\noutput_raw\x18\x01 \x01(\x08\"\x13\n\x11SenderMarkRequest\"\x93\x01\n\x0bSyncRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\x12\x30\n\toverwrite\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SyncOverwrite\x12&\n\x04skip\x18\x04 \x01(\x0b\x32\x18.wandb_internal.SyncSkip\"E\n\x0cSyncResponse\x12\x0b\n\x03url\x18\x01 \x01(\t\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"?\n\x11SenderReadRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\"m\n\x13StatusReportRequest\x12\x12\n\nrecord_num\x18\x01 \x01(\x03\x12\x13\n\x0bsent_offset\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"F\n\x14SummaryRecordRequest\x12.\n\x07summary\x18\x01 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\"L\n\x16TelemetryRecordRequest\x12\x32\n\ttelemetry\x18\x01 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\"A\n\x11ServerInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"|\n\x12ServerInfoResponse\x12-\n\nlocal_info\x18\x01 \x01(\x0b\x32\x19.wandb_internal.LocalInfo\x12\x37\n\x0fserver_messages\x18\x02 \x01(\x0b\x32\x1e.wandb_internal.ServerMessages\"=\n\x0eServerMessages\x12+\n\x04item\x18\x01 \x03(\x0b\x32\x1d.wandb_internal.ServerMessage\"e\n\rServerMessage\x12\x12\n\nplain_text\x18\x01 \x01(\t\x12\x10\n\x08utf_text\x18\x02 \x01(\t\x12\x11\n\thtml_text\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\r\n\x05level\x18\x05 \x01(\x05\"c\n\nFileCounts\x12\x13\n\x0bwandb_count\x18\x01 \x01(\x05\x12\x13\n\x0bmedia_count\x18\x02 \x01(\x05\x12\x16\n\x0e\x61rtifact_count\x18\x03 \x01(\x05\x12\x13\n\x0bother_count\x18\x04 \x01(\x05\"U\n\x0f\x46ilePusherStats\x12\x16\n\x0euploaded_bytes\x18\x01 \x01(\x03\x12\x13\n\x0btotal_bytes\x18\x02 \x01(\x03\x12\x15\n\rdeduped_bytes\x18\x03 \x01(\x03\"\x1e\n\rFilesUploaded\x12\r\n\x05\x66iles\x18\x01 \x03(\t\"\xf4\x01\n\x17\x46ileTransferInfoRequest\x12\x42\n\x04type\x18\x01 \x01(\x0e\x32\x34.wandb_internal.FileTransferInfoRequest.TransferType\x12\x0c\n\x04path\x18\x02 \x01(\t\x12\x0b\n\x03url\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x11\n\tprocessed\x18\x05 \x01(\x03\x12/\n\x0b\x66ile_counts\x18\x06 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"(\n\x0cTransferType\x12\n\n\x06Upload\x10\x00\x12\x0c\n\x08\x44ownload\x10\x01\"1\n\tLocalInfo\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x13\n\x0bout_of_date\x18\x02 \x01(\x08\"?\n\x0fShutdownRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10ShutdownResponse\"P\n\rAttachRequest\x12\x11\n\tattach_id\x18\x14 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"b\n\x0e\x41ttachResponse\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xd5\x02\n\x11TestInjectRequest\x12\x13\n\x0bhandler_exc\x18\x01 \x01(\x08\x12\x14\n\x0chandler_exit\x18\x02 \x01(\x08\x12\x15\n\rhandler_abort\x18\x03 \x01(\x08\x12\x12\n\nsender_exc\x18\x04 \x01(\x08\x12\x13\n\x0bsender_exit\x18\x05 \x01(\x08\x12\x14\n\x0csender_abort\x18\x06 \x01(\x08\x12\x0f\n\x07req_exc\x18\x07 \x01(\x08\x12\x10\n\x08req_exit\x18\x08 \x01(\x08\x12\x11\n\treq_abort\x18\t \x01(\x08\x12\x10\n\x08resp_exc\x18\n \x01(\x08\x12\x11\n\tresp_exit\x18\x0b \x01(\x08\x12\x12\n\nresp_abort\x18\x0c \x01(\x08\x12\x10\n\x08msg_drop\x18\r \x01(\x08\x12\x10\n\x08msg_hang\x18\x0e \x01(\x08\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x14\n\x12TestInjectResponse\"\x1e\n\rHistoryAction\x12\r\n\x05\x66lush\x18\x01 \x01(\x08\"\xca\x01\n\x15PartialHistoryRequest\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12-\n\x06\x61\x63tion\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.HistoryAction\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x18\n\x16PartialHistoryResponse\"E\n\x15SampledHistoryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"_\n\x12SampledHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x14\n\x0cvalues_float\x18\x03 \x03(\x02\x12\x12\n\nvalues_int\x18\x04 \x03(\x03\"J\n\x16SampledHistoryResponse\x12\x30\n\x04item\x18\x01 \x03(\x0b\x32\".wandb_internal.SampledHistoryItem\"@\n\x10RunStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"x\n\x11RunStatusResponse\x12\x18\n\x10sync_items_total\x18\x01 \x01(\x03\x12\x1a\n\x12sync_items_pending\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"g\n\x0fRunStartRequest\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10RunStartResponse\"\\\n\x13\x43heckVersionRequest\x12\x17\n\x0f\x63urrent_version\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"]\n\x14\x43heckVersionResponse\x12\x17\n\x0fupgrade_message\x18\x01 \x01(\t\x12\x14\n\x0cyank_message\x18\x02 \x01(\t\x12\x16\n\x0e\x64\x65lete_message\x18\x03 \x01(\t\">\n\x0eJobInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"6\n\x0fJobInfoResponse\x12\x12\n\nsequenceId\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"\x9f\x01\n\x12LogArtifactRequest\x12\x30\n\x08\x61rtifact\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecord\x12\x14\n\x0chistory_step\x18\x02 \x01(\x03\x12\x13\n\x0bstaging_dir\x18\x03 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"A\n\x13LogArtifactResponse\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rerror_message\x18\x02 \x01(\t\"\xbe\x01\n\x17\x44ownloadArtifactRequest\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rdownload_root\x18\x02 \x01(\t\x12 \n\x18\x61llow_missing_references\x18\x04 \x01(\x08\x12\x12\n\nskip_cache\x18\x05 \x01(\x08\x12\x13\n\x0bpath_prefix\x18\x06 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"1\n\x18\x44ownloadArtifactResponse\x12\x15\n\rerror_message\x18\x01 \x01(\t\"@\n\x10KeepaliveRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x13\n\x11KeepaliveResponse\"q\n\x0c\x41rtifactInfo\x12\x10\n\x08\x61rtifact\x18\x01 \x01(\t\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\x12\x15\n\rbuild_context\x18\x04 \x01(\t\x12\x12\n\ndockerfile\x18\x05 \x01(\t\")\n\x07GitInfo\x12\x0e\n\x06remote\x18\x01 \x01(\t\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"\x87\x01\n\tGitSource\x12)\n\x08git_info\x18\x01 \x01(\x0b\x32\x17.wandb_internal.GitInfo\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\x12\x15\n\rbuild_context\x18\x04 \x01(\t\x12\x12\n\ndockerfile\x18\x05 \x01(\t\"\x1c\n\x0bImageSource\x12\r\n\x05image\x18\x01 \x01(\t\"\x8c\x01\n\x06Source\x12&\n\x03git\x18\x01 \x01(\x0b\x32\x19.wandb_internal.GitSource\x12.\n\x08\x61rtifact\x18\x02 \x01(\x0b\x32\x1c.wandb_internal.ArtifactInfo\x12*\n\x05image\x18\x03 \x01(\x0b\x32\x1b.wandb_internal.ImageSource\"k\n\tJobSource\x12\x10\n\x08_version\x18\x01 \x01(\t\x12\x13\n\x0bsource_type\x18\x02 \x01(\t\x12&\n\x06source\x18\x03 \x01(\x0b\x32\x16.wandb_internal.Source\x12\x0f\n\x07runtime\x18\x04 \x01(\t\"V\n\x12PartialJobArtifact\x12\x10\n\x08job_name\x18\x01 \x01(\t\x12.\n\x0bsource_info\x18\x02 \x01(\x0b\x32\x19.wandb_internal.JobSource\"\x9d\x01\n\x11UseArtifactRecord\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x33\n\x07partial\x18\x04 \x01(\x0b\x32\".wandb_internal.PartialJobArtifact\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x13\n\x11UseArtifactResult\"R\n\rCancelRequest\x12\x13\n\x0b\x63\x61ncel_slot\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0e\x43\x61ncelResponse\"\'\n\x08\x44iskInfo\x12\r\n\x05total\x18\x01 \x01(\x04\x12\x0c\n\x04used\x18\x02 \x01(\x04\"\x1b\n\nMemoryInfo\x12\r\n\x05total\x18\x01 \x01(\x04\"/\n\x07\x43puInfo\x12\r\n\x05\x63ount\x18\x01 \x01(\r\x12\x15\n\rcount_logical\x18\x02 \x01(\r\">\n\x0cGpuAppleInfo\x12\x0f\n\x07gpuType\x18\x01 \x01(\t\x12\x0e\n\x06vendor\x18\x02 \x01(\t\x12\r\n\x05\x63ores\x18\x03 \x01(\r\"3\n\rGpuNvidiaInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x14\n\x0cmemory_total\x18\x02 \x01(\x04\"\x89\x02\n\nGpuAmdInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\tunique_id\x18\x02 \x01(\t\x12\x15\n\rvbios_version\x18\x03 \x01(\t\x12\x19\n\x11performance_level\x18\x04 \x01(\t\x12\x15\n\rgpu_overdrive\x18\x05 \x01(\t\x12\x1c\n\x14gpu_memory_overdrive\x18\x06 \x01(\t\x12\x11\n\tmax_power\x18\x07 \x01(\t\x12\x0e\n\x06series\x18\x08 \x01(\t\x12\r\n\x05model\x18\t \x01(\t\x12\x0e\n\x06vendor\x18\n \x01(\t\x12\x0b\n\x03sku\x18\x0b \x01(\t\x12\x12\n\nsclk_range\x18\x0c \x01(\t\x12\x12\n\nmclk_range\x18\r \x01(\t\"\xac\x08\n\x0fMetadataRequest\x12\n\n\x02os\x18\x01 \x01(\t\x12\x0e\n\x06python\x18\x02 \x01(\t\x12/\n\x0bheartbeatAt\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\tstartedAt\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x64ocker\x18\x05 \x01(\t\x12\x0c\n\x04\x63uda\x18\x06 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x07 \x03(\t\x12\r\n\x05state\x18\x08 \x01(\t\x12\x0f\n\x07program\x18\t \x01(\t\x12\x1b\n\tcode_path\x18\n \x01(\tR\x08\x63odePath\x12*\n\x03git\x18\x0b \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12\r\n\x05\x65mail\x18\x0c \x01(\t\x12\x0c\n\x04root\x18\r \x01(\t\x12\x0c\n\x04host\x18\x0e \x01(\t\x12\x10\n\x08username\x18\x0f \x01(\t\x12\x12\n\nexecutable\x18\x10 \x01(\t\x12&\n\x0f\x63ode_path_local\x18\x11 \x01(\tR\rcodePathLocal\x12\r\n\x05\x63olab\x18\x12 \x01(\t\x12\x1c\n\tcpu_count\x18\x13 \x01(\rR\tcpu_count\x12,\n\x11\x63pu_count_logical\x18\x14 \x01(\rR\x11\x63pu_count_logical\x12\x15\n\x08gpu_type\x18\x15 \x01(\tR\x03gpu\x12\x1c\n\tgpu_count\x18\x16 \x01(\rR\tgpu_count\x12\x37\n\x04\x64isk\x18\x17 \x03(\x0b\x32).wandb_internal.MetadataRequest.DiskEntry\x12*\n\x06memory\x18\x18 \x01(\x0b\x32\x1a.wandb_internal.MemoryInfo\x12$\n\x03\x63pu\x18\x19 \x01(\x0b\x32\x17.wandb_internal.CpuInfo\x12\x39\n\tgpu_apple\x18\x1a \x01(\x0b\x32\x1c.wandb_internal.GpuAppleInfoR\x08gpuapple\x12=\n\ngpu_nvidia\x18\x1b \x03(\x0b\x32\x1d.wandb_internal.GpuNvidiaInfoR\ngpu_nvidia\x12\x34\n\x07gpu_amd\x18\x1c \x03(\x0b\x32\x1a.wandb_internal.GpuAmdInfoR\x07gpu_amd\x12\x39\n\x05slurm\x18\x1d \x03(\x0b\x32*.wandb_internal.MetadataRequest.SlurmEntry\x12\x14\n\x0c\x63uda_version\x18\x1e \x01(\t\x1a\x45\n\tDiskEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\'\n\x05value\x18\x02 \x01(\x0b\x32\x18.wandb_internal.DiskInfo:\x02\x38\x01\x1a,\n\nSlurmEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x8d\x01\n\x15PythonPackagesRequest\x12\x44\n\x07package\x18\x01 \x03(\x0b\x32\x33.wandb_internal.PythonPackagesRequest.PythonPackage\x1a.\n\rPythonPackage\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"\x1c\n\x0cJobInputPath\x12\x0c\n\x04path\x18\x01 \x03(\t\"\xd6\x01\n\x0eJobInputSource\x12\x44\n\nrun_config\x18\x01 \x01(\x0b\x32..wandb_internal.JobInputSource.RunConfigSourceH\x00\x12?\n\x04\x66ile\x18\x02 \x01(\x0b\x32/.wandb_internal.JobInputSource.ConfigFileSourceH\x00\x1a\x11\n\x0fRunConfigSource\x1a \n\x10\x43onfigFileSource\x12\x0c\n\x04path\x18\x01 \x01(\tB\x08\n\x06source\"\xc7\x01\n\x0fJobInputRequest\x12\x34\n\x0cinput_source\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.JobInputSource\x12\x33\n\rinclude_paths\x18\x02 \x03(\x0b\x32\x1c.wandb_internal.JobInputPath\x12\x33\n\rexclude_paths\x18\x03 \x03(\x0b\x32\x1c.wandb_internal.JobInputPath\x12\x14\n\x0cinput_schema\x18\x04 \x01(\tb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n wandb/proto/wandb_internal.proto\x12\x0ewandb_internal\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cwandb/proto/wandb_base.proto\x1a!wandb/proto/wandb_telemetry.proto\"\xd2\t\n\x06Record\x12\x0b\n\x03num\x18\x01 \x01(\x03\x12\x30\n\x07history\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.HistoryRecordH\x00\x12\x30\n\x07summary\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecordH\x00\x12.\n\x06output\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.OutputRecordH\x00\x12.\n\x06\x63onfig\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecordH\x00\x12,\n\x05\x66iles\x18\x06 \x01(\x0b\x32\x1b.wandb_internal.FilesRecordH\x00\x12,\n\x05stats\x18\x07 \x01(\x0b\x32\x1b.wandb_internal.StatsRecordH\x00\x12\x32\n\x08\x61rtifact\x18\x08 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecordH\x00\x12,\n\x08tbrecord\x18\t \x01(\x0b\x32\x18.wandb_internal.TBRecordH\x00\x12,\n\x05\x61lert\x18\n \x01(\x0b\x32\x1b.wandb_internal.AlertRecordH\x00\x12\x34\n\ttelemetry\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecordH\x00\x12.\n\x06metric\x18\x0c \x01(\x0b\x32\x1c.wandb_internal.MetricRecordH\x00\x12\x35\n\noutput_raw\x18\r \x01(\x0b\x32\x1f.wandb_internal.OutputRawRecordH\x00\x12(\n\x03run\x18\x11 \x01(\x0b\x32\x19.wandb_internal.RunRecordH\x00\x12-\n\x04\x65xit\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitRecordH\x00\x12,\n\x05\x66inal\x18\x14 \x01(\x0b\x32\x1b.wandb_internal.FinalRecordH\x00\x12.\n\x06header\x18\x15 \x01(\x0b\x32\x1c.wandb_internal.HeaderRecordH\x00\x12.\n\x06\x66ooter\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.FooterRecordH\x00\x12\x39\n\npreempting\x18\x17 \x01(\x0b\x32#.wandb_internal.RunPreemptingRecordH\x00\x12\x34\n\x12noop_link_artifact\x18\x18 \x01(\x0b\x32\x16.google.protobuf.EmptyH\x00\x12\x39\n\x0cuse_artifact\x18\x19 \x01(\x0b\x32!.wandb_internal.UseArtifactRecordH\x00\x12;\n\rconfig_change\x18\x1a \x01(\x0b\x32\".wandb_internal.ConfigChangeRecordH\x00\x12*\n\x07request\x18\x64 \x01(\x0b\x32\x17.wandb_internal.RequestH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x13 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfoB\r\n\x0brecord_type\"\xa8\x01\n\x07\x43ontrol\x12\x10\n\x08req_resp\x18\x01 \x01(\x08\x12\r\n\x05local\x18\x02 \x01(\x08\x12\x10\n\x08relay_id\x18\x03 \x01(\t\x12\x14\n\x0cmailbox_slot\x18\x04 \x01(\t\x12\x13\n\x0b\x61lways_send\x18\x05 \x01(\x08\x12\x14\n\x0c\x66low_control\x18\x06 \x01(\x08\x12\x12\n\nend_offset\x18\x07 \x01(\x03\x12\x15\n\rconnection_id\x18\x08 \x01(\t\"\xf3\x03\n\x06Result\x12\x35\n\nrun_result\x18\x11 \x01(\x0b\x32\x1f.wandb_internal.RunUpdateResultH\x00\x12\x34\n\x0b\x65xit_result\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitResultH\x00\x12\x33\n\nlog_result\x18\x14 \x01(\x0b\x32\x1d.wandb_internal.HistoryResultH\x00\x12\x37\n\x0esummary_result\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.SummaryResultH\x00\x12\x35\n\routput_result\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.OutputResultH\x00\x12\x35\n\rconfig_result\x18\x17 \x01(\x0b\x32\x1c.wandb_internal.ConfigResultH\x00\x12,\n\x08response\x18\x64 \x01(\x0b\x32\x18.wandb_internal.ResponseH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x18 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._ResultInfoB\r\n\x0bresult_type\":\n\x0b\x46inalRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"b\n\x0bVersionInfo\x12\x10\n\x08producer\x18\x01 \x01(\t\x12\x14\n\x0cmin_consumer\x18\x02 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"n\n\x0cHeaderRecord\x12\x31\n\x0cversion_info\x18\x01 \x01(\x0b\x32\x1b.wandb_internal.VersionInfo\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\x0c\x46ooterRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xce\x05\n\tRunRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\x12,\n\x06\x63onfig\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecord\x12.\n\x07summary\x18\x05 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\x12\x11\n\trun_group\x18\x06 \x01(\t\x12\x10\n\x08job_type\x18\x07 \x01(\t\x12\x14\n\x0c\x64isplay_name\x18\x08 \x01(\t\x12\r\n\x05notes\x18\t \x01(\t\x12\x0c\n\x04tags\x18\n \x03(\t\x12\x30\n\x08settings\x18\x0b \x01(\x0b\x32\x1e.wandb_internal.SettingsRecord\x12\x10\n\x08sweep_id\x18\x0c \x01(\t\x12\x0c\n\x04host\x18\r \x01(\t\x12\x15\n\rstarting_step\x18\x0e \x01(\x03\x12\x12\n\nstorage_id\x18\x10 \x01(\t\x12.\n\nstart_time\x18\x11 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07resumed\x18\x12 \x01(\x08\x12\x32\n\ttelemetry\x18\x13 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\x12\x0f\n\x07runtime\x18\x14 \x01(\x05\x12*\n\x03git\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12\x0e\n\x06\x66orked\x18\x16 \x01(\x08\x12<\n\nconfig_env\x18\x17 \x03(\x0b\x32(.wandb_internal.RunRecord.ConfigEnvEntry\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\x1a\x30\n\x0e\x43onfigEnvEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\";\n\rGitRepoRecord\x12\x1a\n\nremote_url\x18\x01 \x01(\tR\x06remote\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"c\n\x0fRunUpdateResult\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xac\x01\n\tErrorInfo\x12\x0f\n\x07message\x18\x01 \x01(\t\x12\x31\n\x04\x63ode\x18\x02 \x01(\x0e\x32#.wandb_internal.ErrorInfo.ErrorCode\"[\n\tErrorCode\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x11\n\rCOMMUNICATION\x10\x01\x12\x12\n\x0e\x41UTHENTICATION\x10\x02\x12\t\n\x05USAGE\x10\x03\x12\x0f\n\x0bUNSUPPORTED\x10\x04\"`\n\rRunExitRecord\x12\x11\n\texit_code\x18\x01 \x01(\x05\x12\x0f\n\x07runtime\x18\x02 \x01(\x05\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x0f\n\rRunExitResult\"B\n\x13RunPreemptingRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x15\n\x13RunPreemptingResult\"i\n\x0eSettingsRecord\x12*\n\x04item\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.SettingsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"/\n\x0cSettingsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x1a\n\x0bHistoryStep\x12\x0b\n\x03num\x18\x01 \x01(\x03\"\x92\x01\n\rHistoryRecord\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rHistoryResult\"\xdc\x01\n\x0cOutputRecord\x12<\n\x0boutput_type\x18\x01 \x01(\x0e\x32\'.wandb_internal.OutputRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x0e\n\x0cOutputResult\"\xe2\x01\n\x0fOutputRawRecord\x12?\n\x0boutput_type\x18\x01 \x01(\x0e\x32*.wandb_internal.OutputRawRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x11\n\x0fOutputRawResult\"\xc4\x03\n\x0cMetricRecord\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tglob_name\x18\x02 \x01(\t\x12\x13\n\x0bstep_metric\x18\x04 \x01(\t\x12\x19\n\x11step_metric_index\x18\x05 \x01(\x05\x12.\n\x07options\x18\x06 \x01(\x0b\x32\x1d.wandb_internal.MetricOptions\x12.\n\x07summary\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.MetricSummary\x12\x35\n\x04goal\x18\x08 \x01(\x0e\x32\'.wandb_internal.MetricRecord.MetricGoal\x12/\n\x08_control\x18\t \x01(\x0b\x32\x1d.wandb_internal.MetricControl\x12\x13\n\x0b_expression\x18\n \x01(\t\x12\x15\n\r_sample_every\x18\x0b \x01(\x05\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\nMetricGoal\x12\x0e\n\nGOAL_UNSET\x10\x00\x12\x11\n\rGOAL_MINIMIZE\x10\x01\x12\x11\n\rGOAL_MAXIMIZE\x10\x02\"\x0e\n\x0cMetricResult\"C\n\rMetricOptions\x12\x11\n\tstep_sync\x18\x01 \x01(\x08\x12\x0e\n\x06hidden\x18\x02 \x01(\x08\x12\x0f\n\x07\x64\x65\x66ined\x18\x03 \x01(\x08\"2\n\rMetricControl\x12\x11\n\toverwrite\x18\x01 \x01(\x08\x12\x0e\n\x06remove\x18\x02 \x01(\x08\"~\n\rMetricSummary\x12\x0b\n\x03min\x18\x01 \x01(\x08\x12\x0b\n\x03max\x18\x02 \x01(\x08\x12\x0c\n\x04mean\x18\x03 \x01(\x08\x12\x0c\n\x04\x62\x65st\x18\x04 \x01(\x08\x12\x0c\n\x04last\x18\x05 \x01(\x08\x12\x0c\n\x04none\x18\x06 \x01(\x08\x12\x0c\n\x04\x63opy\x18\x07 \x01(\x08\x12\r\n\x05\x66irst\x18\x08 \x01(\x08\"\x93\x01\n\x0c\x43onfigRecord\x12*\n\x06update\x18\x01 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12*\n\x06remove\x18\x02 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"o\n\x12\x43onfigChangeRecord\x12,\n\x06\x63hange\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.ConfigChange\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xa5\x01\n\x0c\x43onfigChange\x12\x12\n\nnested_key\x18\x01 \x03(\t\x12\x16\n\x0eold_value_json\x18\x02 \x01(\t\x12\x16\n\x0enew_value_json\x18\x03 \x01(\t\x12-\n\ttimestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06source\x18\x05 \x01(\t\x12\x12\n\nrecord_num\x18\x06 \x01(\x03\"\xdb\x02\n\nConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\x12\x38\n\ndict_merge\x18\x03 \x01(\x0e\x32$.wandb_internal.ConfigItem.DictMerge\x12\x38\n\nlist_merge\x18\x04 \x01(\x0e\x32$.wandb_internal.ConfigItem.ListMerge\"P\n\tDictMerge\x12\x16\n\x12\x44ICT_MERGE_DEFAULT\x10\x00\x12\x13\n\x0f\x44ICT_MERGE_DEEP\x10\x01\x12\x16\n\x12\x44ICT_MERGE_REPLACE\x10\x02\"R\n\tListMerge\x12\x16\n\x12LIST_MERGE_DEFAULT\x10\x00\x12\x16\n\x12LIST_MERGE_REPLACE\x10\x01\x12\x15\n\x11LIST_MERGE_APPEND\x10\x02\"\x0e\n\x0c\x43onfigResult\"\x96\x01\n\rSummaryRecord\x12+\n\x06update\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x06remove\x18\x02 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bSummaryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rSummaryResult\"d\n\x0b\x46ilesRecord\x12(\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x19.wandb_internal.FilesItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xec\x01\n\tFilesItem\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x34\n\x06policy\x18\x02 \x01(\x0e\x32$.wandb_internal.FilesItem.PolicyType\x12\x30\n\x04type\x18\x03 \x01(\x0e\x32\".wandb_internal.FilesItem.FileType\"(\n\nPolicyType\x12\x07\n\x03NOW\x10\x00\x12\x07\n\x03\x45ND\x10\x01\x12\x08\n\x04LIVE\x10\x02\"9\n\x08\x46ileType\x12\t\n\x05OTHER\x10\x00\x12\t\n\x05WANDB\x10\x01\x12\t\n\x05MEDIA\x10\x02\x12\x0c\n\x08\x41RTIFACT\x10\x03J\x04\x08\x10\x10\x11\"\r\n\x0b\x46ilesResult\"\xe6\x01\n\x0bStatsRecord\x12\x39\n\nstats_type\x18\x01 \x01(\x0e\x32%.wandb_internal.StatsRecord.StatsType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\x04item\x18\x03 \x03(\x0b\x32\x19.wandb_internal.StatsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x17\n\tStatsType\x12\n\n\x06SYSTEM\x10\x00\",\n\tStatsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\xd9\x03\n\x0e\x41rtifactRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\x0c\n\x04name\x18\x05 \x01(\t\x12\x0e\n\x06\x64igest\x18\x06 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x07 \x01(\t\x12\x10\n\x08metadata\x18\x08 \x01(\t\x12\x14\n\x0cuser_created\x18\t \x01(\x08\x12\x18\n\x10use_after_commit\x18\n \x01(\x08\x12\x0f\n\x07\x61liases\x18\x0b \x03(\t\x12\x32\n\x08manifest\x18\x0c \x01(\x0b\x32 .wandb_internal.ArtifactManifest\x12\x16\n\x0e\x64istributed_id\x18\r \x01(\t\x12\x10\n\x08\x66inalize\x18\x0e \x01(\x08\x12\x11\n\tclient_id\x18\x0f \x01(\t\x12\x1a\n\x12sequence_client_id\x18\x10 \x01(\t\x12\x0f\n\x07\x62\x61se_id\x18\x11 \x01(\t\x12\x1c\n\x14ttl_duration_seconds\x18\x12 \x01(\x03\x12\x19\n\x11incremental_beta1\x18\x64 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xd8\x01\n\x10\x41rtifactManifest\x12\x0f\n\x07version\x18\x01 \x01(\x05\x12\x16\n\x0estorage_policy\x18\x02 \x01(\t\x12\x46\n\x15storage_policy_config\x18\x03 \x03(\x0b\x32\'.wandb_internal.StoragePolicyConfigItem\x12\x37\n\x08\x63ontents\x18\x04 \x03(\x0b\x32%.wandb_internal.ArtifactManifestEntry\x12\x1a\n\x12manifest_file_path\x18\x05 \x01(\t\"\xcf\x01\n\x15\x41rtifactManifestEntry\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0e\n\x06\x64igest\x18\x02 \x01(\t\x12\x0b\n\x03ref\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x10\n\x08mimetype\x18\x05 \x01(\t\x12\x12\n\nlocal_path\x18\x06 \x01(\t\x12\x19\n\x11\x62irth_artifact_id\x18\x07 \x01(\t\x12\x12\n\nskip_cache\x18\x08 \x01(\x08\x12(\n\x05\x65xtra\x18\x10 \x03(\x0b\x32\x19.wandb_internal.ExtraItem\",\n\tExtraItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\":\n\x17StoragePolicyConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\"\x10\n\x0e\x41rtifactResult\"\x14\n\x12LinkArtifactResult\"\xd0\x01\n\x13LinkArtifactRequest\x12\x11\n\tclient_id\x18\x01 \x01(\t\x12\x11\n\tserver_id\x18\x02 \x01(\t\x12\x16\n\x0eportfolio_name\x18\x03 \x01(\t\x12\x18\n\x10portfolio_entity\x18\x04 \x01(\t\x12\x19\n\x11portfolio_project\x18\x05 \x01(\t\x12\x19\n\x11portfolio_aliases\x18\x06 \x03(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"-\n\x14LinkArtifactResponse\x12\x15\n\rerror_message\x18\x01 \x01(\t\"h\n\x08TBRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\x12\x0f\n\x07log_dir\x18\x01 \x01(\t\x12\x10\n\x08root_dir\x18\x03 \x01(\t\x12\x0c\n\x04save\x18\x02 \x01(\x08\"\n\n\x08TBResult\"}\n\x0b\x41lertRecord\x12\r\n\x05title\x18\x01 \x01(\t\x12\x0c\n\x04text\x18\x02 \x01(\t\x12\r\n\x05level\x18\x03 \x01(\t\x12\x15\n\rwait_duration\x18\x04 \x01(\x03\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\r\n\x0b\x41lertResult\"\xe0\x12\n\x07Request\x12\x38\n\x0bstop_status\x18\x01 \x01(\x0b\x32!.wandb_internal.StopStatusRequestH\x00\x12>\n\x0enetwork_status\x18\x02 \x01(\x0b\x32$.wandb_internal.NetworkStatusRequestH\x00\x12-\n\x05\x64\x65\x66\x65r\x18\x03 \x01(\x0b\x32\x1c.wandb_internal.DeferRequestH\x00\x12\x38\n\x0bget_summary\x18\x04 \x01(\x0b\x32!.wandb_internal.GetSummaryRequestH\x00\x12-\n\x05login\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.LoginRequestH\x00\x12-\n\x05pause\x18\x06 \x01(\x0b\x32\x1c.wandb_internal.PauseRequestH\x00\x12/\n\x06resume\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.ResumeRequestH\x00\x12\x34\n\tpoll_exit\x18\x08 \x01(\x0b\x32\x1f.wandb_internal.PollExitRequestH\x00\x12@\n\x0fsampled_history\x18\t \x01(\x0b\x32%.wandb_internal.SampledHistoryRequestH\x00\x12@\n\x0fpartial_history\x18\n \x01(\x0b\x32%.wandb_internal.PartialHistoryRequestH\x00\x12\x34\n\trun_start\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.RunStartRequestH\x00\x12<\n\rcheck_version\x18\x0c \x01(\x0b\x32#.wandb_internal.CheckVersionRequestH\x00\x12:\n\x0clog_artifact\x18\r \x01(\x0b\x32\".wandb_internal.LogArtifactRequestH\x00\x12\x44\n\x11\x64ownload_artifact\x18\x0e \x01(\x0b\x32\'.wandb_internal.DownloadArtifactRequestH\x00\x12\x35\n\tkeepalive\x18\x11 \x01(\x0b\x32 .wandb_internal.KeepaliveRequestH\x00\x12\x36\n\nrun_status\x18\x14 \x01(\x0b\x32 .wandb_internal.RunStatusRequestH\x00\x12/\n\x06\x63\x61ncel\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.CancelRequestH\x00\x12\x33\n\x08metadata\x18\x16 \x01(\x0b\x32\x1f.wandb_internal.MetadataRequestH\x00\x12\x44\n\x11internal_messages\x18\x17 \x01(\x0b\x32\'.wandb_internal.InternalMessagesRequestH\x00\x12@\n\x0fpython_packages\x18\x18 \x01(\x0b\x32%.wandb_internal.PythonPackagesRequestH\x00\x12\x33\n\x08shutdown\x18@ \x01(\x0b\x32\x1f.wandb_internal.ShutdownRequestH\x00\x12/\n\x06\x61ttach\x18\x41 \x01(\x0b\x32\x1d.wandb_internal.AttachRequestH\x00\x12/\n\x06status\x18\x42 \x01(\x0b\x32\x1d.wandb_internal.StatusRequestH\x00\x12\x38\n\x0bserver_info\x18\x43 \x01(\x0b\x32!.wandb_internal.ServerInfoRequestH\x00\x12\x38\n\x0bsender_mark\x18\x44 \x01(\x0b\x32!.wandb_internal.SenderMarkRequestH\x00\x12\x38\n\x0bsender_read\x18\x45 \x01(\x0b\x32!.wandb_internal.SenderReadRequestH\x00\x12<\n\rstatus_report\x18\x46 \x01(\x0b\x32#.wandb_internal.StatusReportRequestH\x00\x12>\n\x0esummary_record\x18G \x01(\x0b\x32$.wandb_internal.SummaryRecordRequestH\x00\x12\x42\n\x10telemetry_record\x18H \x01(\x0b\x32&.wandb_internal.TelemetryRecordRequestH\x00\x12\x32\n\x08job_info\x18I \x01(\x0b\x32\x1e.wandb_internal.JobInfoRequestH\x00\x12\x45\n\x12get_system_metrics\x18J \x01(\x0b\x32\'.wandb_internal.GetSystemMetricsRequestH\x00\x12+\n\x04sync\x18L \x01(\x0b\x32\x1b.wandb_internal.SyncRequestH\x00\x12\x34\n\tjob_input\x18M \x01(\x0b\x32\x1f.wandb_internal.JobInputRequestH\x00\x12<\n\rlink_artifact\x18N \x01(\x0b\x32#.wandb_internal.LinkArtifactRequestH\x00\x12I\n\x14system_metrics_burst\x18O \x01(\x0b\x32).wandb_internal.SystemMetricsBurstRequestH\x00\x12G\n\x13\x66ile_stream_metrics\x18P \x01(\x0b\x32(.wandb_internal.FileStreamMetricsRequestH\x00\x12Z\n\x1d\x66ile_stream_transmit_interval\x18Q \x01(\x0b\x32\x31.wandb_internal.FileStreamTransmitIntervalRequestH\x00\x12\x32\n\x08step_ack\x18R \x01(\x0b\x32\x1e.wandb_internal.StepAckRequestH\x00\x12>\n\x0e\x63onfig_history\x18S \x01(\x0b\x32$.wandb_internal.ConfigHistoryRequestH\x00\x12\x39\n\x0btest_inject\x18\xe8\x07 \x01(\x0b\x32!.wandb_internal.TestInjectRequestH\x00\x42\x0e\n\x0crequest_typeJ\x04\x08K\x10L\"\x85\x0e\n\x08Response\x12?\n\x12keepalive_response\x18\x12 \x01(\x0b\x32!.wandb_internal.KeepaliveResponseH\x00\x12\x42\n\x14stop_status_response\x18\x13 \x01(\x0b\x32\".wandb_internal.StopStatusResponseH\x00\x12H\n\x17network_status_response\x18\x14 \x01(\x0b\x32%.wandb_internal.NetworkStatusResponseH\x00\x12\x37\n\x0elogin_response\x18\x18 \x01(\x0b\x32\x1d.wandb_internal.LoginResponseH\x00\x12\x42\n\x14get_summary_response\x18\x19 \x01(\x0b\x32\".wandb_internal.GetSummaryResponseH\x00\x12>\n\x12poll_exit_response\x18\x1a \x01(\x0b\x32 .wandb_internal.PollExitResponseH\x00\x12J\n\x18sampled_history_response\x18\x1b \x01(\x0b\x32&.wandb_internal.SampledHistoryResponseH\x00\x12>\n\x12run_start_response\x18\x1c \x01(\x0b\x32 .wandb_internal.RunStartResponseH\x00\x12\x46\n\x16\x63heck_version_response\x18\x1d \x01(\x0b\x32$.wandb_internal.CheckVersionResponseH\x00\x12\x44\n\x15log_artifact_response\x18\x1e \x01(\x0b\x32#.wandb_internal.LogArtifactResponseH\x00\x12N\n\x1a\x64ownload_artifact_response\x18\x1f \x01(\x0b\x32(.wandb_internal.DownloadArtifactResponseH\x00\x12@\n\x13run_status_response\x18# \x01(\x0b\x32!.wandb_internal.RunStatusResponseH\x00\x12\x39\n\x0f\x63\x61ncel_response\x18$ \x01(\x0b\x32\x1e.wandb_internal.CancelResponseH\x00\x12N\n\x1ainternal_messages_response\x18% \x01(\x0b\x32(.wandb_internal.InternalMessagesResponseH\x00\x12=\n\x11shutdown_response\x18@ \x01(\x0b\x32 .wandb_internal.ShutdownResponseH\x00\x12\x39\n\x0f\x61ttach_response\x18\x41 \x01(\x0b\x32\x1e.wandb_internal.AttachResponseH\x00\x12\x39\n\x0fstatus_response\x18\x42 \x01(\x0b\x32\x1e.wandb_internal.StatusResponseH\x00\x12\x42\n\x14server_info_response\x18\x43 \x01(\x0b\x32\".wandb_internal.ServerInfoResponseH\x00\x12<\n\x11job_info_response\x18\x44 \x01(\x0b\x32\x1f.wandb_internal.JobInfoResponseH\x00\x12O\n\x1bget_system_metrics_response\x18\x45 \x01(\x0b\x32(.wandb_internal.GetSystemMetricsResponseH\x00\x12\x35\n\rsync_response\x18\x46 \x01(\x0b\x32\x1c.wandb_internal.SyncResponseH\x00\x12\x46\n\x16link_artifact_response\x18G \x01(\x0b\x32$.wandb_internal.LinkArtifactResponseH\x00\x12Q\n\x1c\x66ile_stream_metrics_response\x18H \x01(\x0b\x32).wandb_internal.FileStreamMetricsResponseH\x00\x12<\n\x11step_ack_response\x18I \x01(\x0b\x32\x1f.wandb_internal.StepAckResponseH\x00\x12H\n\x17\x63onfig_history_response\x18J \x01(\x0b\x32%.wandb_internal.ConfigHistoryResponseH\x00\x12\x43\n\x14test_inject_response\x18\xe8\x07 \x01(\x0b\x32\".wandb_internal.TestInjectResponseH\x00\x42\x0f\n\rresponse_type\"\xc0\x02\n\x0c\x44\x65\x66\x65rRequest\x12\x36\n\x05state\x18\x01 \x01(\x0e\x32\'.wandb_internal.DeferRequest.DeferState\"\xf7\x01\n\nDeferState\x12\t\n\x05\x42\x45GIN\x10\x00\x12\r\n\tFLUSH_RUN\x10\x01\x12\x0f\n\x0b\x46LUSH_STATS\x10\x02\x12\x19\n\x15\x46LUSH_PARTIAL_HISTORY\x10\x03\x12\x0c\n\x08\x46LUSH_TB\x10\x04\x12\r\n\tFLUSH_SUM\x10\x05\x12\x13\n\x0f\x46LUSH_DEBOUNCER\x10\x06\x12\x10\n\x0c\x46LUSH_OUTPUT\x10\x07\x12\r\n\tFLUSH_JOB\x10\x08\x12\r\n\tFLUSH_DIR\x10\t\x12\x0c\n\x08\x46LUSH_FP\x10\n\x12\x0b\n\x07JOIN_FP\x10\x0b\x12\x0c\n\x08\x46LUSH_FS\x10\x0c\x12\x0f\n\x0b\x46LUSH_FINAL\x10\r\x12\x07\n\x03\x45ND\x10\x0e\"<\n\x0cPauseRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x0f\n\rPauseResponse\"=\n\rResumeRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0eResumeResponse\"M\n\x0cLoginRequest\x12\x0f\n\x07\x61pi_key\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"&\n\rLoginResponse\x12\x15\n\ractive_entity\x18\x01 \x01(\t\"A\n\x11GetSummaryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"?\n\x12GetSummaryResponse\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\"G\n\x17GetSystemMetricsRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"R\n\x12SystemMetricSample\x12-\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05value\x18\x02 \x01(\x02\"I\n\x13SystemMetricsBuffer\x12\x32\n\x06record\x18\x01 \x03(\x0b\x32\".wandb_internal.SystemMetricSample\"\xca\x01\n\x18GetSystemMetricsResponse\x12S\n\x0esystem_metrics\x18\x01 \x03(\x0b\x32;.wandb_internal.GetSystemMetricsResponse.SystemMetricsEntry\x1aY\n\x12SystemMetricsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32#.wandb_internal.SystemMetricsBuffer:\x02\x38\x01\"\x90\x01\n\x19SystemMetricsBurstRequest\x12\x1b\n\x13sample_rate_seconds\x18\x01 \x01(\x01\x12\x18\n\x10\x64uration_seconds\x18\x02 \x01(\x01\x12\x0e\n\x06\x61ssets\x18\x03 \x03(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"=\n\rStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\")\n\x0eStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"A\n\x11StopStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"-\n\x12StopStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"D\n\x14NetworkStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"P\n\x15NetworkStatusResponse\x12\x37\n\x11network_responses\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.HttpResponse\"D\n\x0cHttpResponse\x12\x18\n\x10http_status_code\x18\x01 \x01(\x05\x12\x1a\n\x12http_response_text\x18\x02 \x01(\t\"H\n\x18\x46ileStreamMetricsRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\xa1\x03\n\x19\x46ileStreamMetricsResponse\x12\x10\n\x08requests\x18\x01 \x01(\x03\x12\x17\n\x0f\x66\x61iled_requests\x18\x02 \x01(\x03\x12\x0f\n\x07retries\x18\x03 \x01(\x03\x12\x12\n\nbytes_sent\x18\x04 \x01(\x03\x12\x19\n\x11max_request_bytes\x18\x05 \x01(\x03\x12\x1c\n\x14mean_latency_seconds\x18\x06 \x01(\x01\x12\x1b\n\x13max_latency_seconds\x18\x07 \x01(\x01\x12\x15\n\rdropped_lines\x18\x08 \x01(\x03\x12\x16\n\x0e\x64\x65\x66\x65rred_lines\x18\t \x01(\x03\x12\x43\n\x05\x66iles\x18\n \x03(\x0b\x32\x34.wandb_internal.FileStreamMetricsResponse.FilesEntry\x12\x15\n\rdrain_seconds\x18\x0b \x01(\x01\x1aS\n\nFilesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x34\n\x05value\x18\x02 \x01(\x0b\x32%.wandb_internal.FileStreamFileMetrics:\x02\x38\x01\"D\n\x14\x43onfigHistoryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"V\n\x15\x43onfigHistoryResponse\x12,\n\x06\x63hange\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.ConfigChange\x12\x0f\n\x07\x64ropped\x18\x02 \x01(\x03\"5\n\x15\x46ileStreamFileMetrics\x12\r\n\x05lines\x18\x01 \x01(\x03\x12\r\n\x05\x62ytes\x18\x02 \x01(\x03\"t\n!FileStreamTransmitIntervalRequest\x12!\n\x19transmit_interval_seconds\x18\x01 \x01(\x01\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"L\n\x0eStepAckRequest\x12\x0c\n\x04step\x18\x01 \x01(\x03\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"1\n\x0fStepAckResponse\x12\x10\n\x08\x61\x63\x63\x65pted\x18\x01 \x01(\x08\x12\x0c\n\x04step\x18\x02 \x01(\x03\"G\n\x17InternalMessagesRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"N\n\x18InternalMessagesResponse\x12\x32\n\x08messages\x18\x01 \x01(\x0b\x32 .wandb_internal.InternalMessages\"#\n\x10InternalMessages\x12\x0f\n\x07warning\x18\x01 \x03(\t\"?\n\x0fPollExitRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\xbc\x01\n\x10PollExitResponse\x12\x0c\n\x04\x64one\x18\x01 \x01(\x08\x12\x32\n\x0b\x65xit_result\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.RunExitResult\x12\x35\n\x0cpusher_stats\x18\x03 \x01(\x0b\x32\x1f.wandb_internal.FilePusherStats\x12/\n\x0b\x66ile_counts\x18\x04 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"@\n\rSyncOverwrite\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\"\x1e\n\x08SyncSkip\x12\x12\n\noutput_raw\x18\x01 \x01(\x08\"\x13\n\x11SenderMarkRequest\"\x93\x01\n\x0bSyncRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\x12\x30\n\toverwrite\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SyncOverwrite\x12&\n\x04skip\x18\x04 \x01(\x0b\x32\x18.wandb_internal.SyncSkip\"E\n\x0cSyncResponse\x12\x0b\n\x03url\x18\x01 \x01(\t\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"?\n\x11SenderReadRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\"m\n\x13StatusReportRequest\x12\x12\n\nrecord_num\x18\x01 \x01(\x03\x12\x13\n\x0bsent_offset\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"F\n\x14SummaryRecordRequest\x12.\n\x07summary\x18\x01 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\"L\n\x16TelemetryRecordRequest\x12\x32\n\ttelemetry\x18\x01 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\"A\n\x11ServerInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"|\n\x12ServerInfoResponse\x12-\n\nlocal_info\x18\x01 \x01(\x0b\x32\x19.wandb_internal.LocalInfo\x12\x37\n\x0fserver_messages\x18\x02 \x01(\x0b\x32\x1e.wandb_internal.ServerMessages\"=\n\x0eServerMessages\x12+\n\x04item\x18\x01 \x03(\x0b\x32\x1d.wandb_internal.ServerMessage\"e\n\rServerMessage\x12\x12\n\nplain_text\x18\x01 \x01(\t\x12\x10\n\x08utf_text\x18\x02 \x01(\t\x12\x11\n\thtml_text\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\r\n\x05level\x18\x05 \x01(\x05\"c\n\nFileCounts\x12\x13\n\x0bwandb_count\x18\x01 \x01(\x05\x12\x13\n\x0bmedia_count\x18\x02 \x01(\x05\x12\x16\n\x0e\x61rtifact_count\x18\x03 \x01(\x05\x12\x13\n\x0bother_count\x18\x04 \x01(\x05\"\x8d\x01\n\x0f\x46ilePusherStats\x12\x16\n\x0euploaded_bytes\x18\x01 \x01(\x03\x12\x13\n\x0btotal_bytes\x18\x02 \x01(\x03\x12\x15\n\rdeduped_bytes\x18\x03 \x01(\x03\x12\x18\n\x10\x64ownloaded_bytes\x18\x04 \x01(\x03\x12\x1c\n\x14total_download_bytes\x18\x05 \x01(\x03\"\x1e\n\rFilesUploaded\x12\r\n\x05\x66iles\x18\x01 \x03(\t\"\xf4\x01\n\x17\x46ileTransferInfoRequest\x12\x42\n\x04type\x18\x01 \x01(\x0e\x32\x34.wandb_internal.FileTransferInfoRequest.TransferType\x12\x0c\n\x04path\x18\x02 \x01(\t\x12\x0b\n\x03url\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x11\n\tprocessed\x18\x05 \x01(\x03\x12/\n\x0b\x66ile_counts\x18\x06 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"(\n\x0cTransferType\x12\n\n\x06Upload\x10\x00\x12\x0c\n\x08\x44ownload\x10\x01\"1\n\tLocalInfo\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x13\n\x0bout_of_date\x18\x02 \x01(\x08\"?\n\x0fShutdownRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10ShutdownResponse\"P\n\rAttachRequest\x12\x11\n\tattach_id\x18\x14 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"b\n\x0e\x41ttachResponse\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xd5\x02\n\x11TestInjectRequest\x12\x13\n\x0bhandler_exc\x18\x01 \x01(\x08\x12\x14\n\x0chandler_exit\x18\x02 \x01(\x08\x12\x15\n\rhandler_abort\x18\x03 \x01(\x08\x12\x12\n\nsender_exc\x18\x04 \x01(\x08\x12\x13\n\x0bsender_exit\x18\x05 \x01(\x08\x12\x14\n\x0csender_abort\x18\x06 \x01(\x08\x12\x0f\n\x07req_exc\x18\x07 \x01(\x08\x12\x10\n\x08req_exit\x18\x08 \x01(\x08\x12\x11\n\treq_abort\x18\t \x01(\x08\x12\x10\n\x08resp_exc\x18\n \x01(\x08\x12\x11\n\tresp_exit\x18\x0b \x01(\x08\x12\x12\n\nresp_abort\x18\x0c \x01(\x08\x12\x10\n\x08msg_drop\x18\r \x01(\x08\x12\x10\n\x08msg_hang\x18\x0e \x01(\x08\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x14\n\x12TestInjectResponse\"\x1e\n\rHistoryAction\x12\r\n\x05\x66lush\x18\x01 \x01(\x08\"\xca\x01\n\x15PartialHistoryRequest\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12-\n\x06\x61\x63tion\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.HistoryAction\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x18\n\x16PartialHistoryResponse\"E\n\x15SampledHistoryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"_\n\x12SampledHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x14\n\x0cvalues_float\x18\x03 \x03(\x02\x12\x12\n\nvalues_int\x18\x04 \x03(\x03\"J\n\x16SampledHistoryResponse\x12\x30\n\x04item\x18\x01 \x03(\x0b\x32\".wandb_internal.SampledHistoryItem\"@\n\x10RunStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"x\n\x11RunStatusResponse\x12\x18\n\x10sync_items_total\x18\x01 \x01(\x03\x12\x1a\n\x12sync_items_pending\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"g\n\x0fRunStartRequest\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10RunStartResponse\"\\\n\x13\x43heckVersionRequest\x12\x17\n\x0f\x63urrent_version\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"]\n\x14\x43heckVersionResponse\x12\x17\n\x0fupgrade_message\x18\x01 \x01(\t\x12\x14\n\x0cyank_message\x18\x02 \x01(\t\x12\x16\n\x0e\x64\x65lete_message\x18\x03 \x01(\t\">\n\x0eJobInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"6\n\x0fJobInfoResponse\x12\x12\n\nsequenceId\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"\x9f\x01\n\x12LogArtifactRequest\x12\x30\n\x08\x61rtifact\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecord\x12\x14\n\x0chistory_step\x18\x02 \x01(\x03\x12\x13\n\x0bstaging_dir\x18\x03 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"|\n\x13LogArtifactResponse\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rerror_message\x18\x02 \x01(\t\x12\x39\n\x0c\x66\x61iled_files\x18\x03 \x03(\x0b\x32#.wandb_internal.FileTransferFailure\"a\n\x13\x46ileTransferFailure\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x12\n\nerror_kind\x18\x02 \x01(\t\x12\x11\n\tretryable\x18\x03 \x01(\x08\x12\x15\n\rerror_message\x18\x04 \x01(\t\"\xbe\x01\n\x17\x44ownloadArtifactRequest\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rdownload_root\x18\x02 \x01(\t\x12 \n\x18\x61llow_missing_references\x18\x04 \x01(\x08\x12\x12\n\nskip_cache\x18\x05 \x01(\x08\x12\x13\n\x0bpath_prefix\x18\x06 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"1\n\x18\x44ownloadArtifactResponse\x12\x15\n\rerror_message\x18\x01 \x01(\t\"@\n\x10KeepaliveRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x13\n\x11KeepaliveResponse\"q\n\x0c\x41rtifactInfo\x12\x10\n\x08\x61rtifact\x18\x01 \x01(\t\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\x12\x15\n\rbuild_context\x18\x04 \x01(\t\x12\x12\n\ndockerfile\x18\x05 \x01(\t\")\n\x07GitInfo\x12\x0e\n\x06remote\x18\x01 \x01(\t\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"\x87\x01\n\tGitSource\x12)\n\x08git_info\x18\x01 \x01(\x0b\x32\x17.wandb_internal.GitInfo\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\x12\x15\n\rbuild_context\x18\x04 \x01(\t\x12\x12\n\ndockerfile\x18\x05 \x01(\t\"\x1c\n\x0bImageSource\x12\r\n\x05image\x18\x01 \x01(\t\"\x8c\x01\n\x06Source\x12&\n\x03git\x18\x01 \x01(\x0b\x32\x19.wandb_internal.GitSource\x12.\n\x08\x61rtifact\x18\x02 \x01(\x0b\x32\x1c.wandb_internal.ArtifactInfo\x12*\n\x05image\x18\x03 \x01(\x0b\x32\x1b.wandb_internal.ImageSource\"k\n\tJobSource\x12\x10\n\x08_version\x18\x01 \x01(\t\x12\x13\n\x0bsource_type\x18\x02 \x01(\t\x12&\n\x06source\x18\x03 \x01(\x0b\x32\x16.wandb_internal.Source\x12\x0f\n\x07runtime\x18\x04 \x01(\t\"V\n\x12PartialJobArtifact\x12\x10\n\x08job_name\x18\x01 \x01(\t\x12.\n\x0bsource_info\x18\x02 \x01(\x0b\x32\x19.wandb_internal.JobSource\"\x9d\x01\n\x11UseArtifactRecord\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x33\n\x07partial\x18\x04 \x01(\x0b\x32\".wandb_internal.PartialJobArtifact\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x13\n\x11UseArtifactResult\"R\n\rCancelRequest\x12\x13\n\x0b\x63\x61ncel_slot\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0e\x43\x61ncelResponse\"\'\n\x08\x44iskInfo\x12\r\n\x05total\x18\x01 \x01(\x04\x12\x0c\n\x04used\x18\x02 \x01(\x04\"\x1b\n\nMemoryInfo\x12\r\n\x05total\x18\x01 \x01(\x04\"/\n\x07\x43puInfo\x12\r\n\x05\x63ount\x18\x01 \x01(\r\x12\x15\n\rcount_logical\x18\x02 \x01(\r\">\n\x0cGpuAppleInfo\x12\x0f\n\x07gpuType\x18\x01 \x01(\t\x12\x0e\n\x06vendor\x18\x02 \x01(\t\x12\r\n\x05\x63ores\x18\x03 \x01(\r\"3\n\rGpuNvidiaInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x14\n\x0cmemory_total\x18\x02 \x01(\x04\"\x89\x02\n\nGpuAmdInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\tunique_id\x18\x02 \x01(\t\x12\x15\n\rvbios_version\x18\x03 \x01(\t\x12\x19\n\x11performance_level\x18\x04 \x01(\t\x12\x15\n\rgpu_overdrive\x18\x05 \x01(\t\x12\x1c\n\x14gpu_memory_overdrive\x18\x06 \x01(\t\x12\x11\n\tmax_power\x18\x07 \x01(\t\x12\x0e\n\x06series\x18\x08 \x01(\t\x12\r\n\x05model\x18\t \x01(\t\x12\x0e\n\x06vendor\x18\n \x01(\t\x12\x0b\n\x03sku\x18\x0b \x01(\t\x12\x12\n\nsclk_range\x18\x0c \x01(\t\x12\x12\n\nmclk_range\x18\r \x01(\t\"\xac\x08\n\x0fMetadataRequest\x12\n\n\x02os\x18\x01 \x01(\t\x12\x0e\n\x06python\x18\x02 \x01(\t\x12/\n\x0bheartbeatAt\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\tstartedAt\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x64ocker\x18\x05 \x01(\t\x12\x0c\n\x04\x63uda\x18\x06 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x07 \x03(\t\x12\r\n\x05state\x18\x08 \x01(\t\x12\x0f\n\x07program\x18\t \x01(\t\x12\x1b\n\tcode_path\x18\n \x01(\tR\x08\x63odePath\x12*\n\x03git\x18\x0b \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12\r\n\x05\x65mail\x18\x0c \x01(\t\x12\x0c\n\x04root\x18\r \x01(\t\x12\x0c\n\x04host\x18\x0e \x01(\t\x12\x10\n\x08username\x18\x0f \x01(\t\x12\x12\n\nexecutable\x18\x10 \x01(\t\x12&\n\x0f\x63ode_path_local\x18\x11 \x01(\tR\rcodePathLocal\x12\r\n\x05\x63olab\x18\x12 \x01(\t\x12\x1c\n\tcpu_count\x18\x13 \x01(\rR\tcpu_count\x12,\n\x11\x63pu_count_logical\x18\x14 \x01(\rR\x11\x63pu_count_logical\x12\x15\n\x08gpu_type\x18\x15 \x01(\tR\x03gpu\x12\x1c\n\tgpu_count\x18\x16 \x01(\rR\tgpu_count\x12\x37\n\x04\x64isk\x18\x17 \x03(\x0b\x32).wandb_internal.MetadataRequest.DiskEntry\x12*\n\x06memory\x18\x18 \x01(\x0b\x32\x1a.wandb_internal.MemoryInfo\x12$\n\x03\x63pu\x18\x19 \x01(\x0b\x32\x17.wandb_internal.CpuInfo\x12\x39\n\tgpu_apple\x18\x1a \x01(\x0b\x32\x1c.wandb_internal.GpuAppleInfoR\x08gpuapple\x12=\n\ngpu_nvidia\x18\x1b \x03(\x0b\x32\x1d.wandb_internal.GpuNvidiaInfoR\ngpu_nvidia\x12\x34\n\x07gpu_amd\x18\x1c \x03(\x0b\x32\x1a.wandb_internal.GpuAmdInfoR\x07gpu_amd\x12\x39\n\x05slurm\x18\x1d \x03(\x0b\x32*.wandb_internal.MetadataRequest.SlurmEntry\x12\x14\n\x0c\x63uda_version\x18\x1e \x01(\t\x1a\x45\n\tDiskEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\'\n\x05value\x18\x02 \x01(\x0b\x32\x18.wandb_internal.DiskInfo:\x02\x38\x01\x1a,\n\nSlurmEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x8d\x01\n\x15PythonPackagesRequest\x12\x44\n\x07package\x18\x01 \x03(\x0b\x32\x33.wandb_internal.PythonPackagesRequest.PythonPackage\x1a.\n\rPythonPackage\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"\x1c\n\x0cJobInputPath\x12\x0c\n\x04path\x18\x01 \x03(\t\"\xd6\x01\n\x0eJobInputSource\x12\x44\n\nrun_config\x18\x01 \x01(\x0b\x32..wandb_internal.JobInputSource.RunConfigSourceH\x00\x12?\n\x04\x66ile\x18\x02 \x01(\x0b\x32/.wandb_internal.JobInputSource.ConfigFileSourceH\x00\x1a\x11\n\x0fRunConfigSource\x1a \n\x10\x43onfigFileSource\x12\x0c\n\x04path\x18\x01 \x01(\tB\x08\n\x06source\"\xc7\x01\n\x0fJobInputRequest\x12\x34\n\x0cinput_source\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.JobInputSource\x12\x33\n\rinclude_paths\x18\x02 \x03(\x0b\x32\x1c.wandb_internal.JobInputPath\x12\x33\n\rexclude_paths\x18\x03 \x03(\x0b\x32\x1c.wandb_internal.JobInputPath\x12\x14\n\x0cinput_schema\x18\x04 \x01(\t\"\xb3\x02\n\x11\x46ileStreamPayload\x12;\n\x05\x66iles\x18\x01 \x03(\x0b\x32,.wandb_internal.FileStreamPayload.FilesEntry\x12\x10\n\x08uploaded\x18\x02 \x03(\t\x12\x17\n\npreempting\x18\x03 \x01(\x08H\x00\x88\x01\x01\x12\x15\n\x08\x63omplete\x18\x04 \x01(\x08H\x01\x88\x01\x01\x12\x15\n\x08\x65xitcode\x18\x05 \x01(\x05H\x02\x88\x01\x01\x12\x10\n\x08\x63hecksum\x18\x06 \x01(\t\x1aM\n\nFilesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12.\n\x05value\x18\x02 \x01(\x0b\x32\x1f.wandb_internal.FileStreamChunk:\x02\x38\x01\x42\r\n\x0b_preemptingB\x0b\n\t_completeB\x0b\n\t_exitcode\"2\n\x0f\x46ileStreamChunk\x12\x0e\n\x06offset\x18\x01 \x01(\x03\x12\x0f\n\x07\x63ontent\x18\x02 \x03(\tb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
 _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'wandb.proto.wandb_internal_pb2', _globals)
 if not _descriptor._USE_C_DESCRIPTORS:
   DESCRIPTOR._loaded_options = None
+  _globals['_RUNRECORD_CONFIGENVENTRY']._loaded_options = None
+  _globals['_RUNRECORD_CONFIGENVENTRY']._serialized_options = b'8\001'
   _globals['_GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY']._loaded_options = None
   _globals['_GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY']._serialized_options = b'8\001'
+  _globals['_FILESTREAMMETRICSRESPONSE_FILESENTRY']._loaded_options = None
+  _globals['_FILESTREAMMETRICSRESPONSE_FILESENTRY']._serialized_options = b'8\001'
   _globals['_METADATAREQUEST_DISKENTRY']._loaded_options = None
   _globals['_METADATAREQUEST_DISKENTRY']._serialized_options = b'8\001'
   _globals['_METADATAREQUEST_SLURMENTRY']._loaded_options = None
   _globals['_METADATAREQUEST_SLURMENTRY']._serialized_options = b'8\001'
+  _globals['_FILESTREAMPAYLOAD_FILESENTRY']._loaded_options = None
+  _globals['_FILESTREAMPAYLOAD_FILESENTRY']._serialized_options = b'8\001'
   _globals['_RECORD']._serialized_start=180
-  _globals['_RECORD']._serialized_end=1353
-  _globals['_CONTROL']._serialized_start=1356
-  _globals['_CONTROL']._serialized_end=1524
-  _globals['_RESULT']._serialized_start=1527
-  _globals['_RESULT']._serialized_end=2026
-  _globals['_FINALRECORD']._serialized_start=2028
-  _globals['_FINALRECORD']._serialized_end=2086
-  _globals['_VERSIONINFO']._serialized_start=2088
-  _globals['_VERSIONINFO']._serialized_end=2186
-  _globals['_HEADERRECORD']._serialized_start=2188
-  _globals['_HEADERRECORD']._serialized_end=2298
-  _globals['_FOOTERRECORD']._serialized_start=2300
-  _globals['_FOOTERRECORD']._serialized_end=2359
-  _globals['_RUNRECORD']._serialized_start=2362
-  _globals['_RUNRECORD']._serialized_end=2968
-  _globals['_GITREPORECORD']._serialized_start=2970
-  _globals['_GITREPORECORD']._serialized_end=3029
-  _globals['_RUNUPDATERESULT']._serialized_start=3031
-  _globals['_RUNUPDATERESULT']._serialized_end=3130
-  _globals['_ERRORINFO']._serialized_start=3133
-  _globals['_ERRORINFO']._serialized_end=3305
-  _globals['_ERRORINFO_ERRORCODE']._serialized_start=3214
-  _globals['_ERRORINFO_ERRORCODE']._serialized_end=3305
-  _globals['_RUNEXITRECORD']._serialized_start=3307
-  _globals['_RUNEXITRECORD']._serialized_end=3403
-  _globals['_RUNEXITRESULT']._serialized_start=3405
-  _globals['_RUNEXITRESULT']._serialized_end=3420
-  _globals['_RUNPREEMPTINGRECORD']._serialized_start=3422
-  _globals['_RUNPREEMPTINGRECORD']._serialized_end=3488
-  _globals['_RUNPREEMPTINGRESULT']._serialized_start=3490
-  _globals['_RUNPREEMPTINGRESULT']._serialized_end=3511
-  _globals['_SETTINGSRECORD']._serialized_start=3513
-  _globals['_SETTINGSRECORD']._serialized_end=3618
-  _globals['_SETTINGSITEM']._serialized_start=3620
-  _globals['_SETTINGSITEM']._serialized_end=3667
-  _globals['_HISTORYSTEP']._serialized_start=3669
-  _globals['_HISTORYSTEP']._serialized_end=3695
-  _globals['_HISTORYRECORD']._serialized_start=3698
-  _globals['_HISTORYRECORD']._serialized_end=3844
-  _globals['_HISTORYITEM']._serialized_start=3846
-  _globals['_HISTORYITEM']._serialized_end=3912
-  _globals['_HISTORYRESULT']._serialized_start=3914
-  _globals['_HISTORYRESULT']._serialized_end=3929
-  _globals['_OUTPUTRECORD']._serialized_start=3932
-  _globals['_OUTPUTRECORD']._serialized_end=4152
-  _globals['_OUTPUTRECORD_OUTPUTTYPE']._serialized_start=4116
-  _globals['_OUTPUTRECORD_OUTPUTTYPE']._serialized_end=4152
-  _globals['_OUTPUTRESULT']._serialized_start=4154
-  _globals['_OUTPUTRESULT']._serialized_end=4168
-  _globals['_OUTPUTRAWRECORD']._serialized_start=4171
-  _globals['_OUTPUTRAWRECORD']._serialized_end=4397
-  _globals['_OUTPUTRAWRECORD_OUTPUTTYPE']._serialized_start=4116
-  _globals['_OUTPUTRAWRECORD_OUTPUTTYPE']._serialized_end=4152
-  _globals['_OUTPUTRAWRESULT']._serialized_start=4399
-  _globals['_OUTPUTRAWRESULT']._serialized_end=4416
-  _globals['_METRICRECORD']._serialized_start=4419
-  _globals['_METRICRECORD']._serialized_end=4827
-  _globals['_METRICRECORD_METRICGOAL']._serialized_start=4761
-  _globals['_METRICRECORD_METRICGOAL']._serialized_end=4827
-  _globals['_METRICRESULT']._serialized_start=4829
-  _globals['_METRICRESULT']._serialized_end=4843
-  _globals['_METRICOPTIONS']._serialized_start=4845
-  _globals['_METRICOPTIONS']._serialized_end=4912
-  _globals['_METRICCONTROL']._serialized_start=4914
-  _globals['_METRICCONTROL']._serialized_end=4948
-  _globals['_METRICSUMMARY']._serialized_start=4950
-  _globals['_METRICSUMMARY']._serialized_end=5061
-  _globals['_CONFIGRECORD']._serialized_start=5064
-  _globals['_CONFIGRECORD']._serialized_end=5211
-  _globals['_CONFIGITEM']._serialized_start=5213
-  _globals['_CONFIGITEM']._serialized_end=5278
-  _globals['_CONFIGRESULT']._serialized_start=5280
-  _globals['_CONFIGRESULT']._serialized_end=5294
-  _globals['_SUMMARYRECORD']._serialized_start=5297
-  _globals['_SUMMARYRECORD']._serialized_end=5447
-  _globals['_SUMMARYITEM']._serialized_start=5449
-  _globals['_SUMMARYITEM']._serialized_end=5515
-  _globals['_SUMMARYRESULT']._serialized_start=5517
-  _globals['_SUMMARYRESULT']._serialized_end=5532
-  _globals['_FILESRECORD']._serialized_start=5534
-  _globals['_FILESRECORD']._serialized_end=5634
-  _globals['_FILESITEM']._serialized_start=5637
-  _globals['_FILESITEM']._serialized_end=5873
-  _globals['_FILESITEM_POLICYTYPE']._serialized_start=5768
-  _globals['_FILESITEM_POLICYTYPE']._serialized_end=5808
-  _globals['_FILESITEM_FILETYPE']._serialized_start=5810
-  _globals['_FILESITEM_FILETYPE']._serialized_end=5867
-  _globals['_FILESRESULT']._serialized_start=5875
-  _globals['_FILESRESULT']._serialized_end=5888
-  _globals['_STATSRECORD']._serialized_start=5891
-  _globals['_STATSRECORD']._serialized_end=6121
-  _globals['_STATSRECORD_STATSTYPE']._serialized_start=6098
-  _globals['_STATSRECORD_STATSTYPE']._serialized_end=6121
-  _globals['_STATSITEM']._serialized_start=6123
-  _globals['_STATSITEM']._serialized_end=6167
-  _globals['_ARTIFACTRECORD']._serialized_start=6170
-  _globals['_ARTIFACTRECORD']._serialized_end=6643
-  _globals['_ARTIFACTMANIFEST']._serialized_start=6646
-  _globals['_ARTIFACTMANIFEST']._serialized_end=6862
-  _globals['_ARTIFACTMANIFESTENTRY']._serialized_start=6865
-  _globals['_ARTIFACTMANIFESTENTRY']._serialized_end=7072
-  _globals['_EXTRAITEM']._serialized_start=7074
-  _globals['_EXTRAITEM']._serialized_end=7118
-  _globals['_STORAGEPOLICYCONFIGITEM']._serialized_start=7120
-  _globals['_STORAGEPOLICYCONFIGITEM']._serialized_end=7178
-  _globals['_ARTIFACTRESULT']._serialized_start=7180
-  _globals['_ARTIFACTRESULT']._serialized_end=7196
-  _globals['_LINKARTIFACTRESULT']._serialized_start=7198
-  _globals['_LINKARTIFACTRESULT']._serialized_end=7218
-  _globals['_LINKARTIFACTREQUEST']._serialized_start=7221
-  _globals['_LINKARTIFACTREQUEST']._serialized_end=7429
-  _globals['_LINKARTIFACTRESPONSE']._serialized_start=7431
-  _globals['_LINKARTIFACTRESPONSE']._serialized_end=7476
-  _globals['_TBRECORD']._serialized_start=7478
-  _globals['_TBRECORD']._serialized_end=7582
-  _globals['_TBRESULT']._serialized_start=7584
-  _globals['_TBRESULT']._serialized_end=7594
-  _globals['_ALERTRECORD']._serialized_start=7596
-  _globals['_ALERTRECORD']._serialized_end=7721
-  _globals['_ALERTRESULT']._serialized_start=7723
-  _globals['_ALERTRESULT']._serialized_end=7736
-  _globals['_REQUEST']._serialized_start=7739
-  _globals['_REQUEST']._serialized_end=9783
-  _globals['_RESPONSE']._serialized_start=9786
-  _globals['_RESPONSE']._serialized_end=11364
-  _globals['_DEFERREQUEST']._serialized_start=11367
-  _globals['_DEFERREQUEST']._serialized_end=11687
-  _globals['_DEFERREQUEST_DEFERSTATE']._serialized_start=11440
-  _globals['_DEFERREQUEST_DEFERSTATE']._serialized_end=11687
-  _globals['_PAUSEREQUEST']._serialized_start=11689
-  _globals['_PAUSEREQUEST']._serialized_end=11749
-  _globals['_PAUSERESPONSE']._serialized_start=11751
-  _globals['_PAUSERESPONSE']._serialized_end=11766
-  _globals['_RESUMEREQUEST']._serialized_start=11768
-  _globals['_RESUMEREQUEST']._serialized_end=11829
-  _globals['_RESUMERESPONSE']._serialized_start=11831
-  _globals['_RESUMERESPONSE']._serialized_end=11847
-  _globals['_LOGINREQUEST']._serialized_start=11849
-  _globals['_LOGINREQUEST']._serialized_end=11926
-  _globals['_LOGINRESPONSE']._serialized_start=11928
-  _globals['_LOGINRESPONSE']._serialized_end=11966
-  _globals['_GETSUMMARYREQUEST']._serialized_start=11968
-  _globals['_GETSUMMARYREQUEST']._serialized_end=12033
-  _globals['_GETSUMMARYRESPONSE']._serialized_start=12035
-  _globals['_GETSUMMARYRESPONSE']._serialized_end=12098
-  _globals['_GETSYSTEMMETRICSREQUEST']._serialized_start=12100
-  _globals['_GETSYSTEMMETRICSREQUEST']._serialized_end=12171
-  _globals['_SYSTEMMETRICSAMPLE']._serialized_start=12173
-  _globals['_SYSTEMMETRICSAMPLE']._serialized_end=12255
-  _globals['_SYSTEMMETRICSBUFFER']._serialized_start=12257
-  _globals['_SYSTEMMETRICSBUFFER']._serialized_end=12330
-  _globals['_GETSYSTEMMETRICSRESPONSE']._serialized_start=12333
-  _globals['_GETSYSTEMMETRICSRESPONSE']._serialized_end=12535
-  _globals['_GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY']._serialized_start=12446
-  _globals['_GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY']._serialized_end=12535
-  _globals['_STATUSREQUEST']._serialized_start=12537
-  _globals['_STATUSREQUEST']._serialized_end=12598
-  _globals['_STATUSRESPONSE']._serialized_start=12600
-  _globals['_STATUSRESPONSE']._serialized_end=12641
-  _globals['_STOPSTATUSREQUEST']._serialized_start=12643
-  _globals['_STOPSTATUSREQUEST']._serialized_end=12708
-  _globals['_STOPSTATUSRESPONSE']._serialized_start=12710
-  _globals['_STOPSTATUSRESPONSE']._serialized_end=12755
-  _globals['_NETWORKSTATUSREQUEST']._serialized_start=12757
-  _globals['_NETWORKSTATUSREQUEST']._serialized_end=12825
-  _globals['_NETWORKSTATUSRESPONSE']._serialized_start=12827
-  _globals['_NETWORKSTATUSRESPONSE']._serialized_end=12907
-  _globals['_HTTPRESPONSE']._serialized_start=12909
-  _globals['_HTTPRESPONSE']._serialized_end=12977
-  _globals['_INTERNALMESSAGESREQUEST']._serialized_start=12979
-  _globals['_INTERNALMESSAGESREQUEST']._serialized_end=13050
-  _globals['_INTERNALMESSAGESRESPONSE']._serialized_start=13052
-  _globals['_INTERNALMESSAGESRESPONSE']._serialized_end=13130
-  _globals['_INTERNALMESSAGES']._serialized_start=13132
-  _globals['_INTERNALMESSAGES']._serialized_end=13167
-  _globals['_POLLEXITREQUEST']._serialized_start=13169
-  _globals['_POLLEXITREQUEST']._serialized_end=13232
-  _globals['_POLLEXITRESPONSE']._serialized_start=13235
-  _globals['_POLLEXITRESPONSE']._serialized_end=13423
-  _globals['_SYNCOVERWRITE']._serialized_start=13425
-  _globals['_SYNCOVERWRITE']._serialized_end=13489
-  _globals['_SYNCSKIP']._serialized_start=13491
-  _globals['_SYNCSKIP']._serialized_end=13521
-  _globals['_SENDERMARKREQUEST']._serialized_start=13523
-  _globals['_SENDERMARKREQUEST']._serialized_end=13542
-  _globals['_SYNCREQUEST']._serialized_start=13545
-  _globals['_SYNCREQUEST']._serialized_end=13692
-  _globals['_SYNCRESPONSE']._serialized_start=13694
-  _globals['_SYNCRESPONSE']._serialized_end=13763
-  _globals['_SENDERREADREQUEST']._serialized_start=13765
-  _globals['_SENDERREADREQUEST']._serialized_end=13828
-  _globals['_STATUSREPORTREQUEST']._serialized_start=13830
-  _globals['_STATUSREPORTREQUEST']._serialized_end=13939
-  _globals['_SUMMARYRECORDREQUEST']._serialized_start=13941
-  _globals['_SUMMARYRECORDREQUEST']._serialized_end=14011
-  _globals['_TELEMETRYRECORDREQUEST']._serialized_start=14013
-  _globals['_TELEMETRYRECORDREQUEST']._serialized_end=14089
-  _globals['_SERVERINFOREQUEST']._serialized_start=14091
-  _globals['_SERVERINFOREQUEST']._serialized_end=14156
-  _globals['_SERVERINFORESPONSE']._serialized_start=14158
-  _globals['_SERVERINFORESPONSE']._serialized_end=14282
-  _globals['_SERVERMESSAGES']._serialized_start=14284
-  _globals['_SERVERMESSAGES']._serialized_end=14345
-  _globals['_SERVERMESSAGE']._serialized_start=14347
-  _globals['_SERVERMESSAGE']._serialized_end=14448
-  _globals['_FILECOUNTS']._serialized_start=14450
-  _globals['_FILECOUNTS']._serialized_end=14549
-  _globals['_FILEPUSHERSTATS']._serialized_start=14551
-  _globals['_FILEPUSHERSTATS']._serialized_end=14636
-  _globals['_FILESUPLOADED']._serialized_start=14638
-  _globals['_FILESUPLOADED']._serialized_end=14668
-  _globals['_FILETRANSFERINFOREQUEST']._serialized_start=14671
-  _globals['_FILETRANSFERINFOREQUEST']._serialized_end=14915
-  _globals['_FILETRANSFERINFOREQUEST_TRANSFERTYPE']._serialized_start=14875
-  _globals['_FILETRANSFERINFOREQUEST_TRANSFERTYPE']._serialized_end=14915
-  _globals['_LOCALINFO']._serialized_start=14917
-  _globals['_LOCALINFO']._serialized_end=14966
-  _globals['_SHUTDOWNREQUEST']._serialized_start=14968
-  _globals['_SHUTDOWNREQUEST']._serialized_end=15031
-  _globals['_SHUTDOWNRESPONSE']._serialized_start=15033
-  _globals['_SHUTDOWNRESPONSE']._serialized_end=15051
-  _globals['_ATTACHREQUEST']._serialized_start=15053
-  _globals['_ATTACHREQUEST']._serialized_end=15133
-  _globals['_ATTACHRESPONSE']._serialized_start=15135
-  _globals['_ATTACHRESPONSE']._serialized_end=15233
-  _globals['_TESTINJECTREQUEST']._serialized_start=15236
-  _globals['_TESTINJECTREQUEST']._serialized_end=15577
-  _globals['_TESTINJECTRESPONSE']._serialized_start=15579
-  _globals['_TESTINJECTRESPONSE']._serialized_end=15599
-  _globals['_HISTORYACTION']._serialized_start=15601
-  _globals['_HISTORYACTION']._serialized_end=15631
-  _globals['_PARTIALHISTORYREQUEST']._serialized_start=15634
-  _globals['_PARTIALHISTORYREQUEST']._serialized_end=15836
-  _globals['_PARTIALHISTORYRESPONSE']._serialized_start=15838
-  _globals['_PARTIALHISTORYRESPONSE']._serialized_end=15862
-  _globals['_SAMPLEDHISTORYREQUEST']._serialized_start=15864
-  _globals['_SAMPLEDHISTORYREQUEST']._serialized_end=15933
-  _globals['_SAMPLEDHISTORYITEM']._serialized_start=15935
-  _globals['_SAMPLEDHISTORYITEM']._serialized_end=16030
-  _globals['_SAMPLEDHISTORYRESPONSE']._serialized_start=16032
-  _globals['_SAMPLEDHISTORYRESPONSE']._serialized_end=16106
-  _globals['_RUNSTATUSREQUEST']._serialized_start=16108
-  _globals['_RUNSTATUSREQUEST']._serialized_end=16172
-  _globals['_RUNSTATUSRESPONSE']._serialized_start=16174
-  _globals['_RUNSTATUSRESPONSE']._serialized_end=16294
-  _globals['_RUNSTARTREQUEST']._serialized_start=16296
-  _globals['_RUNSTARTREQUEST']._serialized_end=16399
-  _globals['_RUNSTARTRESPONSE']._serialized_start=16401
-  _globals['_RUNSTARTRESPONSE']._serialized_end=16419
-  _globals['_CHECKVERSIONREQUEST']._serialized_start=16421
-  _globals['_CHECKVERSIONREQUEST']._serialized_end=16513
-  _globals['_CHECKVERSIONRESPONSE']._serialized_start=16515
-  _globals['_CHECKVERSIONRESPONSE']._serialized_end=16608
-  _globals['_JOBINFOREQUEST']._serialized_start=16610
-  _globals['_JOBINFOREQUEST']._serialized_end=16672
-  _globals['_JOBINFORESPONSE']._serialized_start=16674
-  _globals['_JOBINFORESPONSE']._serialized_end=16728
-  _globals['_LOGARTIFACTREQUEST']._serialized_start=16731
-  _globals['_LOGARTIFACTREQUEST']._serialized_end=16890
-  _globals['_LOGARTIFACTRESPONSE']._serialized_start=16892
-  _globals['_LOGARTIFACTRESPONSE']._serialized_end=16957
-  _globals['_DOWNLOADARTIFACTREQUEST']._serialized_start=16960
-  _globals['_DOWNLOADARTIFACTREQUEST']._serialized_end=17150
-  _globals['_DOWNLOADARTIFACTRESPONSE']._serialized_start=17152
-  _globals['_DOWNLOADARTIFACTRESPONSE']._serialized_end=17201
-  _globals['_KEEPALIVEREQUEST']._serialized_start=17203
-  _globals['_KEEPALIVEREQUEST']._serialized_end=17267
-  _globals['_KEEPALIVERESPONSE']._serialized_start=17269
-  _globals['_KEEPALIVERESPONSE']._serialized_end=17288
-  _globals['_ARTIFACTINFO']._serialized_start=17290
-  _globals['_ARTIFACTINFO']._serialized_end=17403
-  _globals['_GITINFO']._serialized_start=17405
-  _globals['_GITINFO']._serialized_end=17446
-  _globals['_GITSOURCE']._serialized_start=17449
-  _globals['_GITSOURCE']._serialized_end=17584
-  _globals['_IMAGESOURCE']._serialized_start=17586
-  _globals['_IMAGESOURCE']._serialized_end=17614
-  _globals['_SOURCE']._serialized_start=17617
-  _globals['_SOURCE']._serialized_end=17757
-  _globals['_JOBSOURCE']._serialized_start=17759
-  _globals['_JOBSOURCE']._serialized_end=17866
-  _globals['_PARTIALJOBARTIFACT']._serialized_start=17868
-  _globals['_PARTIALJOBARTIFACT']._serialized_end=17954
-  _globals['_USEARTIFACTRECORD']._serialized_start=17957
-  _globals['_USEARTIFACTRECORD']._serialized_end=18114
-  _globals['_USEARTIFACTRESULT']._serialized_start=18116
-  _globals['_USEARTIFACTRESULT']._serialized_end=18135
-  _globals['_CANCELREQUEST']._serialized_start=18137
-  _globals['_CANCELREQUEST']._serialized_end=18219
-  _globals['_CANCELRESPONSE']._serialized_start=18221
-  _globals['_CANCELRESPONSE']._serialized_end=18237
-  _globals['_DISKINFO']._serialized_start=18239
-  _globals['_DISKINFO']._serialized_end=18278
-  _globals['_MEMORYINFO']._serialized_start=18280
-  _globals['_MEMORYINFO']._serialized_end=18307
-  _globals['_CPUINFO']._serialized_start=18309
-  _globals['_CPUINFO']._serialized_end=18356
-  _globals['_GPUAPPLEINFO']._serialized_start=18358
-  _globals['_GPUAPPLEINFO']._serialized_end=18420
-  _globals['_GPUNVIDIAINFO']._serialized_start=18422
-  _globals['_GPUNVIDIAINFO']._serialized_end=18473
-  _globals['_GPUAMDINFO']._serialized_start=18476
-  _globals['_GPUAMDINFO']._serialized_end=18741
-  _globals['_METADATAREQUEST']._serialized_start=18744
-  _globals['_METADATAREQUEST']._serialized_end=19812
-  _globals['_METADATAREQUEST_DISKENTRY']._serialized_start=19697
-  _globals['_METADATAREQUEST_DISKENTRY']._serialized_end=19766
-  _globals['_METADATAREQUEST_SLURMENTRY']._serialized_start=19768
-  _globals['_METADATAREQUEST_SLURMENTRY']._serialized_end=19812
-  _globals['_PYTHONPACKAGESREQUEST']._serialized_start=19815
-  _globals['_PYTHONPACKAGESREQUEST']._serialized_end=19956
-  _globals['_PYTHONPACKAGESREQUEST_PYTHONPACKAGE']._serialized_start=19910
-  _globals['_PYTHONPACKAGESREQUEST_PYTHONPACKAGE']._serialized_end=19956
-  _globals['_JOBINPUTPATH']._serialized_start=19958
-  _globals['_JOBINPUTPATH']._serialized_end=19986
-  _globals['_JOBINPUTSOURCE']._serialized_start=19989
-  _globals['_JOBINPUTSOURCE']._serialized_end=20203
-  _globals['_JOBINPUTSOURCE_RUNCONFIGSOURCE']._serialized_start=20142
-  _globals['_JOBINPUTSOURCE_RUNCONFIGSOURCE']._serialized_end=20159
-  _globals['_JOBINPUTSOURCE_CONFIGFILESOURCE']._serialized_start=20161
-  _globals['_JOBINPUTSOURCE_CONFIGFILESOURCE']._serialized_end=20193
-  _globals['_JOBINPUTREQUEST']._serialized_start=20206
-  _globals['_JOBINPUTREQUEST']._serialized_end=20405
+  _globals['_RECORD']._serialized_end=1414
+  _globals['_CONTROL']._serialized_start=1417
+  _globals['_CONTROL']._serialized_end=1585
+  _globals['_RESULT']._serialized_start=1588
+  _globals['_RESULT']._serialized_end=2087
+  _globals['_FINALRECORD']._serialized_start=2089
+  _globals['_FINALRECORD']._serialized_end=2147
+  _globals['_VERSIONINFO']._serialized_start=2149
+  _globals['_VERSIONINFO']._serialized_end=2247
+  _globals['_HEADERRECORD']._serialized_start=2249
+  _globals['_HEADERRECORD']._serialized_end=2359
+  _globals['_FOOTERRECORD']._serialized_start=2361
+  _globals['_FOOTERRECORD']._serialized_end=2420
+  _globals['_RUNRECORD']._serialized_start=2423
+  _globals['_RUNRECORD']._serialized_end=3141
+  _globals['_RUNRECORD_CONFIGENVENTRY']._serialized_start=3093
+  _globals['_RUNRECORD_CONFIGENVENTRY']._serialized_end=3141
+  _globals['_GITREPORECORD']._serialized_start=3143
+  _globals['_GITREPORECORD']._serialized_end=3202
+  _globals['_RUNUPDATERESULT']._serialized_start=3204
+  _globals['_RUNUPDATERESULT']._serialized_end=3303
+  _globals['_ERRORINFO']._serialized_start=3306
+  _globals['_ERRORINFO']._serialized_end=3478
+  _globals['_ERRORINFO_ERRORCODE']._serialized_start=3387
+  _globals['_ERRORINFO_ERRORCODE']._serialized_end=3478
+  _globals['_RUNEXITRECORD']._serialized_start=3480
+  _globals['_RUNEXITRECORD']._serialized_end=3576
+  _globals['_RUNEXITRESULT']._serialized_start=3578
+  _globals['_RUNEXITRESULT']._serialized_end=3593
+  _globals['_RUNPREEMPTINGRECORD']._serialized_start=3595
+  _globals['_RUNPREEMPTINGRECORD']._serialized_end=3661
+  _globals['_RUNPREEMPTINGRESULT']._serialized_start=3663
+  _globals['_RUNPREEMPTINGRESULT']._serialized_end=3684
+  _globals['_SETTINGSRECORD']._serialized_start=3686
+  _globals['_SETTINGSRECORD']._serialized_end=3791
+  _globals['_SETTINGSITEM']._serialized_start=3793
+  _globals['_SETTINGSITEM']._serialized_end=3840
+  _globals['_HISTORYSTEP']._serialized_start=3842
+  _globals['_HISTORYSTEP']._serialized_end=3868
+  _globals['_HISTORYRECORD']._serialized_start=3871
+  _globals['_HISTORYRECORD']._serialized_end=4017
+  _globals['_HISTORYITEM']._serialized_start=4019
+  _globals['_HISTORYITEM']._serialized_end=4085
+  _globals['_HISTORYRESULT']._serialized_start=4087
+  _globals['_HISTORYRESULT']._serialized_end=4102
+  _globals['_OUTPUTRECORD']._serialized_start=4105
+  _globals['_OUTPUTRECORD']._serialized_end=4325
+  _globals['_OUTPUTRECORD_OUTPUTTYPE']._serialized_start=4289
+  _globals['_OUTPUTRECORD_OUTPUTTYPE']._serialized_end=4325
+  _globals['_OUTPUTRESULT']._serialized_start=4327
+  _globals['_OUTPUTRESULT']._serialized_end=4341
+  _globals['_OUTPUTRAWRECORD']._serialized_start=4344
+  _globals['_OUTPUTRAWRECORD']._serialized_end=4570
+  _globals['_OUTPUTRAWRECORD_OUTPUTTYPE']._serialized_start=4289
+  _globals['_OUTPUTRAWRECORD_OUTPUTTYPE']._serialized_end=4325
+  _globals['_OUTPUTRAWRESULT']._serialized_start=4572
+  _globals['_OUTPUTRAWRESULT']._serialized_end=4589
+  _globals['_METRICRECORD']._serialized_start=4592
+  _globals['_METRICRECORD']._serialized_end=5044
+  _globals['_METRICRECORD_METRICGOAL']._serialized_start=4978
+  _globals['_METRICRECORD_METRICGOAL']._serialized_end=5044
+  _globals['_METRICRESULT']._serialized_start=5046
+  _globals['_METRICRESULT']._serialized_end=5060
+  _globals['_METRICOPTIONS']._serialized_start=5062
+  _globals['_METRICOPTIONS']._serialized_end=5129
+  _globals['_METRICCONTROL']._serialized_start=5131
+  _globals['_METRICCONTROL']._serialized_end=5181
+  _globals['_METRICSUMMARY']._serialized_start=5183
+  _globals['_METRICSUMMARY']._serialized_end=5309
+  _globals['_CONFIGRECORD']._serialized_start=5312
+  _globals['_CONFIGRECORD']._serialized_end=5459
+  _globals['_CONFIGCHANGERECORD']._serialized_start=5461
+  _globals['_CONFIGCHANGERECORD']._serialized_end=5572
+  _globals['_CONFIGCHANGE']._serialized_start=5575
+  _globals['_CONFIGCHANGE']._serialized_end=5740
+  _globals['_CONFIGITEM']._serialized_start=5743
+  _globals['_CONFIGITEM']._serialized_end=6090
+  _globals['_CONFIGITEM_DICTMERGE']._serialized_start=5926
+  _globals['_CONFIGITEM_DICTMERGE']._serialized_end=6006
+  _globals['_CONFIGITEM_LISTMERGE']._serialized_start=6008
+  _globals['_CONFIGITEM_LISTMERGE']._serialized_end=6090
+  _globals['_CONFIGRESULT']._serialized_start=6092
+  _globals['_CONFIGRESULT']._serialized_end=6106
+  _globals['_SUMMARYRECORD']._serialized_start=6109
+  _globals['_SUMMARYRECORD']._serialized_end=6259
+  _globals['_SUMMARYITEM']._serialized_start=6261
+  _globals['_SUMMARYITEM']._serialized_end=6327
+  _globals['_SUMMARYRESULT']._serialized_start=6329
+  _globals['_SUMMARYRESULT']._serialized_end=6344
+  _globals['_FILESRECORD']._serialized_start=6346
+  _globals['_FILESRECORD']._serialized_end=6446
+  _globals['_FILESITEM']._serialized_start=6449
+  _globals['_FILESITEM']._serialized_end=6685
+  _globals['_FILESITEM_POLICYTYPE']._serialized_start=6580
+  _globals['_FILESITEM_POLICYTYPE']._serialized_end=6620
+  _globals['_FILESITEM_FILETYPE']._serialized_start=6622
+  _globals['_FILESITEM_FILETYPE']._serialized_end=6679
+  _globals['_FILESRESULT']._serialized_start=6687
+  _globals['_FILESRESULT']._serialized_end=6700
+  _globals['_STATSRECORD']._serialized_start=6703
+  _globals['_STATSRECORD']._serialized_end=6933
+  _globals['_STATSRECORD_STATSTYPE']._serialized_start=6910
+  _globals['_STATSRECORD_STATSTYPE']._serialized_end=6933
+  _globals['_STATSITEM']._serialized_start=6935
+  _globals['_STATSITEM']._serialized_end=6979
+  _globals['_ARTIFACTRECORD']._serialized_start=6982
+  _globals['_ARTIFACTRECORD']._serialized_end=7455
+  _globals['_ARTIFACTMANIFEST']._serialized_start=7458
+  _globals['_ARTIFACTMANIFEST']._serialized_end=7674
+  _globals['_ARTIFACTMANIFESTENTRY']._serialized_start=7677
+  _globals['_ARTIFACTMANIFESTENTRY']._serialized_end=7884
+  _globals['_EXTRAITEM']._serialized_start=7886
+  _globals['_EXTRAITEM']._serialized_end=7930
+  _globals['_STORAGEPOLICYCONFIGITEM']._serialized_start=7932
+  _globals['_STORAGEPOLICYCONFIGITEM']._serialized_end=7990
+  _globals['_ARTIFACTRESULT']._serialized_start=7992
+  _globals['_ARTIFACTRESULT']._serialized_end=8008
+  _globals['_LINKARTIFACTRESULT']._serialized_start=8010
+  _globals['_LINKARTIFACTRESULT']._serialized_end=8030
+  _globals['_LINKARTIFACTREQUEST']._serialized_start=8033
+  _globals['_LINKARTIFACTREQUEST']._serialized_end=8241
+  _globals['_LINKARTIFACTRESPONSE']._serialized_start=8243
+  _globals['_LINKARTIFACTRESPONSE']._serialized_end=8288
+  _globals['_TBRECORD']._serialized_start=8290
+  _globals['_TBRECORD']._serialized_end=8394
+  _globals['_TBRESULT']._serialized_start=8396
+  _globals['_TBRESULT']._serialized_end=8406
+  _globals['_ALERTRECORD']._serialized_start=8408
+  _globals['_ALERTRECORD']._serialized_end=8533
+  _globals['_ALERTRESULT']._serialized_start=8535
+  _globals['_ALERTRESULT']._serialized_end=8548
+  _globals['_REQUEST']._serialized_start=8551
+  _globals['_REQUEST']._serialized_end=10951
+  _globals['_RESPONSE']._serialized_start=10954
+  _globals['_RESPONSE']._serialized_end=12751
+  _globals['_DEFERREQUEST']._serialized_start=12754
+  _globals['_DEFERREQUEST']._serialized_end=13074
+  _globals['_DEFERREQUEST_DEFERSTATE']._serialized_start=12827
+  _globals['_DEFERREQUEST_DEFERSTATE']._serialized_end=13074
+  _globals['_PAUSEREQUEST']._serialized_start=13076
+  _globals['_PAUSEREQUEST']._serialized_end=13136
+  _globals['_PAUSERESPONSE']._serialized_start=13138
+  _globals['_PAUSERESPONSE']._serialized_end=13153
+  _globals['_RESUMEREQUEST']._serialized_start=13155
+  _globals['_RESUMEREQUEST']._serialized_end=13216
+  _globals['_RESUMERESPONSE']._serialized_start=13218
+  _globals['_RESUMERESPONSE']._serialized_end=13234
+  _globals['_LOGINREQUEST']._serialized_start=13236
+  _globals['_LOGINREQUEST']._serialized_end=13313
+  _globals['_LOGINRESPONSE']._serialized_start=13315
+  _globals['_LOGINRESPONSE']._serialized_end=13353
+  _globals['_GETSUMMARYREQUEST']._serialized_start=13355
+  _globals['_GETSUMMARYREQUEST']._serialized_end=13420
+  _globals['_GETSUMMARYRESPONSE']._serialized_start=13422
+  _globals['_GETSUMMARYRESPONSE']._serialized_end=13485
+  _globals['_GETSYSTEMMETRICSREQUEST']._serialized_start=13487
+  _globals['_GETSYSTEMMETRICSREQUEST']._serialized_end=13558
+  _globals['_SYSTEMMETRICSAMPLE']._serialized_start=13560
+  _globals['_SYSTEMMETRICSAMPLE']._serialized_end=13642
+  _globals['_SYSTEMMETRICSBUFFER']._serialized_start=13644
+  _globals['_SYSTEMMETRICSBUFFER']._serialized_end=13717
+  _globals['_GETSYSTEMMETRICSRESPONSE']._serialized_start=13720
+  _globals['_GETSYSTEMMETRICSRESPONSE']._serialized_end=13922
+  _globals['_GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY']._serialized_start=13833
+  _globals['_GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY']._serialized_end=13922
+  _globals['_SYSTEMMETRICSBURSTREQUEST']._serialized_start=13925
+  _globals['_SYSTEMMETRICSBURSTREQUEST']._serialized_end=14069
+  _globals['_STATUSREQUEST']._serialized_start=14071
+  _globals['_STATUSREQUEST']._serialized_end=14132
+  _globals['_STATUSRESPONSE']._serialized_start=14134
+  _globals['_STATUSRESPONSE']._serialized_end=14175
+  _globals['_STOPSTATUSREQUEST']._serialized_start=14177
+  _globals['_STOPSTATUSREQUEST']._serialized_end=14242
+  _globals['_STOPSTATUSRESPONSE']._serialized_start=14244
+  _globals['_STOPSTATUSRESPONSE']._serialized_end=14289
+  _globals['_NETWORKSTATUSREQUEST']._serialized_start=14291
+  _globals['_NETWORKSTATUSREQUEST']._serialized_end=14359
+  _globals['_NETWORKSTATUSRESPONSE']._serialized_start=14361
+  _globals['_NETWORKSTATUSRESPONSE']._serialized_end=14441
+  _globals['_HTTPRESPONSE']._serialized_start=14443
+  _globals['_HTTPRESPONSE']._serialized_end=14511
+  _globals['_FILESTREAMMETRICSREQUEST']._serialized_start=14513
+  _globals['_FILESTREAMMETRICSREQUEST']._serialized_end=14585
+  _globals['_FILESTREAMMETRICSRESPONSE']._serialized_start=14588
+  _globals['_FILESTREAMMETRICSRESPONSE']._serialized_end=15005
+  _globals['_FILESTREAMMETRICSRESPONSE_FILESENTRY']._serialized_start=14922
+  _globals['_FILESTREAMMETRICSRESPONSE_FILESENTRY']._serialized_end=15005
+  _globals['_CONFIGHISTORYREQUEST']._serialized_start=15007
+  _globals['_CONFIGHISTORYREQUEST']._serialized_end=15075
+  _globals['_CONFIGHISTORYRESPONSE']._serialized_start=15077
+  _globals['_CONFIGHISTORYRESPONSE']._serialized_end=15163
+  _globals['_FILESTREAMFILEMETRICS']._serialized_start=15165
+  _globals['_FILESTREAMFILEMETRICS']._serialized_end=15218
+  _globals['_FILESTREAMTRANSMITINTERVALREQUEST']._serialized_start=15220
+  _globals['_FILESTREAMTRANSMITINTERVALREQUEST']._serialized_end=15336
+  _globals['_STEPACKREQUEST']._serialized_start=15338
+  _globals['_STEPACKREQUEST']._serialized_end=15414
+  _globals['_STEPACKRESPONSE']._serialized_start=15416
+  _globals['_STEPACKRESPONSE']._serialized_end=15465
+  _globals['_INTERNALMESSAGESREQUEST']._serialized_start=15467
+  _globals['_INTERNALMESSAGESREQUEST']._serialized_end=15538
+  _globals['_INTERNALMESSAGESRESPONSE']._serialized_start=15540
+  _globals['_INTERNALMESSAGESRESPONSE']._serialized_end=15618
+  _globals['_INTERNALMESSAGES']._serialized_start=15620
+  _globals['_INTERNALMESSAGES']._serialized_end=15655
+  _globals['_POLLEXITREQUEST']._serialized_start=15657
+  _globals['_POLLEXITREQUEST']._serialized_end=15720
+  _globals['_POLLEXITRESPONSE']._serialized_start=15723
+  _globals['_POLLEXITRESPONSE']._serialized_end=15911
+  _globals['_SYNCOVERWRITE']._serialized_start=15913
+  _globals['_SYNCOVERWRITE']._serialized_end=15977
+  _globals['_SYNCSKIP']._serialized_start=15979
+  _globals['_SYNCSKIP']._serialized_end=16009
+  _globals['_SENDERMARKREQUEST']._serialized_start=16011
+  _globals['_SENDERMARKREQUEST']._serialized_end=16030
+  _globals['_SYNCREQUEST']._serialized_start=16033
+  _globals['_SYNCREQUEST']._serialized_end=16180
+  _globals['_SYNCRESPONSE']._serialized_start=16182
+  _globals['_SYNCRESPONSE']._serialized_end=16251
+  _globals['_SENDERREADREQUEST']._serialized_start=16253
+  _globals['_SENDERREADREQUEST']._serialized_end=16316
+  _globals['_STATUSREPORTREQUEST']._serialized_start=16318
+  _globals['_STATUSREPORTREQUEST']._serialized_end=16427
+  _globals['_SUMMARYRECORDREQUEST']._serialized_start=16429
+  _globals['_SUMMARYRECORDREQUEST']._serialized_end=16499
+  _globals['_TELEMETRYRECORDREQUEST']._serialized_start=16501
+  _globals['_TELEMETRYRECORDREQUEST']._serialized_end=16577
+  _globals['_SERVERINFOREQUEST']._serialized_start=16579
+  _globals['_SERVERINFOREQUEST']._serialized_end=16644
+  _globals['_SERVERINFORESPONSE']._serialized_start=16646
+  _globals['_SERVERINFORESPONSE']._serialized_end=16770
+  _globals['_SERVERMESSAGES']._serialized_start=16772
+  _globals['_SERVERMESSAGES']._serialized_end=16833
+  _globals['_SERVERMESSAGE']._serialized_start=16835
+  _globals['_SERVERMESSAGE']._serialized_end=16936
+  _globals['_FILECOUNTS']._serialized_start=16938
+  _globals['_FILECOUNTS']._serialized_end=17037
+  _globals['_FILEPUSHERSTATS']._serialized_start=17040
+  _globals['_FILEPUSHERSTATS']._serialized_end=17181
+  _globals['_FILESUPLOADED']._serialized_start=17183
+  _globals['_FILESUPLOADED']._serialized_end=17213
+  _globals['_FILETRANSFERINFOREQUEST']._serialized_start=17216
+  _globals['_FILETRANSFERINFOREQUEST']._serialized_end=17460
+  _globals['_FILETRANSFERINFOREQUEST_TRANSFERTYPE']._serialized_start=17420
+  _globals['_FILETRANSFERINFOREQUEST_TRANSFERTYPE']._serialized_end=17460
+  _globals['_LOCALINFO']._serialized_start=17462
+  _globals['_LOCALINFO']._serialized_end=17511
+  _globals['_SHUTDOWNREQUEST']._serialized_start=17513
+  _globals['_SHUTDOWNREQUEST']._serialized_end=17576
+  _globals['_SHUTDOWNRESPONSE']._serialized_start=17578
+  _globals['_SHUTDOWNRESPONSE']._serialized_end=17596
+  _globals['_ATTACHREQUEST']._serialized_start=17598
+  _globals['_ATTACHREQUEST']._serialized_end=17678
+  _globals['_ATTACHRESPONSE']._serialized_start=17680
+  _globals['_ATTACHRESPONSE']._serialized_end=17778
+  _globals['_TESTINJECTREQUEST']._serialized_start=17781
+  _globals['_TESTINJECTREQUEST']._serialized_end=18122
+  _globals['_TESTINJECTRESPONSE']._serialized_start=18124
+  _globals['_TESTINJECTRESPONSE']._serialized_end=18144
+  _globals['_HISTORYACTION']._serialized_start=18146
+  _globals['_HISTORYACTION']._serialized_end=18176
+  _globals['_PARTIALHISTORYREQUEST']._serialized_start=18179
+  _globals['_PARTIALHISTORYREQUEST']._serialized_end=18381
+  _globals['_PARTIALHISTORYRESPONSE']._serialized_start=18383
+  _globals['_PARTIALHISTORYRESPONSE']._serialized_end=18407
+  _globals['_SAMPLEDHISTORYREQUEST']._serialized_start=18409
+  _globals['_SAMPLEDHISTORYREQUEST']._serialized_end=18478
+  _globals['_SAMPLEDHISTORYITEM']._serialized_start=18480
+  _globals['_SAMPLEDHISTORYITEM']._serialized_end=18575
+  _globals['_SAMPLEDHISTORYRESPONSE']._serialized_start=18577
+  _globals['_SAMPLEDHISTORYRESPONSE']._serialized_end=18651
+  _globals['_RUNSTATUSREQUEST']._serialized_start=18653
+  _globals['_RUNSTATUSREQUEST']._serialized_end=18717
+  _globals['_RUNSTATUSRESPONSE']._serialized_start=18719
+  _globals['_RUNSTATUSRESPONSE']._serialized_end=18839
+  _globals['_RUNSTARTREQUEST']._serialized_start=18841
+  _globals['_RUNSTARTREQUEST']._serialized_end=18944
+  _globals['_RUNSTARTRESPONSE']._serialized_start=18946
+  _globals['_RUNSTARTRESPONSE']._serialized_end=18964
+  _globals['_CHECKVERSIONREQUEST']._serialized_start=18966
+  _globals['_CHECKVERSIONREQUEST']._serialized_end=19058
+  _globals['_CHECKVERSIONRESPONSE']._serialized_start=19060
+  _globals['_CHECKVERSIONRESPONSE']._serialized_end=19153
+  _globals['_JOBINFOREQUEST']._serialized_start=19155
+  _globals['_JOBINFOREQUEST']._serialized_end=19217
+  _globals['_JOBINFORESPONSE']._serialized_start=19219
+  _globals['_JOBINFORESPONSE']._serialized_end=19273
+  _globals['_LOGARTIFACTREQUEST']._serialized_start=19276
+  _globals['_LOGARTIFACTREQUEST']._serialized_end=19435
+  _globals['_LOGARTIFACTRESPONSE']._serialized_start=19437
+  _globals['_LOGARTIFACTRESPONSE']._serialized_end=19561
+  _globals['_FILETRANSFERFAILURE']._serialized_start=19563
+  _globals['_FILETRANSFERFAILURE']._serialized_end=19660
+  _globals['_DOWNLOADARTIFACTREQUEST']._serialized_start=19663
+  _globals['_DOWNLOADARTIFACTREQUEST']._serialized_end=19853
+  _globals['_DOWNLOADARTIFACTRESPONSE']._serialized_start=19855
+  _globals['_DOWNLOADARTIFACTRESPONSE']._serialized_end=19904
+  _globals['_KEEPALIVEREQUEST']._serialized_start=19906
+  _globals['_KEEPALIVEREQUEST']._serialized_end=19970
+  _globals['_KEEPALIVERESPONSE']._serialized_start=19972
+  _globals['_KEEPALIVERESPONSE']._serialized_end=19991
+  _globals['_ARTIFACTINFO']._serialized_start=19993
+  _globals['_ARTIFACTINFO']._serialized_end=20106
+  _globals['_GITINFO']._serialized_start=20108
+  _globals['_GITINFO']._serialized_end=20149
+  _globals['_GITSOURCE']._serialized_start=20152
+  _globals['_GITSOURCE']._serialized_end=20287
+  _globals['_IMAGESOURCE']._serialized_start=20289
+  _globals['_IMAGESOURCE']._serialized_end=20317
+  _globals['_SOURCE']._serialized_start=20320
+  _globals['_SOURCE']._serialized_end=20460
+  _globals['_JOBSOURCE']._serialized_start=20462
+  _globals['_JOBSOURCE']._serialized_end=20569
+  _globals['_PARTIALJOBARTIFACT']._serialized_start=20571
+  _globals['_PARTIALJOBARTIFACT']._serialized_end=20657
+  _globals['_USEARTIFACTRECORD']._serialized_start=20660
+  _globals['_USEARTIFACTRECORD']._serialized_end=20817
+  _globals['_USEARTIFACTRESULT']._serialized_start=20819
+  _globals['_USEARTIFACTRESULT']._serialized_end=20838
+  _globals['_CANCELREQUEST']._serialized_start=20840
+  _globals['_CANCELREQUEST']._serialized_end=20922
+  _globals['_CANCELRESPONSE']._serialized_start=20924
+  _globals['_CANCELRESPONSE']._serialized_end=20940
+  _globals['_DISKINFO']._serialized_start=20942
+  _globals['_DISKINFO']._serialized_end=20981
+  _globals['_MEMORYINFO']._serialized_start=20983
+  _globals['_MEMORYINFO']._serialized_end=21010
+  _globals['_CPUINFO']._serialized_start=21012
+  _globals['_CPUINFO']._serialized_end=21059
+  _globals['_GPUAPPLEINFO']._serialized_start=21061
+  _globals['_GPUAPPLEINFO']._serialized_end=21123
+  _globals['_GPUNVIDIAINFO']._serialized_start=21125
+  _globals['_GPUNVIDIAINFO']._serialized_end=21176
+  _globals['_GPUAMDINFO']._serialized_start=21179
+  _globals['_GPUAMDINFO']._serialized_end=21444
+  _globals['_METADATAREQUEST']._serialized_start=21447
+  _globals['_METADATAREQUEST']._serialized_end=22515
+  _globals['_METADATAREQUEST_DISKENTRY']._serialized_start=22400
+  _globals['_METADATAREQUEST_DISKENTRY']._serialized_end=22469
+  _globals['_METADATAREQUEST_SLURMENTRY']._serialized_start=22471
+  _globals['_METADATAREQUEST_SLURMENTRY']._serialized_end=22515
+  _globals['_PYTHONPACKAGESREQUEST']._serialized_start=22518
+  _globals['_PYTHONPACKAGESREQUEST']._serialized_end=22659
+  _globals['_PYTHONPACKAGESREQUEST_PYTHONPACKAGE']._serialized_start=22613
+  _globals['_PYTHONPACKAGESREQUEST_PYTHONPACKAGE']._serialized_end=22659
+  _globals['_JOBINPUTPATH']._serialized_start=22661
+  _globals['_JOBINPUTPATH']._serialized_end=22689
+  _globals['_JOBINPUTSOURCE']._serialized_start=22692
+  _globals['_JOBINPUTSOURCE']._serialized_end=22906
+  _globals['_JOBINPUTSOURCE_RUNCONFIGSOURCE']._serialized_start=22845
+  _globals['_JOBINPUTSOURCE_RUNCONFIGSOURCE']._serialized_end=22862
+  _globals['_JOBINPUTSOURCE_CONFIGFILESOURCE']._serialized_start=22864
+  _globals['_JOBINPUTSOURCE_CONFIGFILESOURCE']._serialized_end=22896
+  _globals['_JOBINPUTREQUEST']._serialized_start=22909
+  _globals['_JOBINPUTREQUEST']._serialized_end=23108
+  _globals['_FILESTREAMPAYLOAD']._serialized_start=23111
+  _globals['_FILESTREAMPAYLOAD']._serialized_end=23418
+  _globals['_FILESTREAMPAYLOAD_FILESENTRY']._serialized_start=23300
+  _globals['_FILESTREAMPAYLOAD_FILESENTRY']._serialized_end=23377
+  _globals['_FILESTREAMCHUNK']._serialized_start=23420
+  _globals['_FILESTREAMCHUNK']._serialized_end=23470
 # @@protoc_insertion_point(module_scope)
@@ -31,6 +31,7 @@ class TelemetryRecord(google.protobuf.message.Message):
     ISSUES_FIELD_NUMBER: builtins.int
     CORE_VERSION_FIELD_NUMBER: builtins.int
     PLATFORM_FIELD_NUMBER: builtins.int
+    CONFIG_STATS_FIELD_NUMBER: builtins.int
     _INFO_FIELD_NUMBER: builtins.int
     python_version: builtins.str
     cli_version: builtins.str
@@ -54,6 +55,8 @@ class TelemetryRecord(google.protobuf.message.Message):
     @property
     def issues(self) -> global___Issues: ...
     @property
+    def config_stats(self) -> global___ConfigStats: ...
+    @property
     def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
     def __init__(
         self,
@@ -70,10 +73,11 @@ class TelemetryRecord(google.protobuf.message.Message):
         issues: global___Issues | None = ...,
         core_version: builtins.str = ...,
         platform: builtins.str = ...,
+        config_stats: global___ConfigStats | None = ...,
         _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing.Literal["_info", b"_info", "deprecated", b"deprecated", "env", b"env", "feature", b"feature", "imports_finish", b"imports_finish", "imports_init", b"imports_init", "issues", b"issues", "label", b"label"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing.Literal["_info", b"_info", "cli_version", b"cli_version", "core_version", b"core_version", "deprecated", b"deprecated", "env", b"env", "feature", b"feature", "huggingface_version", b"huggingface_version", "imports_finish", b"imports_finish", "imports_init", b"imports_init", "issues", b"issues", "label", b"label", "platform", b"platform", "python_version", b"python_version"]) -> None: ...
+    def HasField(self, field_name: typing.Literal["_info", b"_info", "config_stats", b"config_stats", "deprecated", b"deprecated", "env", b"env", "feature", b"feature", "imports_finish", b"imports_finish", "imports_init", b"imports_init", "issues", b"issues", "label", b"label"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing.Literal["_info", b"_info", "cli_version", b"cli_version", "config_stats", b"config_stats", "core_version", b"core_version", "deprecated", b"deprecated", "env", b"env", "feature", b"feature", "huggingface_version", b"huggingface_version", "imports_finish", b"imports_finish", "imports_init", b"imports_init", "issues", b"issues", "label", b"label", "platform", b"platform", "python_version", b"python_version"]) -> None: ...
 
 global___TelemetryRecord = TelemetryRecord
 
@@ -882,3 +886,23 @@ class Issues(google.protobuf.message.Message):
     def ClearField(self, field_name: typing.Literal["settings__preprocessing_warnings", b"settings__preprocessing_warnings", "settings__unexpected_args", b"settings__unexpected_args", "settings__validation_warnings", b"settings__validation_warnings"]) -> None: ...
 
 global___Issues = Issues
+
+@typing.final
+class ConfigStats(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    SIZE_BYTES_FIELD_NUMBER: builtins.int
+    LEAF_COUNT_FIELD_NUMBER: builtins.int
+    size_bytes: builtins.int
+    """size of the user's config as JSON"""
+    leaf_count: builtins.int
+    """number of leaf values in the user's config"""
+    def __init__(
+        self,
+        *,
+        size_bytes: builtins.int = ...,
+        leaf_count: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing.Literal["leaf_count", b"leaf_count", "size_bytes", b"size_bytes"]) -> None: ...
+
+global___ConfigStats = ConfigStats
@@ -815,6 +815,11 @@ class Artifact:
             response = result.response.log_artifact_response
             if response.error_message:
                 raise ValueError(response.error_message)
+            for failure in response.failed_files:
+                termwarn(
+                    f"Artifact file {failure.name!r} failed to upload and was"
+                    f" left out ({failure.error_kind}): {failure.error_message}"
+                )
             self._populate_after_save(response.artifact_id)
         return self
 
@@ -1804,7 +1809,7 @@ class Artifact:
                 cursor = attrs["pageInfo"]["endCursor"]
                 for edge in attrs["edges"]:
                     entry = self.get_entry(edge["node"]["name"])
-                    if require_core and entry.ref is None:
+                    if require_core and _is_downloaded_by_core(entry):
                         # Handled by core
                         continue
                     entry._download_url = edge["node"]["directUrl"]
@@ -2356,6 +2361,35 @@ def is_require_core() -> bool:
     return False
 
 
+# Reference URL schemes that wandb-core can download.
+_CORE_REFERENCE_SCHEMES = (
+    "hdfs",
+    "webhdfs",
+    "swebhdfs",
+    "webdav",
+    "webdavs",
+    "oci",
+    "file",
+)
+
+
+def _is_downloaded_by_core(entry: ArtifactManifestEntry) -> bool:
+    """Returns whether wandb-core downloads the entry.
+
+    This mirrors `filetransfer.SupportsReference` in wandb-core. Other
+    references, such as those to S3, GCS or Azure, are downloaded by the
+    user process.
+    """
+    if entry.ref is None:
+        return True
+
+    url = urlparse(entry.ref)
+    scheme = url.scheme.lower()
+    if scheme in ("http", "https"):
+        return not (url.hostname or "").endswith(".blob.core.windows.net")
+    return scheme in _CORE_REFERENCE_SCHEMES
+
+
 class _ArtifactVersionType(WBType):
     name = "artifactVersion"
     types = [Artifact]
@@ -11,6 +11,7 @@ InterfaceRelay: Responses are routed to a relay queue (not matching uuids)
 import gzip
 import logging
 import os
+import re
 import sys
 import time
 from abc import abstractmethod
@@ -52,6 +53,9 @@ from .message_future import MessageFuture
 
 MANIFEST_FILE_SIZE_THRESHOLD = 100_000
 
+# Matches "${env:NAME}" references in config values.
+_ENV_REFERENCE_RE = re.compile(r"\$\{env:([A-Za-z_][A-Za-z0-9_]*)\}")
+
 GlobStr = NewType("GlobStr", str)
 
 if sys.version_info >= (3, 8):
@@ -156,10 +160,23 @@ class InterfaceBase:
         if run._config is not None:
             config_dict = run._config._as_dict()  # type: ignore
             self._make_config(data=config_dict, obj=proto_run.config)
+            if run._settings._config_interpolate_env:
+                self._add_config_env(proto_run)
         if run._telemetry_obj:
             proto_run.telemetry.MergeFrom(run._telemetry_obj)
         return proto_run
 
+    def _add_config_env(self, proto_run: pb.RunRecord) -> None:
+        """Add the environment variables that the config references.
+
+        wandb-core resolves "${env:NAME}" references with these values, since
+        its own environment may differ from this process's.
+        """
+        for update in proto_run.config.update:
+            for name in _ENV_REFERENCE_RE.findall(update.value_json):
+                if name in os.environ:
+                    proto_run.config_env[name] = os.environ[name]
+
     def publish_run(self, run: "Run") -> None:
         run_record = self._make_run(run)
         self._publish_run(run_record)
@@ -947,6 +964,67 @@ class InterfaceBase:
     ) -> MailboxHandle:
         raise NotImplementedError
 
+    def publish_system_metrics_burst(
+        self,
+        sample_rate_seconds: float,
+        duration_seconds: float,
+        assets: Optional[Iterable[str]] = None,
+    ) -> None:
+        system_metrics_burst = pb.SystemMetricsBurstRequest(
+            sample_rate_seconds=sample_rate_seconds,
+            duration_seconds=duration_seconds,
+            assets=assets,
+        )
+        self._publish_system_metrics_burst(system_metrics_burst)
+
+    @abstractmethod
+    def _publish_system_metrics_burst(
+        self, system_metrics_burst: pb.SystemMetricsBurstRequest
+    ) -> None:
+        raise NotImplementedError
+
+    def deliver_file_stream_metrics(self) -> MailboxHandle:
+        file_stream_metrics = pb.FileStreamMetricsRequest()
+        return self._deliver_file_stream_metrics(file_stream_metrics)
+
+    @abstractmethod
+    def _deliver_file_stream_metrics(
+        self, file_stream_metrics: pb.FileStreamMetricsRequest
+    ) -> MailboxHandle:
+        raise NotImplementedError
+
+    def publish_file_stream_transmit_interval(
+        self, transmit_interval_seconds: float
+    ) -> None:
+        transmit_interval = pb.FileStreamTransmitIntervalRequest(
+            transmit_interval_seconds=transmit_interval_seconds,
+        )
+        self._publish_file_stream_transmit_interval(transmit_interval)
+
+    @abstractmethod
+    def _publish_file_stream_transmit_interval(
+        self, transmit_interval: pb.FileStreamTransmitIntervalRequest
+    ) -> None:
+        raise NotImplementedError
+
+    def deliver_step_ack(self, step: int) -> MailboxHandle:
+        step_ack = pb.StepAckRequest(step=step)
+        return self._deliver_step_ack(step_ack)
+
+    @abstractmethod
+    def _deliver_step_ack(self, step_ack: pb.StepAckRequest) -> MailboxHandle:
+        raise NotImplementedError
+
+    def deliver_config_history(self) -> MailboxHandle:
+        config_history = pb.ConfigHistoryRequest()
+        return self._deliver_config_history(config_history)
+
+    @abstractmethod
+    def _deliver_config_history(
+        self, config_history: pb.ConfigHistoryRequest
+    ) -> MailboxHandle:
+        raise NotImplementedError
+
     def deliver_exit(self, exit_code: Optional[int]) -> MailboxHandle:
         exit_data = self._make_exit(exit_code)
         return self._deliver_exit(exit_data)
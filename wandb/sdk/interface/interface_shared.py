@@ -151,6 +151,13 @@ class InterfaceShared(InterfaceBase):
         summary_record: Optional[pb.SummaryRecordRequest] = None,
         telemetry_record: Optional[pb.TelemetryRecordRequest] = None,
         get_system_metrics: Optional[pb.GetSystemMetricsRequest] = None,
+        system_metrics_burst: Optional[pb.SystemMetricsBurstRequest] = None,
+        file_stream_metrics: Optional[pb.FileStreamMetricsRequest] = None,
+        file_stream_transmit_interval: Optional[
+            pb.FileStreamTransmitIntervalRequest
+        ] = None,
+        step_ack: Optional[pb.StepAckRequest] = None,
+        config_history: Optional[pb.ConfigHistoryRequest] = None,
         python_packages: Optional[pb.PythonPackagesRequest] = None,
         job_input: Optional[pb.JobInputRequest] = None,
     ) -> pb.Record:
@@ -211,6 +218,18 @@ class InterfaceShared(InterfaceBase):
             request.telemetry_record.CopyFrom(telemetry_record)
         elif get_system_metrics:
             request.get_system_metrics.CopyFrom(get_system_metrics)
+        elif system_metrics_burst:
+            request.system_metrics_burst.CopyFrom(system_metrics_burst)
+        elif file_stream_metrics:
+            request.file_stream_metrics.CopyFrom(file_stream_metrics)
+        elif file_stream_transmit_interval:
+            request.file_stream_transmit_interval.CopyFrom(
+                file_stream_transmit_interval
+            )
+        elif step_ack:
+            request.step_ack.CopyFrom(step_ack)
+        elif config_history:
+            request.config_history.CopyFrom(config_history)
         elif sync:
             request.sync.CopyFrom(sync)
         elif python_packages:
@@ -477,6 +496,34 @@ class InterfaceShared(InterfaceBase):
         record = self._make_request(get_system_metrics=get_system_metrics)
         return self._deliver_record(record)
 
+    def _publish_system_metrics_burst(
+        self, system_metrics_burst: pb.SystemMetricsBurstRequest
+    ) -> None:
+        record = self._make_request(system_metrics_burst=system_metrics_burst)
+        self._publish(record)
+
+    def _deliver_file_stream_metrics(
+        self, file_stream_metrics: pb.FileStreamMetricsRequest
+    ) -> MailboxHandle:
+        record = self._make_request(file_stream_metrics=file_stream_metrics)
+        return self._deliver_record(record)
+
+    def _publish_file_stream_transmit_interval(
+        self, transmit_interval: pb.FileStreamTransmitIntervalRequest
+    ) -> None:
+        record = self._make_request(file_stream_transmit_interval=transmit_interval)
+        self._publish(record)
+
+    def _deliver_step_ack(self, step_ack: pb.StepAckRequest) -> MailboxHandle:
+        record = self._make_request(step_ack=step_ack)
+        return self._deliver_record(record)
+
+    def _deliver_config_history(
+        self, config_history: pb.ConfigHistoryRequest
+    ) -> MailboxHandle:
+        record = self._make_request(config_history=config_history)
+        return self._deliver_record(record)
+
     def _deliver_exit(self, exit_data: pb.RunExitRecord) -> MailboxHandle:
         record = self._make_record(exit=exit_data)
         return self._deliver_record(record)
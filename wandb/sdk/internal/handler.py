@@ -287,6 +287,12 @@ class HandleManager:
             if len(kl) > 1:
                 _dict_nested_set(self._consolidated_summary, kl, v)
                 return True
+        if s.first:
+            first_key = tuple(kl + ["first"])
+            if first_key not in self._metric_track:
+                self._metric_track[first_key] = float_v
+                _dict_nested_set(self._consolidated_summary, first_key, v)
+                updated = True
         if s.last:
             last_key = tuple(kl + ["last"])
             old_last = self._metric_track.get(last_key)
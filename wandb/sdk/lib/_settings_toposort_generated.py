@@ -12,10 +12,34 @@ else:
 
 _Setting = Literal[
     "_args",
+    "_artifact_upload_max_failures",
+    "_artifacts_cache_max_size_bytes",
+    "_artifacts_cache_hardlinks",
+    "_artifacts_download_batch_size",
     "_aws_lambda",
     "_cli_only_mode",
     "_code_path_local",
     "_colab",
+    "_config_max_bytes",
+    "_config_max_value_bytes",
+    "_config_include_keys",
+    "_config_exclude_keys",
+    "_config_dict_merge",
+    "_config_list_merge",
+    "_config_preserve_order",
+    "_config_strict_types",
+    "_config_interpolate_env",
+    "_config_dotted_keys",
+    "_config_key_escape",
+    "_config_audit",
+    "_metric_redefine_policy",
+    "_console_disable_dedupe",
+    "_console_max_lines_per_second",
+    "_console_disable_redaction",
+    "_console_redact_patterns",
+    "_console_max_line_length",
+    "_console_strip_ansi",
+    "_console_carriage_return_newline",
     "_cuda",
     "_disable_meta",
     "_disable_service",
@@ -31,19 +55,54 @@ _Setting = Literal[
     "_file_stream_retry_wait_min_seconds",
     "_file_stream_retry_wait_max_seconds",
     "_file_stream_timeout_seconds",
+    "_file_stream_flush_bytes",
+    "_file_stream_flush_lines",
+    "_file_stream_min_batch_delay_seconds",
+    "_file_stream_heartbeat_seconds",
+    "_file_stream_max_line_bytes",
+    "_file_stream_backlog_bytes",
+    "_file_stream_backlog_block",
+    "_file_stream_transmit_interval_seconds",
+    "_file_stream_dry_run_path",
+    "_file_stream_max_request_bytes",
+    "_file_stream_idle_conn_timeout_seconds",
+    "_file_stream_max_idle_conns_per_host",
+    "_file_stream_keep_alive",
+    "_file_stream_disable_http2",
+    "_file_stream_drain_timeout_seconds",
+    "_file_stream_drain_timeout_policy",
     "_file_transfer_retry_max",
     "_file_transfer_retry_wait_min_seconds",
     "_file_transfer_retry_wait_max_seconds",
     "_file_transfer_timeout_seconds",
+    "_file_transfer_multipart_threshold_bytes",
+    "_file_transfer_multipart_part_retries",
+    "_file_transfer_download_concurrency",
+    "_file_transfer_download_chunk_size_bytes",
+    "_file_transfer_max_concurrency",
+    "_file_transfer_max_concurrency_per_host",
+    "_file_transfer_symlink_policy",
+    "_file_transfer_compress_globs",
+    "_file_transfer_reference_include_globs",
+    "_file_transfer_reference_exclude_globs",
+    "_file_transfer_local_reference_policy",
+    "_file_transfer_upload_timeout_seconds",
+    "_file_transfer_download_timeout_seconds",
+    "_file_transfer_digest_concurrency",
+    "_file_transfer_upload_dedupe",
+    "_file_transfer_snapshot_uploads",
     "_flow_control_custom",
     "_flow_control_disabled",
     "_graphql_retry_max",
     "_graphql_retry_wait_min_seconds",
     "_graphql_retry_wait_max_seconds",
     "_graphql_timeout_seconds",
+    "_http_reference_headers",
+    "_http_reference_bearer_token",
     "_internal_check_process",
     "_internal_queue_timeout",
     "_ipython",
+    "_json_non_finite_policy",
     "_jupyter",
     "_jupyter_name",
     "_jupyter_path",
@@ -56,6 +115,8 @@ _Setting = Literal[
     "_noop",
     "_notebook",
     "_offline",
+    "_oci_username",
+    "_oci_password",
     "_sync",
     "_os",
     "_platform",
@@ -78,9 +139,14 @@ _Setting = Literal[
     "_stats_open_metrics_filters",
     "_stats_disk_paths",
     "_stats_buffer_size",
+    "_stats_sampling_delay_seconds",
+    "_stats_warmup_duration_seconds",
+    "_stats_warmup_sample_rate_seconds",
     "_tmp_code_dir",
     "_tracelog",
     "_unsaved_keys",
+    "_webdav_username",
+    "_webdav_password",
     "_windows",
     "allow_val_change",
     "anonymous",
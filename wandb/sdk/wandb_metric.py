@@ -19,6 +19,8 @@ class Metric:
     _summary: Optional[Sequence[str]]
     _goal: Optional[str]
     _overwrite: Optional[bool]
+    _expression: Optional[str]
+    _sample_every: Optional[int]
 
     def __init__(
         self,
@@ -29,6 +31,8 @@ class Metric:
         summary: Optional[Sequence[str]] = None,
         goal: Optional[str] = None,
         overwrite: Optional[bool] = None,
+        expression: Optional[str] = None,
+        sample_every: Optional[int] = None,
     ) -> None:
         self._callback = None
         self._name = name
@@ -40,6 +44,8 @@ class Metric:
         self._summary = summary
         self._goal = goal
         self._overwrite = overwrite
+        self._expression = expression
+        self._sample_every = sample_every
 
     def _set_callback(self, cb: Callable[[pb.MetricRecord], None]) -> None:
         self._callback = cb
@@ -100,11 +106,17 @@ class Metric:
                 m.summary.none = True
             if "best" in summary_set:
                 m.summary.best = True
+            if "first" in summary_set:
+                m.summary.first = True
         if self._goal == "min":
             m.goal = m.GOAL_MINIMIZE
         if self._goal == "max":
             m.goal = m.GOAL_MAXIMIZE
         if self._overwrite:
             m._control.overwrite = self._overwrite
+        if self._expression:
+            m._expression = self._expression
+        if self._sample_every:
+            m._sample_every = self._sample_every
         if self._callback:
             self._callback(m)
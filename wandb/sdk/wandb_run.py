@@ -2704,11 +2704,15 @@ class Run:
         summary: Optional[str] = None,
         goal: Optional[str] = None,
         overwrite: Optional[bool] = None,
+        expression: Optional[str] = None,
+        sample_every: Optional[int] = None,
     ) -> wandb_metric.Metric:
         """Customize metrics logged with `wandb.log()`.
 
         Arguments:
-            name: The name of the metric to customize.
+            name: The name of the metric to customize. Dots separate nested
+                keys; escape a dot that is part of a key with a backslash,
+                as in `"file\\.txt"`.
             step_metric: The name of another metric to serve as the X-axis
                 for this metric in automatically generated charts.
             step_sync: Automatically insert the last value of step_metric into
@@ -2716,10 +2720,10 @@ class Run:
                  if step_metric is specified.
             hidden: Hide this metric from automatic plots.
             summary: Specify aggregate metrics added to summary.
-                Supported aggregations include "min", "max", "mean", "last",
-                "best", "copy" and "none". "best" is used together with the
-                goal parameter. "none" prevents a summary from being generated.
-                "copy" is deprecated and should not be used.
+                Supported aggregations include "min", "max", "mean", "first",
+                "last", "best", "copy" and "none". "best" is used together with
+                the goal parameter. "none" prevents a summary from being
+                generated. "copy" is deprecated and should not be used.
             goal: Specify how to interpret the "best" summary type.
                 Supported options are "minimize" and "maximize".
             overwrite: If false, then this call is merged with previous
@@ -2727,6 +2731,15 @@ class Run:
                 values for any unspecified parameters. If true, then
                 unspecified parameters overwrite values specified by
                 previous calls.
+            expression: Compute this metric from other metrics with an
+                arithmetic expression, like "samples / `time/delta`". It
+                is logged in every step that has all of its inputs. Names
+                with characters other than letters, digits, "_" and "."
+                are quoted with backticks. Requires wandb-core.
+            sample_every: Upload only every Nth value of this metric, to
+                reduce the size of the history of very frequently logged
+                metrics. The summary still uses every value. Requires
+                wandb-core.
 
         Returns:
             An object that represents this call but can otherwise be discarded.
@@ -2746,6 +2759,8 @@ class Run:
             summary,
             goal,
             overwrite,
+            expression,
+            sample_every,
         )
 
     def _define_metric(
@@ -2757,6 +2772,8 @@ class Run:
         summary: Optional[str] = None,
         goal: Optional[str] = None,
         overwrite: Optional[bool] = None,
+        expression: Optional[str] = None,
+        sample_every: Optional[int] = None,
     ) -> wandb_metric.Metric:
         if not name:
             raise wandb.Error("define_metric() requires non-empty name argument")
@@ -2770,6 +2787,8 @@ class Run:
             ("summary", summary, str),
             ("goal", goal, str),
             ("overwrite", overwrite, bool),
+            ("expression", expression, str),
+            ("sample_every", sample_every, int),
         ):
             # NOTE: type checking is broken for isinstance and str
             if arg_val is not None and not isinstance(arg_val, exp_type):
@@ -2782,11 +2801,19 @@ class Run:
             raise wandb.Error(
                 f"Unhandled define_metric() arg: name (glob suffixes only): {name}"
             )
+        if expression is not None and name.endswith("*"):
+            raise wandb.Error(
+                f"Unhandled define_metric() arg: expression (not for globs): {name}"
+            )
+        if sample_every is not None and sample_every < 1:
+            raise wandb.Error(
+                f"Unhandled define_metric() arg: sample_every (must be positive): {sample_every}"
+            )
         summary_ops: Optional[Sequence[str]] = None
         if summary:
             summary_items = [s.lower() for s in summary.split(",")]
             summary_ops = []
-            valid = {"min", "max", "mean", "best", "last", "copy", "none"}
+            valid = {"min", "max", "mean", "best", "first", "last", "copy", "none"}
             # TODO: deprecate copy and best
             for i in summary_items:
                 if i not in valid:
@@ -2821,11 +2848,36 @@ class Run:
             hidden=hidden,
             goal=goal_cleaned,
             overwrite=overwrite,
+            expression=expression,
+            sample_every=sample_every,
         )
         m._set_callback(self._metric_callback)
         m._commit()
         return m
 
+    @_run_decorator._noop_on_finish()
+    @_run_decorator._attach
+    def undefine_metric(self, name: str) -> None:
+        """Remove a metric definition made with `define_metric()`.
+
+        The metric's step metric, summary and other options are reset to
+        their defaults. Removing a glob does not affect metrics that have
+        already matched it. Requires wandb-core.
+
+        Arguments:
+            name: The name or glob that was passed to `define_metric()`.
+        """
+        if not name:
+            raise wandb.Error("undefine_metric() requires non-empty name argument")
+
+        m = MetricRecord()
+        if name.endswith("*"):
+            m.glob_name = name
+        else:
+            m.name = name
+        m._control.remove = True
+        self._metric_callback(m)
+
     # TODO(jhr): annotate this
     @_run_decorator._attach
     def watch(  # type: ignore
@@ -3888,6 +3940,11 @@ class Run:
         )
         if stats.deduped_bytes > 0:
             line += f" ({stats.deduped_bytes / megabyte:.3f} MB deduped)"
+        if stats.total_download_bytes > 0:
+            line += (
+                f", {stats.downloaded_bytes / megabyte:.3f} MB"
+                f" of {stats.total_download_bytes / megabyte:.3f} MB downloaded"
+            )
         line += "\r"
 
         if stats.total_bytes > 0:
@@ -34,7 +34,13 @@ from typing import (
 )
 from urllib.parse import quote, unquote, urlencode, urlparse, urlsplit
 
-from google.protobuf.wrappers_pb2 import BoolValue, DoubleValue, Int32Value, StringValue
+from google.protobuf.wrappers_pb2 import (
+    BoolValue,
+    DoubleValue,
+    Int32Value,
+    Int64Value,
+    StringValue,
+)
 
 import wandb
 import wandb.env
@@ -135,7 +141,15 @@ def _datetime_as_str(val: Union[datetime, str]) -> str:
 
 def _redact_dict(
     d: Dict[str, Any],
-    unsafe_keys: Union[Set[str], FrozenSet[str]] = frozenset({"api_key"}),
+    unsafe_keys: Union[Set[str], FrozenSet[str]] = frozenset(
+        {
+            "api_key",
+            "_http_reference_bearer_token",
+            "_http_reference_headers",
+            "_oci_password",
+            "_webdav_password",
+        }
+    ),
     redact_str: str = "***REDACTED***",
 ) -> Dict[str, Any]:
     """Redact a dict of unsafe values specified by their key."""
@@ -297,10 +311,34 @@ class SettingsData:
     """Settings for the W&B SDK."""
 
     _args: Sequence[str]
+    _artifact_upload_max_failures: int  # files that may fail to upload
+    _artifacts_cache_max_size_bytes: int  # evict cached files above this size
+    _artifacts_cache_hardlinks: bool  # hard link files restored from the cache
+    _artifacts_download_batch_size: int  # files downloaded between checkpoints
     _aws_lambda: bool
     _cli_only_mode: bool  # Avoid running any code specific for runs
     _code_path_local: str
     _colab: bool
+    _config_max_bytes: int  # max size of the run config
+    _config_max_value_bytes: int  # max size of a single config value
+    _config_include_keys: Sequence[str]  # config keys to upload
+    _config_exclude_keys: Sequence[str]  # config keys not to upload
+    _config_dict_merge: str  # default merge strategy for dicts
+    _config_list_merge: str  # default merge strategy for lists
+    _config_preserve_order: bool  # keep config keys in insertion order
+    _config_strict_types: bool  # reject values that are not plain JSON
+    _config_interpolate_env: bool  # expand ${env:VAR} in the initial config
+    _config_dotted_keys: bool  # split config keys on dots
+    _config_key_escape: str  # escapes a literal dot in a dotted key
+    _config_audit: bool  # record an audit trail of config changes
+    _metric_redefine_policy: str  # merge, overwrite or keep_first
+    _console_disable_dedupe: bool  # send repeated console lines as they are
+    _console_max_lines_per_second: float
+    _console_disable_redaction: bool  # send console lines without redacting secrets
+    _console_redact_patterns: Sequence[str]  # additional regexes of secrets to redact
+    _console_max_line_length: int
+    _console_strip_ansi: bool  # remove ANSI escape sequences
+    _console_carriage_return_newline: bool  # treat \r as a line break
     # _config_dict: Config
     _cuda: str
     _disable_meta: bool  # Do not collect system metadata
@@ -320,11 +358,43 @@ class SettingsData:
     _file_stream_retry_wait_min_seconds: float  # min wait time between retries
     _file_stream_retry_wait_max_seconds: float  # max wait time between retries
     _file_stream_timeout_seconds: float  # timeout for individual HTTP requests
+    _file_stream_flush_bytes: int  # buffered bytes that trigger a request
+    _file_stream_flush_lines: int  # buffered lines that trigger a request
+    _file_stream_min_batch_delay_seconds: float
+    _file_stream_heartbeat_seconds: float  # max time between requests
+    _file_stream_max_line_bytes: int  # max size of a single filestream line
+    _file_stream_backlog_bytes: int  # buffered bytes before warning
+    _file_stream_backlog_block: bool  # block instead of warning
+    _file_stream_transmit_interval_seconds: float  # min time between requests
+    _file_stream_dry_run_path: str  # write requests to a file instead
+    _file_stream_max_request_bytes: int  # split larger requests
+    _file_stream_idle_conn_timeout_seconds: float
+    _file_stream_max_idle_conns_per_host: int
+    _file_stream_keep_alive: bool
+    _file_stream_disable_http2: bool
+    _file_stream_drain_timeout_seconds: float  # max time to finish uploading at exit
+    _file_stream_drain_timeout_policy: str
     # file transfer retry client configuration
     _file_transfer_retry_max: int
     _file_transfer_retry_wait_min_seconds: float
     _file_transfer_retry_wait_max_seconds: float
     _file_transfer_timeout_seconds: float
+    _file_transfer_multipart_threshold_bytes: int  # min size of files uploaded in parts
+    _file_transfer_multipart_part_retries: int  # retries of a failed part
+    _file_transfer_download_concurrency: int  # byte ranges of a file downloaded at once
+    _file_transfer_download_chunk_size_bytes: int
+    _file_transfer_max_concurrency: int  # file transfers in progress at once
+    _file_transfer_max_concurrency_per_host: int
+    _file_transfer_symlink_policy: str  # skip, preserve or follow symlinks
+    _file_transfer_compress_globs: Sequence[str]  # files to gzip before upload
+    _file_transfer_reference_include_globs: Sequence[str]
+    _file_transfer_reference_exclude_globs: Sequence[str]
+    _file_transfer_local_reference_policy: str  # copy, link or verify file:// references
+    _file_transfer_upload_timeout_seconds: float  # deadline for each upload
+    _file_transfer_download_timeout_seconds: float  # deadline for each download
+    _file_transfer_digest_concurrency: int  # parts hashed at once
+    _file_transfer_upload_dedupe: bool  # skip re-uploads of the same Content-MD5
+    _file_transfer_snapshot_uploads: bool  # copy files before uploading them
     _flow_control_custom: bool
     _flow_control_disabled: bool
     # graphql retry client configuration
@@ -332,9 +402,12 @@ class SettingsData:
     _graphql_retry_wait_min_seconds: float
     _graphql_retry_wait_max_seconds: float
     _graphql_timeout_seconds: float
+    _http_reference_headers: Mapping[str, str]  # headers for http(s):// references
+    _http_reference_bearer_token: str
     _internal_check_process: float
     _internal_queue_timeout: float
     _ipython: bool
+    _json_non_finite_policy: str  # how NaN and Infinity are encoded
     _jupyter: bool
     _jupyter_name: str
     _jupyter_path: str
@@ -347,6 +420,8 @@ class SettingsData:
     _noop: bool
     _notebook: bool
     _offline: bool
+    _oci_username: str  # credentials for OCI registries
+    _oci_password: str
     _sync: bool
     _os: str
     _platform: str
@@ -378,9 +453,14 @@ class SettingsData:
     _stats_open_metrics_filters: Union[Sequence[str], Mapping[str, Mapping[str, str]]]
     _stats_disk_paths: Sequence[str]  # paths to monitor disk usage
     _stats_buffer_size: int  # number of consolidated samples to buffer before flushing, available in run obj
+    _stats_sampling_delay_seconds: float  # delay before the first sample
+    _stats_warmup_duration_seconds: float  # duration of the warm-up burst
+    _stats_warmup_sample_rate_seconds: float  # sampling interval during warm-up
     _tmp_code_dir: str
     _tracelog: str
     _unsaved_keys: Sequence[str]
+    _webdav_username: str  # credentials for webdav(s):// transfers
+    _webdav_password: str
     _windows: bool
     allow_val_change: bool
     anonymous: str
@@ -627,6 +707,10 @@ class Settings(SettingsData):
         Note that key names must be the same as the class attribute names.
         """
         props: Dict[str, Dict[str, Any]] = dict(
+            _artifact_upload_max_failures={"preprocessor": int},
+            _artifacts_cache_max_size_bytes={"preprocessor": int},
+            _artifacts_cache_hardlinks={"preprocessor": _str_as_bool},
+            _artifacts_download_batch_size={"preprocessor": int},
             _aws_lambda={
                 "hook": lambda _: is_aws_lambda(),
                 "auto_hook": True,
@@ -639,6 +723,26 @@ class Settings(SettingsData):
                 "hook": lambda _: "google.colab" in sys.modules,
                 "auto_hook": True,
             },
+            _config_max_bytes={"preprocessor": int},
+            _config_max_value_bytes={"preprocessor": int},
+            _config_include_keys={"preprocessor": _str_as_tuple},
+            _config_exclude_keys={"preprocessor": _str_as_tuple},
+            _config_dict_merge={"preprocessor": str},
+            _config_list_merge={"preprocessor": str},
+            _config_preserve_order={"preprocessor": _str_as_bool},
+            _config_strict_types={"preprocessor": _str_as_bool},
+            _config_interpolate_env={"preprocessor": _str_as_bool},
+            _config_dotted_keys={"preprocessor": _str_as_bool},
+            _config_key_escape={"preprocessor": str},
+            _config_audit={"preprocessor": _str_as_bool},
+            _metric_redefine_policy={"preprocessor": str},
+            _console_disable_dedupe={"preprocessor": _str_as_bool},
+            _console_max_lines_per_second={"preprocessor": float},
+            _console_disable_redaction={"preprocessor": _str_as_bool},
+            _console_redact_patterns={"preprocessor": _str_as_json},
+            _console_max_line_length={"preprocessor": int},
+            _console_strip_ansi={"preprocessor": _str_as_bool},
+            _console_carriage_return_newline={"preprocessor": _str_as_bool},
             _disable_machine_info={
                 "value": False,
                 "preprocessor": _str_as_bool,
@@ -667,10 +771,42 @@ class Settings(SettingsData):
             _file_stream_retry_wait_min_seconds={"preprocessor": float},
             _file_stream_retry_wait_max_seconds={"preprocessor": float},
             _file_stream_timeout_seconds={"preprocessor": float},
+            _file_stream_flush_bytes={"preprocessor": int},
+            _file_stream_flush_lines={"preprocessor": int},
+            _file_stream_min_batch_delay_seconds={"preprocessor": float},
+            _file_stream_heartbeat_seconds={"preprocessor": float},
+            _file_stream_max_line_bytes={"preprocessor": int},
+            _file_stream_backlog_bytes={"preprocessor": int},
+            _file_stream_backlog_block={"preprocessor": _str_as_bool},
+            _file_stream_transmit_interval_seconds={"preprocessor": float},
+            _file_stream_dry_run_path={"preprocessor": str},
+            _file_stream_max_request_bytes={"preprocessor": int},
+            _file_stream_idle_conn_timeout_seconds={"preprocessor": float},
+            _file_stream_max_idle_conns_per_host={"preprocessor": int},
+            _file_stream_keep_alive={"value": True, "preprocessor": _str_as_bool},
+            _file_stream_disable_http2={"preprocessor": _str_as_bool},
+            _file_stream_drain_timeout_seconds={"preprocessor": float},
+            _file_stream_drain_timeout_policy={"preprocessor": str},
             _file_transfer_retry_max={"preprocessor": int},
             _file_transfer_retry_wait_min_seconds={"preprocessor": float},
             _file_transfer_retry_wait_max_seconds={"preprocessor": float},
             _file_transfer_timeout_seconds={"preprocessor": float},
+            _file_transfer_multipart_threshold_bytes={"preprocessor": int},
+            _file_transfer_multipart_part_retries={"preprocessor": int},
+            _file_transfer_download_concurrency={"preprocessor": int},
+            _file_transfer_download_chunk_size_bytes={"preprocessor": int},
+            _file_transfer_max_concurrency={"preprocessor": int},
+            _file_transfer_max_concurrency_per_host={"preprocessor": int},
+            _file_transfer_symlink_policy={"preprocessor": str},
+            _file_transfer_compress_globs={"preprocessor": _str_as_tuple},
+            _file_transfer_reference_include_globs={"preprocessor": _str_as_tuple},
+            _file_transfer_reference_exclude_globs={"preprocessor": _str_as_tuple},
+            _file_transfer_local_reference_policy={"preprocessor": str},
+            _file_transfer_upload_timeout_seconds={"preprocessor": float},
+            _file_transfer_download_timeout_seconds={"preprocessor": float},
+            _file_transfer_digest_concurrency={"preprocessor": int},
+            _file_transfer_upload_dedupe={"preprocessor": _str_as_bool},
+            _file_transfer_snapshot_uploads={"preprocessor": _str_as_bool},
             _flow_control_disabled={
                 "hook": lambda _: self._network_buffer == 0,
                 "auto_hook": True,
@@ -683,12 +819,15 @@ class Settings(SettingsData):
             _graphql_retry_wait_min_seconds={"preprocessor": float},
             _graphql_retry_wait_max_seconds={"preprocessor": float},
             _graphql_timeout_seconds={"preprocessor": float},
+            _http_reference_headers={"preprocessor": _str_as_json},
+            _http_reference_bearer_token={"preprocessor": str},
             _internal_check_process={"value": 8, "preprocessor": float},
             _internal_queue_timeout={"value": 2, "preprocessor": float},
             _ipython={
                 "hook": lambda _: _get_python_type() == "ipython",
                 "auto_hook": True,
             },
+            _json_non_finite_policy={"preprocessor": str},
             _jupyter={
                 "hook": lambda _: _get_python_type() == "jupyter",
                 "auto_hook": True,
@@ -712,6 +851,8 @@ class Settings(SettingsData):
                 ),
                 "auto_hook": True,
             },
+            _oci_username={"preprocessor": str},
+            _oci_password={"preprocessor": str},
             _platform={"value": util.get_platform_name()},
             _proxies={
                 # TODO: deprecate and ask the user to use http_proxy and https_proxy instead
@@ -759,11 +900,16 @@ class Settings(SettingsData):
                 "value": 0,
                 "preprocessor": int,
             },
+            _stats_sampling_delay_seconds={"preprocessor": float},
+            _stats_warmup_duration_seconds={"preprocessor": float},
+            _stats_warmup_sample_rate_seconds={"preprocessor": float},
             _sync={"value": False},
             _tmp_code_dir={
                 "value": "code",
                 "hook": lambda x: self._path_convert(self.tmp_dir, x),
             },
+            _webdav_username={"preprocessor": str},
+            _webdav_password={"preprocessor": str},
             _windows={
                 "hook": lambda _: platform.system() == "Windows",
                 "auto_hook": True,
@@ -1557,7 +1703,11 @@ class Settings(SettingsData):
             if isinstance(v, bool):
                 getattr(settings, k).CopyFrom(BoolValue(value=v))
             elif isinstance(v, int):
-                getattr(settings, k).CopyFrom(Int32Value(value=v))
+                setting = getattr(settings, k)
+                if isinstance(setting, Int64Value):
+                    setting.CopyFrom(Int64Value(value=v))
+                else:
+                    setting.CopyFrom(Int32Value(value=v))
             elif isinstance(v, float):
                 getattr(settings, k).CopyFrom(DoubleValue(value=v))
             elif isinstance(v, str):